@@ -12,6 +12,8 @@ import (
 	"github.com/cenkalti/backoff"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/labstack/echo/v4"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/taikol2"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/http"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/repo"
@@ -53,6 +55,11 @@ func InitFromConfig(ctx context.Context, api *API, cfg *Config) (err error) {
 		return err
 	}
 
+	deadLetterRepository, err := repo.NewDeadLetterRepository(db)
+	if err != nil {
+		return err
+	}
+
 	srcEthClient, err := ethclient.Dial(cfg.SrcRPCUrl)
 	if err != nil {
 		return err
@@ -68,15 +75,49 @@ func InitFromConfig(ctx context.Context, api *API, cfg *Config) (err error) {
 		return err
 	}
 
-	srv, err := http.NewServer(http.NewServerOpts{
+	opts := http.NewServerOpts{
 		EventRepo:               eventRepository,
+		DeadLetterRepo:          deadLetterRepository,
+		AdminToken:              cfg.AdminToken,
 		Echo:                    echo.New(),
 		CorsOrigins:             cfg.CORSOrigins,
 		SrcEthClient:            srcEthClient,
 		DestEthClient:           destEthClient,
 		TaikoL2:                 taikoL2,
 		ProcessingFeeMultiplier: cfg.ProcessingFeeMultiplier,
-	})
+		FeeEstimateTTL:          time.Duration(cfg.FeesEstimateTTL) * time.Second,
+		SimulatedProofSize:      cfg.FeesProofSize,
+		Confirmations:           cfg.Confirmations,
+		StreamPollInterval:      time.Duration(cfg.StreamPollInterval) * time.Second,
+		MaxStreams:              cfg.StreamMaxStreams,
+		MaxStreamDuration:       time.Duration(cfg.StreamMaxDuration) * time.Second,
+	}
+
+	if cfg.SrcBridgeAddress != relayer.ZeroAddress {
+		srcBridge, err := bridge.NewBridge(cfg.SrcBridgeAddress, srcEthClient)
+		if err != nil {
+			return err
+		}
+
+		opts.SrcBridgeAddress = cfg.SrcBridgeAddress
+		opts.SrcBridge = srcBridge
+		opts.SrcRPCClient = srcEthClient.Client()
+		opts.SrcContractCaller = srcEthClient
+	}
+
+	if cfg.DestBridgeAddress != relayer.ZeroAddress {
+		destBridge, err := bridge.NewBridge(cfg.DestBridgeAddress, destEthClient)
+		if err != nil {
+			return err
+		}
+
+		opts.DestBridgeAddress = cfg.DestBridgeAddress
+		opts.DestBridge = destBridge
+		opts.DestRPCClient = destEthClient.Client()
+		opts.DestContractCaller = destEthClient
+	}
+
+	srv, err := http.NewServer(opts)
 	if err != nil {
 		return err
 	}
@@ -22,13 +22,24 @@ type Config struct {
 	DatabaseMaxOpenConns    uint64
 	DatabaseMaxConnLifetime uint64
 	CORSOrigins             []string
+	AdminToken              string
 	// rpc configs
 	SrcRPCUrl               string
 	DestRPCUrl              string
 	ProcessingFeeMultiplier float64
 	DestTaikoAddress        common.Address
-	HTTPPort                uint64
-	OpenDBFunc              func() (DB, error)
+	SrcBridgeAddress        common.Address
+	DestBridgeAddress       common.Address
+	Confirmations           uint64
+	// fee estimation configs
+	FeesEstimateTTL uint64
+	FeesProofSize   uint64
+	// event stream configs
+	StreamPollInterval uint64
+	StreamMaxStreams   uint64
+	StreamMaxDuration  uint64
+	HTTPPort           uint64
+	OpenDBFunc         func() (DB, error)
 }
 
 // NewConfigFromCliContext creates a new config instance from command line flags.
@@ -42,11 +53,20 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		DatabaseMaxOpenConns:    c.Uint64(flags.DatabaseMaxOpenConns.Name),
 		DatabaseMaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
 		CORSOrigins:             strings.Split(c.String(flags.CORSOrigins.Name), ","),
+		AdminToken:              c.String(flags.AdminToken.Name),
 		HTTPPort:                c.Uint64(flags.HTTPPort.Name),
 		SrcRPCUrl:               c.String(flags.SrcRPCUrl.Name),
 		DestRPCUrl:              c.String(flags.DestRPCUrl.Name),
 		ProcessingFeeMultiplier: c.Float64(flags.ProcessingFeeMultiplier.Name),
 		DestTaikoAddress:        common.HexToAddress(c.String(flags.DestTaikoAddress.Name)),
+		SrcBridgeAddress:        common.HexToAddress(c.String(flags.APISrcBridgeAddress.Name)),
+		DestBridgeAddress:       common.HexToAddress(c.String(flags.APIDestBridgeAddress.Name)),
+		Confirmations:           c.Uint64(flags.Confirmations.Name),
+		FeesEstimateTTL:         c.Uint64(flags.FeesEstimateTTL.Name),
+		FeesProofSize:           c.Uint64(flags.FeesProofSize.Name),
+		StreamPollInterval:      c.Uint64(flags.StreamPollInterval.Name),
+		StreamMaxStreams:        c.Uint64(flags.StreamMaxStreams.Name),
+		StreamMaxDuration:       c.Uint64(flags.StreamMaxDuration.Name),
 		OpenDBFunc: func() (DB, error) {
 			return db.OpenDBConnection(db.DBConnectionOpts{
 				Name:            c.String(flags.DatabaseUsername.Name),
@@ -20,6 +20,12 @@ var (
 		Value:    "*",
 		EnvVars:  []string{"HTTP_CORS_ORIGINS"},
 	}
+	AdminToken = &cli.StringFlag{
+		Name:     "http.adminToken",
+		Usage:    "Bearer token required by the admin endpoints, which are disabled when not set",
+		Category: indexerCategory,
+		EnvVars:  []string{"HTTP_ADMIN_TOKEN"},
+	}
 	ProcessingFeeMultiplier = &cli.Float64Flag{
 		Name:     "processingFeeMultiplier",
 		Usage:    "Processing fee multiplier",
@@ -27,12 +33,70 @@ var (
 		Value:    2.5,
 		EnvVars:  []string{"PROCESSING_FEE_MULTIPLIER"},
 	}
+	APISrcBridgeAddress = &cli.StringFlag{
+		Name: "srcBridgeAddress",
+		Usage: "Bridge address on the source chain, to simulate messages to it and look up their status. " +
+			"The static gas limits are recommended when not set",
+		Category: indexerCategory,
+		EnvVars:  []string{"SRC_BRIDGE_ADDRESS"},
+	}
+	APIDestBridgeAddress = &cli.StringFlag{
+		Name: "destBridgeAddress",
+		Usage: "Bridge address on the destination chain, to simulate messages to it and look up their status. " +
+			"The static gas limits are recommended when not set",
+		Category: indexerCategory,
+		EnvVars:  []string{"DEST_BRIDGE_ADDRESS"},
+	}
+	FeesEstimateTTL = &cli.Uint64Flag{
+		Name:     "fees.estimateTTL",
+		Usage:    "Time in seconds simulated gas limit estimates are reused for",
+		Category: indexerCategory,
+		Value:    600,
+		EnvVars:  []string{"FEES_ESTIMATE_TTL"},
+	}
+	FeesProofSize = &cli.Uint64Flag{
+		Name:     "fees.proofSize",
+		Usage:    "Size in bytes of a typical proof, whose calldata gas is added to the gas limit and fees of messages to the source chain",
+		Category: indexerCategory,
+		Value:    6000,
+		EnvVars:  []string{"FEES_PROOF_SIZE"},
+	}
+	StreamPollInterval = &cli.Uint64Flag{
+		Name:     "stream.pollInterval",
+		Usage:    "Time in seconds event streams poll the database for new events",
+		Category: indexerCategory,
+		Value:    2,
+		EnvVars:  []string{"STREAM_POLL_INTERVAL"},
+	}
+	StreamMaxStreams = &cli.Uint64Flag{
+		Name:     "stream.maxStreams",
+		Usage:    "Most event streams served at once, further clients are asked to retry later",
+		Category: indexerCategory,
+		Value:    1000,
+		EnvVars:  []string{"STREAM_MAX_STREAMS"},
+	}
+	StreamMaxDuration = &cli.Uint64Flag{
+		Name:     "stream.maxDuration",
+		Usage:    "Time in seconds an event stream is served before it's closed, and the client reconnects",
+		Category: indexerCategory,
+		Value:    1800,
+		EnvVars:  []string{"STREAM_MAX_DURATION"},
+	}
 )
 
 var APIFlags = MergeFlags(CommonFlags, []cli.Flag{
 	// optional
 	HTTPPort,
 	CORSOrigins,
+	AdminToken,
 	ProcessingFeeMultiplier,
 	DestTaikoAddress,
+	APISrcBridgeAddress,
+	APIDestBridgeAddress,
+	FeesEstimateTTL,
+	FeesProofSize,
+	Confirmations,
+	StreamPollInterval,
+	StreamMaxStreams,
+	StreamMaxDuration,
 })
@@ -11,6 +11,7 @@ var (
 	watchdogCategory  = "WATCHDOG"
 	bridgeCategory    = "BRIDGE"
 	txmgrCategory     = "TX_MANAGER"
+	routerCategory    = "ROUTER"
 )
 
 var (
@@ -136,6 +136,77 @@ var (
 		Value:    5,
 		EnvVars:  []string{"MAX_MESSAGE_RETRIES"},
 	}
+	DeadLetterRequeueInterval = &cli.Uint64Flag{
+		Name: "deadLetter.requeueInterval",
+		Usage: "Interval in seconds to publish the dead-lettered messages requeued by an operator to the queue again, " +
+			"must be greater than 0",
+		Category: processorCategory,
+		Value:    60,
+		EnvVars:  []string{"DEAD_LETTER_REQUEUE_INTERVAL"},
+	}
+	PriorityWorkers = &cli.Uint64Flag{
+		Name: "priority.workers",
+		Usage: "How many messages to process at once, by the highest fee per gas first. " +
+			"Set queue.prefetch higher so there are messages to choose from. Zero processes messages in queue order",
+		Category: processorCategory,
+		Value:    0,
+		EnvVars:  []string{"PRIORITY_WORKERS"},
+	}
+	PriorityMaxWait = &cli.Uint64Flag{
+		Name:     "priority.maxWait",
+		Usage:    "Time in seconds after which a waiting message is processed before higher-fee ones, so it doesn't starve",
+		Category: processorCategory,
+		Value:    300,
+		EnvVars:  []string{"PRIORITY_MAX_WAIT"},
+	}
+	SignerPrivateKeys = &cli.StringSliceFlag{
+		Name:     "signer.privateKeys",
+		Usage:    "Additional private keys to process messages with, each sending transactions with its own nonces",
+		Category: processorCategory,
+		EnvVars:  []string{"SIGNER_PRIVATE_KEYS"},
+	}
+	SignerMinBalance = &cli.StringFlag{
+		Name:     "signer.minBalance",
+		Usage:    "Balance in wei on the destination chain below which a processor key isn't used until topped up. Zero disables balance monitoring",
+		Category: processorCategory,
+		Value:    "0",
+		EnvVars:  []string{"SIGNER_MIN_BALANCE"},
+	}
+	SignerBalanceCheckInterval = &cli.Uint64Flag{
+		Name:     "signer.balanceCheckInterval",
+		Usage:    "Interval in seconds to check the balances of the processor keys",
+		Category: processorCategory,
+		Value:    60,
+		EnvVars:  []string{"SIGNER_BALANCE_CHECK_INTERVAL"},
+	}
+	BatchMulticallAddress = &cli.StringFlag{
+		Name: "batch.multicallAddress",
+		Usage: "Address of a Multicall3-compatible contract on the destination chain to process messages in batches with. " +
+			"It receives the fees of the batched messages, so it should be one the relayer owns",
+		Category: processorCategory,
+		EnvVars:  []string{"BATCH_MULTICALL_ADDRESS"},
+	}
+	BatchMaxSize = &cli.Uint64Flag{
+		Name:     "batch.maxSize",
+		Usage:    "Maximum number of messages to process in one batch",
+		Category: processorCategory,
+		Value:    10,
+		EnvVars:  []string{"BATCH_MAX_SIZE"},
+	}
+	BatchWindow = &cli.Uint64Flag{
+		Name:     "batch.window",
+		Usage:    "Time in seconds to wait for more messages to be ready before processing a batch",
+		Category: processorCategory,
+		Value:    2,
+		EnvVars:  []string{"BATCH_WINDOW"},
+	}
+	ProofCacheSize = &cli.Uint64Flag{
+		Name:     "proof.cacheSize",
+		Usage:    "How many storage proofs, blocks and block headers to cache each to generate proofs. Zero disables the cache",
+		Category: processorCategory,
+		Value:    1000,
+		EnvVars:  []string{"PROOF_CACHE_SIZE"},
+	}
 	DestQuotaManagerAddress = &cli.StringFlag{
 		Name:     "destQuotaManagerAddress",
 		Usage:    "QuotaManager address for the destination chain",
@@ -166,5 +237,15 @@ var ProcessorFlags = MergeFlags(CommonFlags, QueueFlags, TxmgrFlags, []cli.Flag{
 	CacheOption,
 	UnprofitableMessageQueueExpiration,
 	MaxMessageRetries,
+	DeadLetterRequeueInterval,
+	PriorityWorkers,
+	PriorityMaxWait,
+	SignerPrivateKeys,
+	SignerMinBalance,
+	SignerBalanceCheckInterval,
+	BatchMulticallAddress,
+	BatchMaxSize,
+	BatchWindow,
+	ProofCacheSize,
 	DestQuotaManagerAddress,
 })
@@ -3,37 +3,50 @@ package flags
 import "github.com/urfave/cli/v2"
 
 var (
+	QueueType = &cli.StringFlag{
+		Name: "queue.type",
+		Usage: "Queue implementation, either rabbitmq, or file for a durable queue embedded in the process, " +
+			"which stores the messages in queue.dir and needs no broker",
+		Value:    "rabbitmq",
+		Category: commonCategory,
+		EnvVars:  []string{"QUEUE_TYPE"},
+	}
+	QueueDir = &cli.StringFlag{
+		Name:     "queue.dir",
+		Usage:    "Directory the file queue stores the messages in, shared by the indexer and processor",
+		Value:    "queue",
+		Category: commonCategory,
+		EnvVars:  []string{"QUEUE_DIR"},
+	}
 	QueueUsername = &cli.StringFlag{
 		Name:     "queue.username",
-		Usage:    "Queue connection username",
-		Required: true,
+		Usage:    "Queue connection username, required by the rabbitmq queue",
 		Category: commonCategory,
 		EnvVars:  []string{"QUEUE_USER"},
 	}
 	QueuePassword = &cli.StringFlag{
 		Name:     "queue.password",
-		Usage:    "Queue connection password",
-		Required: true,
+		Usage:    "Queue connection password, required by the rabbitmq queue",
 		Category: commonCategory,
 		EnvVars:  []string{"QUEUE_PASSWORD"},
 	}
 	QueueHost = &cli.StringFlag{
 		Name:     "queue.host",
-		Usage:    "Queue connection host",
-		Required: true,
+		Usage:    "Queue connection host, required by the rabbitmq queue",
 		Category: commonCategory,
 		EnvVars:  []string{"QUEUE_HOST"},
 	}
 	QueuePort = &cli.Uint64Flag{
 		Name:     "queue.port",
-		Usage:    "Queue connection port",
-		Required: true,
+		Usage:    "Queue connection port, required by the rabbitmq queue",
 		Category: commonCategory,
 		EnvVars:  []string{"QUEUE_PORT"},
 	}
 )
 
 var QueueFlags = []cli.Flag{
+	QueueType,
+	QueueDir,
 	QueueUsername,
 	QueuePassword,
 	QueueHost,
@@ -0,0 +1,39 @@
+package flags
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	RoutesFile = &cli.StringFlag{
+		Name:     "routes",
+		Usage:    "Path to the route configuration file, listing the chain pairs to relay and their components",
+		Required: true,
+		Category: routerCategory,
+		EnvVars:  []string{"ROUTES_FILE"},
+	}
+	RouteRetryInterval = &cli.Uint64Flag{
+		Name:     "routes.retryInterval",
+		Usage:    "Retry interval in seconds when a route component fails to start",
+		Value:    30,
+		Category: routerCategory,
+		EnvVars:  []string{"ROUTES_RETRY_INTERVAL"},
+	}
+)
+
+// RouterFlags are the flags shared by all the routes of a router, all the other
+// flags are configured per route in the route configuration file.
+var RouterFlags = MergeFlags(QueueFlags, []cli.Flag{
+	// required
+	DatabaseUsername,
+	DatabasePassword,
+	DatabaseHost,
+	DatabaseName,
+	RoutesFile,
+	// optional
+	DatabaseMaxIdleConns,
+	DatabaseConnMaxLifetime,
+	DatabaseMaxOpenConns,
+	MetricsHTTPPort,
+	RouteRetryInterval,
+})
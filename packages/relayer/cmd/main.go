@@ -12,6 +12,7 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/utils"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/indexer"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/processor"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/router"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/watchdog"
 	"github.com/urfave/cli/v2"
 )
@@ -67,6 +68,13 @@ func main() {
 			Description: "Taiko relayer watchdog software",
 			Action:      utils.SubcommandAction(new(watchdog.Watchdog)),
 		},
+		{
+			Name:        "router",
+			Flags:       flags.RouterFlags,
+			Usage:       "Starts the indexers, processors and watchdogs of many routes",
+			Description: "Taiko relayer software serving the chain pairs of a route configuration file in one process",
+			Action:      utils.SubcommandAction(new(router.Router)),
+		},
 		{
 			Name:        "bridge",
 			Flags:       flags.BridgeFlags,
@@ -0,0 +1,75 @@
+package relayer
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/morkid/paginate"
+	"gorm.io/datatypes"
+)
+
+// DeadLetterStatus is used to indicate whether a dead-lettered message is waiting
+// for an operator, or has been requeued by one.
+type DeadLetterStatus int
+
+const (
+	DeadLetterStatusNew DeadLetterStatus = iota
+	DeadLetterStatusRequeued
+)
+
+// String returns string representation of a dead letter status for logging
+func (s DeadLetterStatus) String() string {
+	return [...]string{"new", "requeued"}[s]
+}
+
+// DeadLetter represents a queue message the processor gave up on, with the reason
+// it last failed, so it can be inspected, and requeued or discarded by an operator.
+type DeadLetter struct {
+	ID           int              `json:"id"`
+	MsgHash      string           `json:"msgHash"`
+	QueueName    string           `json:"queueName"`
+	Body         datatypes.JSON   `json:"body"`
+	Error        string           `json:"error"`
+	RevertReason string           `json:"revertReason"`
+	TimesRetried uint64           `json:"timesRetried"`
+	Status       DeadLetterStatus `json:"status"`
+	CreatedAt    time.Time        `json:"createdAt"`
+	UpdatedAt    time.Time        `json:"updatedAt"`
+}
+
+// SaveDeadLetterOpts
+type SaveDeadLetterOpts struct {
+	MsgHash      string
+	QueueName    string
+	Body         string
+	Error        string
+	RevertReason string
+	TimesRetried uint64
+}
+
+type FindAllDeadLettersOpts struct {
+	QueueName *string
+	Status    *DeadLetterStatus
+}
+
+// DeadLetterRepository is used to interact with dead-lettered messages in the store
+type DeadLetterRepository interface {
+	Save(ctx context.Context, opts SaveDeadLetterOpts) (*DeadLetter, error)
+	FindAll(
+		ctx context.Context,
+		req *http.Request,
+		opts FindAllDeadLettersOpts,
+	) (paginate.Page, error)
+	FindAllByQueueNameAndStatus(
+		ctx context.Context,
+		queueName string,
+		status DeadLetterStatus,
+	) ([]*DeadLetter, error)
+	FirstByMsgHash(
+		ctx context.Context,
+		msgHash string,
+	) (*DeadLetter, error)
+	UpdateStatus(ctx context.Context, id int, status DeadLetterStatus) error
+	Delete(ctx context.Context, id int) error
+}
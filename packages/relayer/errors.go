@@ -11,6 +11,10 @@ var (
 		"ERR_NO_BLOCK_REPOSITORY",
 		"BlockRepository is required",
 	)
+	ErrNoDeadLetterRepository = errors.Validation.NewWithKeyAndDetail(
+		"ERR_NO_DEAD_LETTER_REPOSITORY",
+		"DeadLetterRepository is required",
+	)
 	ErrNoCORSOrigins = errors.Validation.NewWithKeyAndDetail("ERR_NO_CORS_ORIGINS", "CORS Origins are required")
 	ErrNoProver      = errors.Validation.NewWithKeyAndDetail("ERR_NO_PROVER", "Prover is required")
 	ErrNoRPCClient   = errors.Validation.NewWithKeyAndDetail("ERR_NO_RPC_CLIENT", "RPCClient is required")
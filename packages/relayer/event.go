@@ -106,6 +106,16 @@ type FindAllByAddressOpts struct {
 	ChainID   *big.Int
 }
 
+// FindAllAfterIDOpts filters the events stored after the one with the given ID, which
+// streaming clients use as a cursor.
+type FindAllAfterIDOpts struct {
+	ID      int
+	Address *common.Address
+	MsgHash *string
+	Events  []string
+	Limit   int
+}
+
 // EventRepository is used to interact with events in the store
 type EventRepository interface {
 	Save(ctx context.Context, opts SaveEventOpts) (*Event, error)
@@ -124,6 +134,14 @@ type EventRepository interface {
 		event string,
 		msgHash string,
 	) (*Event, error)
+	FindAllByMsgHash(
+		ctx context.Context,
+		msgHash string,
+	) ([]*Event, error)
+	FindAllAfterID(
+		ctx context.Context,
+		opts FindAllAfterIDOpts,
+	) ([]*Event, error)
 	Delete(ctx context.Context, id int) error
 	ChainDataSyncedEventByBlockNumberOrGreater(
 		ctx context.Context,
@@ -142,4 +160,12 @@ type EventRepository interface {
 		srcChainID uint64,
 		destChainID uint64,
 	) (uint64, error)
+	FindLatestByEventType(
+		ctx context.Context,
+		event string,
+		eventType EventType,
+		status EventStatus,
+		srcChainID uint64,
+		destChainID uint64,
+	) (*Event, error)
 }
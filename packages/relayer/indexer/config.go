@@ -6,8 +6,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+	pkgFlags "github.com/taikoxyz/taiko-mono/packages/relayer/pkg/flags"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
-	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/rabbitmq"
 	"github.com/urfave/cli/v2"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
@@ -31,6 +31,8 @@ type Config struct {
 	DatabaseMaxOpenConns    uint64
 	DatabaseMaxConnLifetime uint64
 	// queue configs
+	QueueType     string
+	QueueDir      string
 	QueueUsername string
 	QueuePassword string
 	QueueHost     string
@@ -67,6 +69,8 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		DatabaseMaxIdleConns:                c.Uint64(flags.DatabaseMaxIdleConns.Name),
 		DatabaseMaxOpenConns:                c.Uint64(flags.DatabaseMaxOpenConns.Name),
 		DatabaseMaxConnLifetime:             c.Uint64(flags.DatabaseConnMaxLifetime.Name),
+		QueueType:                           c.String(flags.QueueType.Name),
+		QueueDir:                            c.String(flags.QueueDir.Name),
 		QueueUsername:                       c.String(flags.QueueUsername.Name),
 		QueuePassword:                       c.String(flags.QueuePassword.Name),
 		QueuePort:                           c.Uint64(flags.QueuePort.Name),
@@ -112,19 +116,7 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 			})
 		},
 		OpenQueueFunc: func() (queue.Queue, error) {
-			opts := queue.NewQueueOpts{
-				Username: c.String(flags.QueueUsername.Name),
-				Password: c.String(flags.QueuePassword.Name),
-				Host:     c.String(flags.QueueHost.Name),
-				Port:     c.String(flags.QueuePort.Name),
-			}
-
-			q, err := rabbitmq.NewQueue(opts)
-			if err != nil {
-				return nil, err
-			}
-
-			return q, nil
+			return pkgFlags.OpenQueueFromCli(c)
 		},
 	}, nil
 }
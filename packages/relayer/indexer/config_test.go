@@ -53,6 +53,8 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		assert.Equal(t, "queuepassword", c.QueuePassword)
 		assert.Equal(t, "queuehost", c.QueueHost)
 		assert.Equal(t, uint64(5555), c.QueuePort)
+		assert.Equal(t, queue.TypeFile, c.QueueType)
+		assert.Equal(t, "queuedir", c.QueueDir)
 		assert.Equal(t, "srcRpcUrl", c.SrcRPCUrl)
 		assert.Equal(t, "destRpcUrl", c.DestRPCUrl)
 		assert.Equal(t, common.HexToAddress(destBridgeAddr), c.DestBridgeAddress)
@@ -92,6 +94,8 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		"--" + flags.QueuePassword.Name, "queuepassword",
 		"--" + flags.QueueHost.Name, "queuehost",
 		"--" + flags.QueuePort.Name, "5555",
+		"--" + flags.QueueType.Name, queue.TypeFile,
+		"--" + flags.QueueDir.Name, "queuedir",
 		"--" + flags.SrcRPCUrl.Name, "srcRpcUrl",
 		"--" + flags.DestRPCUrl.Name, "destRpcUrl",
 		"--" + flags.DestBridgeAddress.Name, destBridgeAddr,
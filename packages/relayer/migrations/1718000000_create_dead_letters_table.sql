@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS dead_letters (
+    id int NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    msg_hash VARCHAR(255) NOT NULL DEFAULT "",
+    queue_name VARCHAR(255) NOT NULL,
+    body JSON NOT NULL,
+    error TEXT NOT NULL,
+    revert_reason TEXT NOT NULL,
+    times_retried int NOT NULL DEFAULT 0,
+    status int NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_dead_letters_msg_hash (msg_hash),
+    INDEX idx_dead_letters_queue_name_status (queue_name, status)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE dead_letters;
+-- +goose StatementEnd
@@ -0,0 +1,117 @@
+package encoding
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+var (
+	addressT, _         = abi.NewType("address", "", nil)
+	uint256T, _         = abi.NewType("uint256", "", nil)
+	uint256ArrayT, _    = abi.NewType("uint256[]", "", nil)
+	bytesT, _           = abi.NewType("bytes", "", nil)
+	canonicalERC20T     abi.Type
+	canonicalNFTT       abi.Type
+	onMessageInvocation = common.Hex2Bytes("7f07c947")
+)
+
+var canonicalERC20Components = []abi.ArgumentMarshaling{
+	{Name: "chainId", Type: "uint64"},
+	{Name: "addr", Type: "address"},
+	{Name: "decimals", Type: "uint8"},
+	{Name: "symbol", Type: "string"},
+	{Name: "name", Type: "string"},
+}
+
+var canonicalNFTComponents = []abi.ArgumentMarshaling{
+	{Name: "chainId", Type: "uint64"},
+	{Name: "addr", Type: "address"},
+	{Name: "symbol", Type: "string"},
+	{Name: "name", Type: "string"},
+}
+
+func init() {
+	canonicalERC20T, err = abi.NewType("tuple", "", canonicalERC20Components)
+	if err != nil {
+		panic(err)
+	}
+
+	canonicalNFTT, err = abi.NewType("tuple", "", canonicalNFTComponents)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// EncodeERC20MessageData encodes the `onMessageInvocation` call the ERC20 vault on the
+// destination chain receives to transfer an amount of a token.
+func EncodeERC20MessageData(
+	ctoken relayer.CanonicalERC20,
+	from common.Address,
+	to common.Address,
+	amount *big.Int,
+) ([]byte, error) {
+	args := abi.Arguments{{Type: canonicalERC20T}, {Type: addressT}, {Type: addressT}, {Type: uint256T}}
+
+	data, err := args.Pack(ctoken, from, to, amount)
+	if err != nil {
+		return nil, errors.Wrap(err, "args.Pack")
+	}
+
+	return encodeOnMessageInvocation(data)
+}
+
+// EncodeERC721MessageData encodes the `onMessageInvocation` call the ERC721 vault on the
+// destination chain receives to transfer tokens.
+func EncodeERC721MessageData(
+	ctoken relayer.CanonicalNFT,
+	from common.Address,
+	to common.Address,
+	tokenIDs []*big.Int,
+) ([]byte, error) {
+	args := abi.Arguments{{Type: canonicalNFTT}, {Type: addressT}, {Type: addressT}, {Type: uint256ArrayT}}
+
+	data, err := args.Pack(ctoken, from, to, tokenIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "args.Pack")
+	}
+
+	return encodeOnMessageInvocation(data)
+}
+
+// EncodeERC1155MessageData encodes the `onMessageInvocation` call the ERC1155 vault on the
+// destination chain receives to transfer amounts of tokens.
+func EncodeERC1155MessageData(
+	ctoken relayer.CanonicalNFT,
+	from common.Address,
+	to common.Address,
+	tokenIDs []*big.Int,
+	amounts []*big.Int,
+) ([]byte, error) {
+	args := abi.Arguments{
+		{Type: canonicalNFTT},
+		{Type: addressT},
+		{Type: addressT},
+		{Type: uint256ArrayT},
+		{Type: uint256ArrayT},
+	}
+
+	data, err := args.Pack(ctoken, from, to, tokenIDs, amounts)
+	if err != nil {
+		return nil, errors.Wrap(err, "args.Pack")
+	}
+
+	return encodeOnMessageInvocation(data)
+}
+
+func encodeOnMessageInvocation(data []byte) ([]byte, error) {
+	args, err := abi.Arguments{{Type: bytesT}}.Pack(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "args.Pack")
+	}
+
+	return append(append([]byte{}, onMessageInvocation...), args...), nil
+}
@@ -0,0 +1,76 @@
+package encoding
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+var (
+	testFrom = common.HexToAddress("0x63FaC9201494f0bd17B9892B9fae4d52fe3BD377")
+	testTo   = common.HexToAddress("0x71C7656EC7ab88b098defB751B7401B5f6d8976F")
+)
+
+func Test_EncodeERC20MessageData(t *testing.T) {
+	ctoken := relayer.CanonicalERC20{
+		ChainId:  1,
+		Addr:     common.HexToAddress("0x1"),
+		Decimals: 18,
+		Symbol:   "FAKE",
+		Name:     "Fake Token",
+	}
+
+	data, err := EncodeERC20MessageData(ctoken, testFrom, testTo, big.NewInt(100))
+	assert.Equal(t, nil, err)
+
+	eventType, decoded, amount, err := relayer.DecodeMessageData(data, big.NewInt(0))
+	assert.Equal(t, nil, err)
+	assert.Equal(t, relayer.EventTypeSendERC20, eventType)
+	assert.Equal(t, ctoken, decoded)
+	assert.Equal(t, big.NewInt(100), amount)
+}
+
+func Test_EncodeERC721MessageData(t *testing.T) {
+	ctoken := relayer.CanonicalNFT{
+		ChainId: 1,
+		Addr:    common.HexToAddress("0x1"),
+		Symbol:  "FAKE",
+		Name:    "Fake NFT",
+	}
+
+	data, err := EncodeERC721MessageData(ctoken, testFrom, testTo, []*big.Int{big.NewInt(1)})
+	assert.Equal(t, nil, err)
+
+	eventType, decoded, amount, err := relayer.DecodeMessageData(data, big.NewInt(0))
+	assert.Equal(t, nil, err)
+	assert.Equal(t, relayer.EventTypeSendERC721, eventType)
+	assert.Equal(t, ctoken, decoded)
+	assert.Equal(t, big.NewInt(1), amount)
+}
+
+func Test_EncodeERC1155MessageData(t *testing.T) {
+	ctoken := relayer.CanonicalNFT{
+		ChainId: 1,
+		Addr:    common.HexToAddress("0x1"),
+		Symbol:  "FAKE",
+		Name:    "Fake NFT",
+	}
+
+	data, err := EncodeERC1155MessageData(
+		ctoken,
+		testFrom,
+		testTo,
+		[]*big.Int{big.NewInt(1), big.NewInt(2)},
+		[]*big.Int{big.NewInt(3), big.NewInt(4)},
+	)
+	assert.Equal(t, nil, err)
+
+	eventType, decoded, amount, err := relayer.DecodeMessageData(data, big.NewInt(0))
+	assert.Equal(t, nil, err)
+	assert.Equal(t, relayer.EventTypeSendERC1155, eventType)
+	assert.Equal(t, ctoken, decoded)
+	assert.Equal(t, big.NewInt(7), amount)
+}
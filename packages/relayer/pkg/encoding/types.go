@@ -2,6 +2,7 @@ package encoding
 
 import (
 	"math/big"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -82,8 +83,20 @@ var hopComponents = []abi.ArgumentMarshaling{
 	},
 }
 
+// Multicall3Call is a call aggregated by a Multicall3-compatible contract's aggregate3.
+type Multicall3Call struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+// nolint: lll
+var multicall3ABIJSON = `[{"type":"function","name":"aggregate3","inputs":[{"name":"calls","type":"tuple[]","internalType":"struct Multicall3.Call3[]","components":[{"name":"target","type":"address","internalType":"address"},{"name":"allowFailure","type":"bool","internalType":"bool"},{"name":"callData","type":"bytes","internalType":"bytes"}]}],"outputs":[{"name":"returnData","type":"tuple[]","internalType":"struct Multicall3.Result[]","components":[{"name":"success","type":"bool","internalType":"bool"},{"name":"returnData","type":"bytes","internalType":"bytes"}]}],"stateMutability":"payable"}]`
+
 var BridgeABI *abi.ABI
 
+var Multicall3ABI *abi.ABI
+
 func init() {
 	hopProofsT, err = abi.NewType("tuple[]", "tuple[]", hopComponents)
 	if err != nil {
@@ -93,4 +106,11 @@ func init() {
 	if BridgeABI, err = bridge.BridgeMetaData.GetAbi(); err != nil {
 		log.Crit("Get Bridge ABI error", "error", err)
 	}
+
+	multicall3ABI, err := abi.JSON(strings.NewReader(multicall3ABIJSON))
+	if err != nil {
+		log.Crit("Get Multicall3 ABI error", "error", err)
+	}
+
+	Multicall3ABI = &multicall3ABI
 }
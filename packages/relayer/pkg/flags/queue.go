@@ -0,0 +1,46 @@
+package flags
+
+import (
+	"fmt"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/filequeue"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/rabbitmq"
+	"github.com/urfave/cli/v2"
+)
+
+// OpenQueueFromCli opens the queue implementation selected by the command line flags.
+func OpenQueueFromCli(c *cli.Context) (queue.Queue, error) {
+	opts := queue.NewQueueOpts{
+		Username:      c.String(flags.QueueUsername.Name),
+		Password:      c.String(flags.QueuePassword.Name),
+		Host:          c.String(flags.QueueHost.Name),
+		Port:          c.String(flags.QueuePort.Name),
+		PrefetchCount: c.Uint64(flags.QueuePrefetchCount.Name),
+		Dir:           c.String(flags.QueueDir.Name),
+	}
+
+	switch c.String(flags.QueueType.Name) {
+	case queue.TypeRabbitMQ:
+		if opts.Host == "" {
+			return nil, fmt.Errorf("%v is required by the rabbitmq queue", flags.QueueHost.Name)
+		}
+
+		q, err := rabbitmq.NewQueue(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		return q, nil
+	case queue.TypeFile:
+		q, err := filequeue.NewQueue(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		return q, nil
+	default:
+		return nil, fmt.Errorf("invalid queue type: %v", c.String(flags.QueueType.Name))
+	}
+}
@@ -0,0 +1,79 @@
+package http
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
+)
+
+// signalServiceStubCode replaces the code of the signal service when simulating
+// messages, so their proofs are accepted. It returns zero for any call.
+// PUSH1 0x20 PUSH1 0x00 RETURN
+var signalServiceStubCode = hexutil.Bytes{0x60, 0x20, 0x60, 0x00, 0xf3}
+
+type bridgeCaller interface {
+	Resolve(opts *bind.CallOpts, _chainId uint64, _name [32]byte, _allowZeroAddress bool) (common.Address, error)
+	Resolve0(opts *bind.CallOpts, _name [32]byte, _allowZeroAddress bool) (common.Address, error)
+	GetMessageMinGasLimit(opts *bind.CallOpts, dataLength *big.Int) (uint32, error)
+	MessageStatus(opts *bind.CallOpts, msgHash [32]byte) (uint8, error)
+}
+
+type rpcCaller interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// bridgeClient calls the bridge of a chain, and simulates processing messages with it.
+type bridgeClient struct {
+	bridgeAddress common.Address
+	bridge        bridgeCaller
+	rpc           rpcCaller
+	caller        bind.ContractCaller
+}
+
+// estimateGas estimates the gas used by processing the message, with the signal
+// service replaced by one accepting any proof.
+func (bc *bridgeClient) estimateGas(ctx context.Context, msg bridge.IBridgeMessage) (uint64, error) {
+	opts := &bind.CallOpts{Context: ctx}
+
+	signalService, err := bc.bridge.Resolve0(opts, bytes32("signal_service"), false)
+	if err != nil {
+		return 0, errors.Wrap(err, "bc.bridge.Resolve0")
+	}
+
+	data, err := encoding.BridgeABI.Pack("processMessage", msg, []byte{})
+	if err != nil {
+		return 0, errors.Wrap(err, "encoding.BridgeABI.Pack")
+	}
+
+	args := map[string]interface{}{
+		"from":  msg.DestOwner,
+		"to":    bc.bridgeAddress,
+		"input": hexutil.Bytes(data),
+	}
+
+	overrides := map[common.Address]map[string]interface{}{
+		signalService: {"code": signalServiceStubCode},
+	}
+
+	var gasUsed hexutil.Uint64
+
+	if err := bc.rpc.CallContext(ctx, &gasUsed, "eth_estimateGas", args, "latest", overrides); err != nil {
+		return 0, errors.Wrap(err, "bc.rpc.CallContext")
+	}
+
+	return uint64(gasUsed), nil
+}
+
+func bytes32(s string) [32]byte {
+	var b [32]byte
+
+	copy(b[:], s)
+
+	return b
+}
@@ -0,0 +1,156 @@
+package http
+
+import (
+	"crypto/subtle"
+	"html"
+	"net/http"
+	"strconv"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+// adminAuth requires the admin token as a bearer token in the Authorization header.
+func (srv *Server) adminAuth() echo.MiddlewareFunc {
+	return middleware.KeyAuthWithConfig(middleware.KeyAuthConfig{
+		Validator: func(key string, c echo.Context) (bool, error) {
+			return subtle.ConstantTimeCompare([]byte(key), []byte(srv.adminToken)) == 1, nil
+		},
+	})
+}
+
+// GetDeadLetters
+//
+//	 returns the dead-lettered messages
+//
+//			@Summary		Get dead letters
+//			@ID			   	get-dead-letters
+//		    @Param			queueName	query		string		false	"queue name to query"
+//		    @Param			status	query		string		false	"status to query"
+//			@Accept			json
+//			@Produce		json
+//			@Security		BearerAuth
+//			@Success		200	{object} paginate.Page
+//			@Router			/admin/deadLetters [get]
+func (srv *Server) GetDeadLetters(c echo.Context) error {
+	queueName := html.EscapeString(c.QueryParam("queueName"))
+
+	statusParam := html.EscapeString(c.QueryParam("status"))
+
+	var status *relayer.DeadLetterStatus
+
+	if statusParam != "" {
+		i, err := strconv.Atoi(statusParam)
+		if err != nil {
+			return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+		}
+
+		s := relayer.DeadLetterStatus(i)
+
+		status = &s
+	}
+
+	page, err := srv.deadLetterRepo.FindAll(
+		c.Request().Context(),
+		c.Request(),
+		relayer.FindAllDeadLettersOpts{
+			QueueName: &queueName,
+			Status:    status,
+		},
+	)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	return c.JSON(http.StatusOK, page)
+}
+
+// GetDeadLetter
+//
+//	 returns the latest dead letter of a message
+//
+//			@Summary		Get dead letter by msgHash
+//			@ID			   	get-dead-letter
+//		    @Param			msgHash	path		string		true	"msgHash of the message"
+//			@Accept			json
+//			@Produce		json
+//			@Security		BearerAuth
+//			@Success		200	{object} relayer.DeadLetter
+//			@Router			/admin/deadLetters/{msgHash} [get]
+func (srv *Server) GetDeadLetter(c echo.Context) error {
+	d, err := srv.deadLetterRepo.FirstByMsgHash(c.Request().Context(), html.EscapeString(c.Param("msgHash")))
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	if d == nil {
+		return webutils.LogAndRenderErrors(c, http.StatusNotFound, ErrDeadLetterNotFound)
+	}
+
+	return c.JSON(http.StatusOK, d)
+}
+
+// RequeueDeadLetter
+//
+//	 requeues the latest dead letter of a message, the processor of its queue will
+//	 publish it to the queue again with its retry count reset.
+//
+//			@Summary		Requeue dead letter by msgHash
+//			@ID			   	requeue-dead-letter
+//		    @Param			msgHash	path		string		true	"msgHash of the message"
+//			@Accept			json
+//			@Produce		json
+//			@Security		BearerAuth
+//			@Success		202	{object} relayer.DeadLetter
+//			@Router			/admin/deadLetters/{msgHash}/requeue [post]
+func (srv *Server) RequeueDeadLetter(c echo.Context) error {
+	d, err := srv.deadLetterRepo.FirstByMsgHash(c.Request().Context(), html.EscapeString(c.Param("msgHash")))
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	if d == nil {
+		return webutils.LogAndRenderErrors(c, http.StatusNotFound, ErrDeadLetterNotFound)
+	}
+
+	if err := srv.deadLetterRepo.UpdateStatus(
+		c.Request().Context(),
+		d.ID,
+		relayer.DeadLetterStatusRequeued,
+	); err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	d.Status = relayer.DeadLetterStatusRequeued
+
+	return c.JSON(http.StatusAccepted, d)
+}
+
+// DiscardDeadLetter
+//
+//	 discards the latest dead letter of a message, which won't be processed anymore.
+//
+//			@Summary		Discard dead letter by msgHash
+//			@ID			   	discard-dead-letter
+//		    @Param			msgHash	path		string		true	"msgHash of the message"
+//			@Security		BearerAuth
+//			@Success		204
+//			@Router			/admin/deadLetters/{msgHash} [delete]
+func (srv *Server) DiscardDeadLetter(c echo.Context) error {
+	d, err := srv.deadLetterRepo.FirstByMsgHash(c.Request().Context(), html.EscapeString(c.Param("msgHash")))
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	if d == nil {
+		return webutils.LogAndRenderErrors(c, http.StatusNotFound, ErrDeadLetterNotFound)
+	}
+
+	if err := srv.deadLetterRepo.Delete(c.Request().Context(), d.ID); err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,134 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cyberhorsey/webutils/testutils"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+func newAdminRequest(method string, path string, token string) *http.Request {
+	req := testutils.NewUnauthenticatedRequest(method, path, nil)
+	if token != "" {
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	}
+
+	return req
+}
+
+func Test_DeadLetters(t *testing.T) {
+	srv := newTestServer("")
+
+	_, err := srv.deadLetterRepo.Save(context.Background(), relayer.SaveDeadLetterOpts{
+		MsgHash:      "0x1",
+		QueueName:    "1-2-MessageSent-queue",
+		Body:         `{"ID":1}`,
+		Error:        "execution reverted",
+		RevertReason: "B_INVALID_STATUS",
+		TimesRetried: 5,
+	})
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name                  string
+		method                string
+		path                  string
+		token                 string
+		wantStatus            int
+		wantBodyRegexpMatches []string
+	}{
+		{
+			"noToken",
+			echo.GET,
+			"/admin/deadLetters",
+			"",
+			http.StatusBadRequest,
+			[]string{``},
+		},
+		{
+			"invalidToken",
+			echo.GET,
+			"/admin/deadLetters",
+			"invalid",
+			http.StatusUnauthorized,
+			[]string{``},
+		},
+		{
+			"list",
+			echo.GET,
+			"/admin/deadLetters?queueName=1-2-MessageSent-queue",
+			testAdminToken,
+			http.StatusOK,
+			[]string{`"msgHash":"0x1"`, `"revertReason":"B_INVALID_STATUS"`, `"timesRetried":5`},
+		},
+		{
+			"inspect",
+			echo.GET,
+			"/admin/deadLetters/0x1",
+			testAdminToken,
+			http.StatusOK,
+			[]string{`"error":"execution reverted"`, `"body":{"ID":1}`},
+		},
+		{
+			"inspectNotFound",
+			echo.GET,
+			"/admin/deadLetters/0x2",
+			testAdminToken,
+			http.StatusNotFound,
+			[]string{`dead letter not found`},
+		},
+		{
+			"requeue",
+			echo.POST,
+			"/admin/deadLetters/0x1/requeue",
+			testAdminToken,
+			http.StatusAccepted,
+			[]string{fmt.Sprintf(`"status":%v`, int(relayer.DeadLetterStatusRequeued))},
+		},
+		{
+			"discard",
+			echo.DELETE,
+			"/admin/deadLetters/0x1",
+			testAdminToken,
+			http.StatusNoContent,
+			[]string{``},
+		},
+		{
+			"discardNotFound",
+			echo.DELETE,
+			"/admin/deadLetters/0x1",
+			testAdminToken,
+			http.StatusNotFound,
+			[]string{`dead letter not found`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+
+			srv.ServeHTTP(rec, newAdminRequest(tt.method, tt.path, tt.token))
+
+			testutils.AssertStatusAndBody(t, rec, tt.wantStatus, tt.wantBodyRegexpMatches)
+		})
+	}
+}
+
+func Test_DeadLetters_NoAdminToken(t *testing.T) {
+	srv := newTestServer("")
+	srv.adminToken = ""
+	srv.echo = echo.New()
+	srv.configureRoutes()
+
+	rec := httptest.NewRecorder()
+
+	srv.ServeHTTP(rec, newAdminRequest(echo.GET, "/admin/deadLetters", ""))
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
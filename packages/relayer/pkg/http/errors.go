@@ -11,4 +11,24 @@ var (
 		"ERR_NO_REWARDER",
 		"Rewarder is required",
 	)
+	ErrDeadLetterNotFound = errors.NotFound.NewWithKeyAndDetail(
+		"ERR_DEAD_LETTER_NOT_FOUND",
+		"dead letter not found",
+	)
+	ErrNoStreamFilter = errors.Validation.NewWithKeyAndDetail(
+		"ERR_NO_STREAM_FILTER",
+		"address or msgHash is required",
+	)
+	ErrInvalidAddress = errors.Validation.NewWithKeyAndDetail(
+		"ERR_INVALID_ADDRESS",
+		"invalid address",
+	)
+	ErrTooManyStreams = errors.Public.NewWithKeyAndDetail(
+		"ERR_TOO_MANY_STREAMS",
+		"too many event streams, retry later",
+	)
+	ErrMessageNotFound = errors.NotFound.NewWithKeyAndDetail(
+		"ERR_MESSAGE_NOT_FOUND",
+		"message not found",
+	)
 )
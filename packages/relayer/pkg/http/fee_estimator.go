@@ -0,0 +1,354 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
+	"golang.org/x/sync/singleflight"
+)
+
+// calldataGasPerByte is the gas cost of a non-zero byte of transaction calldata.
+var calldataGasPerByte uint64 = 16
+
+// gasEstimateTimeout bounds a simulation, which is shared by all the requests waiting on it.
+var gasEstimateTimeout = 30 * time.Second
+
+var (
+	// simulationAddress is the sender, owner and recipient of simulated messages.
+	simulationAddress = common.BytesToAddress(crypto.Keccak256([]byte("relayer.fees.simulation")))
+
+	// simulatedMessageID is the ID of simulated messages, which no sent message has.
+	simulatedMessageID uint64 = math.MaxUint64
+)
+
+// feeTypeEventTypes maps each fee type to the type of the messages it's for.
+var feeTypeEventTypes = map[FeeType]relayer.EventType{
+	Eth:                relayer.EventTypeSendETH,
+	ERC20Deployed:      relayer.EventTypeSendERC20,
+	ERC20NotDeployed:   relayer.EventTypeSendERC20,
+	ERC721Deployed:     relayer.EventTypeSendERC721,
+	ERC721NotDeployed:  relayer.EventTypeSendERC721,
+	ERC1155Deployed:    relayer.EventTypeSendERC1155,
+	ERC1155NotDeployed: relayer.EventTypeSendERC1155,
+}
+
+// vaultNames maps each type of token message to the name of the vault handling it.
+var vaultNames = map[relayer.EventType]string{
+	relayer.EventTypeSendERC20:   "erc20_vault",
+	relayer.EventTypeSendERC721:  "erc721_vault",
+	relayer.EventTypeSendERC1155: "erc1155_vault",
+}
+
+type gasEstimateKey struct {
+	destChainID uint64
+	feeType     FeeType
+}
+
+type gasEstimate struct {
+	gasLimit    uint64
+	estimatedAt time.Time
+}
+
+// feeEstimator estimates the gas limit of each fee type, by simulating processing a
+// representative message of the fee type on the destination chain, and caches the
+// estimates for a while. Concurrent requests for the same uncached estimate share a
+// single simulation.
+type feeEstimator struct {
+	eventRepo relayer.EventRepository
+	ttl       time.Duration
+	mu        *sync.Mutex
+	estimates map[gasEstimateKey]gasEstimate
+	group     singleflight.Group
+	now       func() time.Time
+}
+
+func newFeeEstimator(eventRepo relayer.EventRepository, ttl time.Duration) *feeEstimator {
+	return &feeEstimator{
+		eventRepo: eventRepo,
+		ttl:       ttl,
+		mu:        &sync.Mutex{},
+		estimates: make(map[gasEstimateKey]gasEstimate),
+		now:       time.Now,
+	}
+}
+
+// gasLimit returns the gas limit to recommend for messages of the fee type, from the
+// source to the destination chain. If it can't be estimated, the static gas limit of
+// the fee type is returned.
+func (fe *feeEstimator) gasLimit(
+	ctx context.Context,
+	bc *bridgeClient,
+	srcChainID uint64,
+	destChainID uint64,
+	feeType FeeType,
+) uint64 {
+	if fe == nil || bc == nil {
+		return uint64(feeType)
+	}
+
+	key := gasEstimateKey{destChainID: destChainID, feeType: feeType}
+
+	if gasLimit, ok := fe.cached(key); ok {
+		return gasLimit
+	}
+
+	// the lock isn't held while simulating, so estimates of other keys aren't blocked.
+	// the shared simulation isn't tied to the request which started it, so it isn't
+	// cancelled for the other requests waiting on it when that request goes away.
+	ch := fe.group.DoChan(fmt.Sprintf("%d-%d", key.destChainID, key.feeType), func() (interface{}, error) {
+		if gasLimit, ok := fe.cached(key); ok {
+			return gasLimit, nil
+		}
+
+		estimateCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), gasEstimateTimeout)
+		defer cancel()
+
+		gasLimit, err := fe.estimateGasLimit(estimateCtx, bc, srcChainID, destChainID, feeType)
+		if err != nil {
+			return nil, err
+		}
+
+		fe.mu.Lock()
+		fe.estimates[key] = gasEstimate{gasLimit: gasLimit, estimatedAt: fe.now()}
+		fe.mu.Unlock()
+
+		return gasLimit, nil
+	})
+
+	var err error
+
+	select {
+	case <-ctx.Done():
+		err = ctx.Err()
+	case res := <-ch:
+		if res.Err == nil {
+			return res.Val.(uint64)
+		}
+
+		err = res.Err
+	}
+
+	slog.Warn("error estimating gas limit, using static gas limit",
+		"feeType", feeType.String(),
+		"destChainID", destChainID,
+		"error", err,
+	)
+
+	return uint64(feeType)
+}
+
+// cached returns the cached gas limit estimate of the key, if it hasn't expired.
+func (fe *feeEstimator) cached(key gasEstimateKey) (uint64, bool) {
+	fe.mu.Lock()
+	defer fe.mu.Unlock()
+
+	estimate, ok := fe.estimates[key]
+	if !ok || fe.now().Sub(estimate.estimatedAt) >= fe.ttl {
+		return 0, false
+	}
+
+	return estimate.gasLimit, true
+}
+
+// estimateGasLimit simulates processing a message of the fee type, and returns the
+// gas limit it needs: the bridge's minimum gas limit for its data, which covers
+// proving it, and the gas used to process it on top.
+func (fe *feeEstimator) estimateGasLimit(
+	ctx context.Context,
+	bc *bridgeClient,
+	srcChainID uint64,
+	destChainID uint64,
+	feeType FeeType,
+) (uint64, error) {
+	msg, err := fe.simulatedMessage(ctx, bc, srcChainID, destChainID, feeType)
+	if err != nil {
+		return 0, err
+	}
+
+	minGasLimit, err := bc.bridge.GetMessageMinGasLimit(
+		&bind.CallOpts{Context: ctx},
+		big.NewInt(int64(len(msg.Data))),
+	)
+	if err != nil {
+		return 0, errors.Wrap(err, "bc.bridge.GetMessageMinGasLimit")
+	}
+
+	gasUsed, err := bc.estimateGas(ctx, msg)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(minGasLimit) + gasUsed, nil
+}
+
+// simulatedMessage builds a representative message of the fee type. Token messages
+// of the "not deployed" fee types transfer a token no one has bridged yet, so its
+// bridged token has to be deployed, while the others transfer the token of the
+// latest processed message of their type.
+func (fe *feeEstimator) simulatedMessage(
+	ctx context.Context,
+	bc *bridgeClient,
+	srcChainID uint64,
+	destChainID uint64,
+	feeType FeeType,
+) (bridge.IBridgeMessage, error) {
+	// the message is owned by its sender, who processes it without a gas limit, so
+	// the simulation uses exactly the gas it needs.
+	msg := bridge.IBridgeMessage{
+		Id:          simulatedMessageID,
+		From:        simulationAddress,
+		SrcChainId:  srcChainID,
+		SrcOwner:    simulationAddress,
+		DestChainId: destChainID,
+		DestOwner:   simulationAddress,
+		To:          simulationAddress,
+		Value:       big.NewInt(1),
+	}
+
+	eventType := feeTypeEventTypes[feeType]
+	if eventType == relayer.EventTypeSendETH {
+		return msg, nil
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+
+	name := bytes32(vaultNames[eventType])
+
+	srcVault, err := bc.bridge.Resolve(opts, srcChainID, name, false)
+	if err != nil {
+		return msg, errors.Wrap(err, "bc.bridge.Resolve")
+	}
+
+	destVault, err := bc.bridge.Resolve0(opts, name, false)
+	if err != nil {
+		return msg, errors.Wrap(err, "bc.bridge.Resolve0")
+	}
+
+	ctoken, err := fe.simulatedToken(ctx, srcChainID, destChainID, feeType, eventType)
+	if err != nil {
+		return msg, err
+	}
+
+	// a new token ID, so it can be minted.
+	tokenIDs := []*big.Int{new(big.Int).SetBytes(crypto.Keccak256(simulationAddress.Bytes()))}
+
+	switch eventType {
+	case relayer.EventTypeSendERC20:
+		msg.Data, err = encoding.EncodeERC20MessageData(
+			relayer.CanonicalERC20{
+				ChainId:  ctoken.ChainID(),
+				Addr:     ctoken.Address(),
+				Decimals: ctoken.TokenDecimals(),
+				Symbol:   ctoken.ContractSymbol(),
+				Name:     ctoken.ContractName(),
+			},
+			simulationAddress,
+			simulationAddress,
+			big.NewInt(1),
+		)
+	case relayer.EventTypeSendERC721:
+		msg.Data, err = encoding.EncodeERC721MessageData(
+			canonicalNFT(ctoken),
+			simulationAddress,
+			simulationAddress,
+			tokenIDs,
+		)
+	case relayer.EventTypeSendERC1155:
+		msg.Data, err = encoding.EncodeERC1155MessageData(
+			canonicalNFT(ctoken),
+			simulationAddress,
+			simulationAddress,
+			tokenIDs,
+			[]*big.Int{big.NewInt(1)},
+		)
+	}
+
+	if err != nil {
+		return msg, err
+	}
+
+	msg.From = srcVault
+	msg.To = destVault
+	msg.Value = big.NewInt(0)
+
+	return msg, nil
+}
+
+// simulatedToken returns the canonical token a simulated message of the fee type
+// transfers. It must be a token of the source chain, so it's minted on the
+// destination chain, as the vault may not hold any of a token of the destination chain.
+func (fe *feeEstimator) simulatedToken(
+	ctx context.Context,
+	srcChainID uint64,
+	destChainID uint64,
+	feeType FeeType,
+	eventType relayer.EventType,
+) (relayer.CanonicalToken, error) {
+	switch feeType {
+	case ERC20NotDeployed, ERC721NotDeployed, ERC1155NotDeployed:
+		return relayer.CanonicalERC20{
+			ChainId:  srcChainID,
+			Addr:     common.BytesToAddress(crypto.Keccak256(simulationAddress.Bytes(), []byte(feeType.String()))),
+			Decimals: 18,
+			Symbol:   "SIM",
+			Name:     "Simulated Token",
+		}, nil
+	}
+
+	event, err := fe.eventRepo.FindLatestByEventType(
+		ctx,
+		relayer.EventNameMessageSent,
+		eventType,
+		relayer.EventStatusDone,
+		srcChainID,
+		destChainID,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "fe.eventRepo.FindLatestByEventType")
+	}
+
+	if event == nil {
+		return nil, errors.New("no processed message of the fee type yet")
+	}
+
+	// only the message of the stored event is needed.
+	msgSentEvent := &struct {
+		Message bridge.IBridgeMessage
+	}{}
+	if err := json.Unmarshal(event.Data, msgSentEvent); err != nil {
+		return nil, errors.Wrap(err, "json.Unmarshal")
+	}
+
+	_, ctoken, _, err := relayer.DecodeMessageData(msgSentEvent.Message.Data, msgSentEvent.Message.Value)
+	if err != nil {
+		return nil, errors.Wrap(err, "relayer.DecodeMessageData")
+	}
+
+	if ctoken == nil || ctoken.ChainID() != srcChainID {
+		return nil, errors.New("latest processed message of the fee type isn't for a token of the source chain")
+	}
+
+	return ctoken, nil
+}
+
+func canonicalNFT(ctoken relayer.CanonicalToken) relayer.CanonicalNFT {
+	return relayer.CanonicalNFT{
+		ChainId: ctoken.ChainID(),
+		Addr:    ctoken.Address(),
+		Symbol:  ctoken.ContractSymbol(),
+		Name:    ctoken.ContractName(),
+	}
+}
@@ -0,0 +1,263 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+var (
+	testSignalService = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	testVault         = common.HexToAddress("0x1000000000000000000000000000000000000002")
+	testMinGasLimit   = uint32(800_000)
+	testGasUsed       = uint64(200_000)
+)
+
+type fakeBridgeCaller struct {
+	status       relayer.EventStatus
+	quotaManager common.Address
+}
+
+func (b *fakeBridgeCaller) Resolve(
+	opts *bind.CallOpts,
+	_chainId uint64,
+	_name [32]byte,
+	_allowZeroAddress bool,
+) (common.Address, error) {
+	return testVault, nil
+}
+
+func (b *fakeBridgeCaller) Resolve0(opts *bind.CallOpts, _name [32]byte, _allowZeroAddress bool) (common.Address, error) {
+	switch _name {
+	case bytes32("signal_service"):
+		return testSignalService, nil
+	case bytes32("quota_manager"):
+		return b.quotaManager, nil
+	}
+
+	return testVault, nil
+}
+
+func (b *fakeBridgeCaller) GetMessageMinGasLimit(opts *bind.CallOpts, dataLength *big.Int) (uint32, error) {
+	return testMinGasLimit, nil
+}
+
+func (b *fakeBridgeCaller) MessageStatus(opts *bind.CallOpts, msgHash [32]byte) (uint8, error) {
+	return uint8(b.status), nil
+}
+
+type fakeRPCCaller struct {
+	calls    int
+	err      error
+	block    chan struct{}
+	ctxErr   error
+	args     map[string]interface{}
+	override map[common.Address]map[string]interface{}
+}
+
+func (c *fakeRPCCaller) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	c.calls++
+
+	if c.block != nil {
+		<-c.block
+	}
+
+	c.ctxErr = ctx.Err()
+
+	if c.err != nil {
+		return c.err
+	}
+
+	c.args = args[0].(map[string]interface{})
+	c.override = args[2].(map[common.Address]map[string]interface{})
+
+	*result.(*hexutil.Uint64) = hexutil.Uint64(testGasUsed)
+
+	return nil
+}
+
+func newTestBridgeClient() (*bridgeClient, *fakeRPCCaller) {
+	rpc := &fakeRPCCaller{}
+
+	return &bridgeClient{
+		bridgeAddress: common.HexToAddress("0x1000000000000000000000000000000000000003"),
+		bridge:        &fakeBridgeCaller{},
+		rpc:           rpc,
+	}, rpc
+}
+
+func Test_feeEstimator_NoBridge(t *testing.T) {
+	fe := newFeeEstimator(mock.NewEventRepository(), time.Minute)
+
+	assert.Equal(t, uint64(Eth), fe.gasLimit(context.Background(), nil, 1, 2, Eth))
+}
+
+func Test_feeEstimator_Cache(t *testing.T) {
+	fe := newFeeEstimator(mock.NewEventRepository(), time.Minute)
+
+	now := time.Now()
+	fe.now = func() time.Time { return now }
+
+	bc, rpc := newTestBridgeClient()
+
+	want := uint64(testMinGasLimit) + testGasUsed
+
+	assert.Equal(t, want, fe.gasLimit(context.Background(), bc, 1, 2, ERC20NotDeployed))
+	assert.Equal(t, want, fe.gasLimit(context.Background(), bc, 1, 2, ERC20NotDeployed))
+	assert.Equal(t, 1, rpc.calls)
+
+	// the signal service accepts any proof during the simulation.
+	assert.Equal(t, signalServiceStubCode, rpc.override[testSignalService]["code"])
+	assert.Equal(t, bc.bridgeAddress, rpc.args["to"])
+
+	now = now.Add(2 * time.Minute)
+
+	assert.Equal(t, want, fe.gasLimit(context.Background(), bc, 1, 2, ERC20NotDeployed))
+	assert.Equal(t, 2, rpc.calls)
+}
+
+func Test_feeEstimator_ConcurrentEstimates(t *testing.T) {
+	fe := newFeeEstimator(mock.NewEventRepository(), time.Minute)
+
+	bc, rpc := newTestBridgeClient()
+	rpc.block = make(chan struct{})
+
+	want := uint64(testMinGasLimit) + testGasUsed
+
+	wg := &sync.WaitGroup{}
+
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			assert.Equal(t, want, fe.gasLimit(context.Background(), bc, 1, 2, Eth))
+		}()
+	}
+
+	// the estimates of other keys aren't blocked by a pending simulation.
+	other, _ := newTestBridgeClient()
+
+	assert.Equal(t, want, fe.gasLimit(context.Background(), other, 1, 3, Eth))
+
+	close(rpc.block)
+	wg.Wait()
+
+	// concurrent requests for the same estimate share a single simulation.
+	assert.Equal(t, 1, rpc.calls)
+}
+
+func Test_feeEstimator_CancelledRequest(t *testing.T) {
+	fe := newFeeEstimator(mock.NewEventRepository(), time.Minute)
+
+	bc, rpc := newTestBridgeClient()
+	rpc.block = make(chan struct{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cancelled := make(chan uint64)
+
+	go func() {
+		cancelled <- fe.gasLimit(ctx, bc, 1, 2, Eth)
+	}()
+
+	waiting := make(chan uint64)
+
+	go func() {
+		// waits for the simulation started by the request above.
+		time.Sleep(50 * time.Millisecond)
+		waiting <- fe.gasLimit(context.Background(), bc, 1, 2, Eth)
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+
+	// the cancelled request falls back to the static gas limit, without waiting.
+	cancel()
+	assert.Equal(t, uint64(Eth), <-cancelled)
+
+	// the simulation isn't cancelled along with the request which started it.
+	close(rpc.block)
+	assert.Equal(t, uint64(testMinGasLimit)+testGasUsed, <-waiting)
+	assert.Nil(t, rpc.ctxErr)
+	assert.Equal(t, 1, rpc.calls)
+}
+
+func Test_feeEstimator_StaticGasLimitOnError(t *testing.T) {
+	fe := newFeeEstimator(mock.NewEventRepository(), time.Minute)
+
+	bc, rpc := newTestBridgeClient()
+	rpc.err = errors.New("execution reverted")
+
+	assert.Equal(t, uint64(ERC721NotDeployed), fe.gasLimit(context.Background(), bc, 1, 2, ERC721NotDeployed))
+
+	// failed estimates aren't cached.
+	rpc.err = nil
+
+	assert.Equal(
+		t,
+		uint64(testMinGasLimit)+testGasUsed,
+		fe.gasLimit(context.Background(), bc, 1, 2, ERC721NotDeployed),
+	)
+}
+
+func Test_feeEstimator_DeployedToken(t *testing.T) {
+	eventRepo := mock.NewEventRepository()
+	fe := newFeeEstimator(eventRepo, time.Minute)
+
+	bc, rpc := newTestBridgeClient()
+
+	// no message of the fee type was processed yet.
+	assert.Equal(t, uint64(ERC20Deployed), fe.gasLimit(context.Background(), bc, 1, 2, ERC20Deployed))
+	assert.Equal(t, 0, rpc.calls)
+
+	ctoken := relayer.CanonicalERC20{
+		ChainId:  1,
+		Addr:     common.HexToAddress("0x2000000000000000000000000000000000000001"),
+		Decimals: 6,
+		Symbol:   "FAKE",
+		Name:     "Fake Token",
+	}
+
+	data, err := encoding.EncodeERC20MessageData(ctoken, simulationAddress, simulationAddress, big.NewInt(1))
+	assert.Nil(t, err)
+
+	marshaled, err := json.Marshal(&bridge.BridgeMessageSent{
+		Message: bridge.IBridgeMessage{Data: data, Value: big.NewInt(0)},
+	})
+	assert.Nil(t, err)
+
+	_, err = eventRepo.Save(context.Background(), relayer.SaveEventOpts{
+		Name:        relayer.EventNameMessageSent,
+		Event:       relayer.EventNameMessageSent,
+		Data:        string(marshaled),
+		ChainID:     big.NewInt(1),
+		DestChainID: big.NewInt(2),
+		Status:      relayer.EventStatusDone,
+		EventType:   relayer.EventTypeSendERC20,
+	})
+	assert.Nil(t, err)
+
+	msg, err := fe.simulatedMessage(context.Background(), bc, 1, 2, ERC20Deployed)
+	assert.Nil(t, err)
+
+	_, simulated, _, err := relayer.DecodeMessageData(msg.Data, msg.Value)
+	assert.Nil(t, err)
+	assert.Equal(t, ctoken, simulated)
+	assert.Equal(t, testVault, msg.To)
+	assert.Equal(t, simulationAddress, msg.DestOwner)
+	assert.Equal(t, uint32(0), msg.GasLimit)
+}
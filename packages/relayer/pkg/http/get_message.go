@@ -0,0 +1,432 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/quotamanager"
+)
+
+var (
+	stepStatusDone    = "done"
+	stepStatusPending = "pending"
+	stepStatusFailed  = "failed"
+)
+
+var (
+	stepSent          = "sent"
+	stepConfirmations = "confirmations"
+	stepHeaderSynced  = "headerSynced"
+	stepQuota         = "quota"
+	stepStatusChanged = "statusChanged"
+	stepDeadLetter    = "deadLetter"
+	stepProcessed     = "processed"
+)
+
+// blockerProcessing is the blocker of a message all steps before processing are done for.
+var blockerProcessing = "processing"
+
+type timelineStep struct {
+	Step    string `json:"step"`
+	Status  string `json:"status"`
+	ChainID int64  `json:"chainID,omitempty"`
+	TxHash  string `json:"txHash,omitempty"`
+	BlockID uint64 `json:"blockID,omitempty"`
+	Detail  string `json:"detail,omitempty"`
+}
+
+type getMessageResponse struct {
+	MsgHash     string         `json:"msgHash"`
+	SrcChainID  int64          `json:"srcChainID"`
+	DestChainID int64          `json:"destChainID"`
+	EventType   string         `json:"eventType"`
+	Status      string         `json:"status"`
+	Live        bool           `json:"live"`
+	Blocker     string         `json:"blocker"`
+	Timeline    []timelineStep `json:"timeline"`
+}
+
+// storedEvent is the part of the data of a stored bridge event the timeline shows.
+type storedEvent struct {
+	Message bridge.IBridgeMessage
+	Raw     struct {
+		TxHash common.Hash `json:"transactionHash"`
+	}
+}
+
+// GetMessage
+//
+//	 returns the lifecycle of a message: a timeline of its stored events, joined with
+//	 the steps the processor waits on before processing it, its live status on the
+//	 destination chain, and the step it's currently blocked on.
+//
+//			@Summary		Get message lifecycle by msgHash
+//			@ID			   	get-message
+//		    @Param			msgHash	path		string		true	"msgHash of the message"
+//			@Accept			json
+//			@Produce		json
+//			@Success		200	{object} getMessageResponse
+//			@Router			/message/{msgHash} [get]
+func (srv *Server) GetMessage(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	msgHash := html.EscapeString(c.Param("msgHash"))
+
+	events, err := srv.eventRepo.FindAllByMsgHash(ctx, msgHash)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	var sent *relayer.Event
+
+	for _, e := range events {
+		if e.Event == relayer.EventNameMessageSent {
+			sent = e
+			break
+		}
+	}
+
+	if sent == nil {
+		return webutils.LogAndRenderErrors(c, http.StatusNotFound, ErrMessageNotFound)
+	}
+
+	resp, err := srv.messageLifecycle(ctx, sent, events)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// messageLifecycle builds the timeline of the message of the MessageSent event, from
+// all the stored events of the message.
+func (srv *Server) messageLifecycle(
+	ctx context.Context,
+	sent *relayer.Event,
+	events []*relayer.Event,
+) (*getMessageResponse, error) {
+	msgSent := &storedEvent{}
+	if err := json.Unmarshal(sent.Data, msgSent); err != nil {
+		return nil, errors.Wrap(err, "json.Unmarshal")
+	}
+
+	srcEthClient, destBridge, err := srv.messageClients(ctx, sent)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &getMessageResponse{
+		MsgHash:     sent.MsgHash,
+		SrcChainID:  sent.ChainID,
+		DestChainID: sent.DestChainID,
+		EventType:   sent.EventType.String(),
+		Timeline:    make([]timelineStep, 0),
+	}
+
+	status := sent.Status
+
+	if destBridge != nil {
+		s, err := destBridge.bridge.MessageStatus(&bind.CallOpts{Context: ctx}, common.HexToHash(sent.MsgHash))
+		if err != nil {
+			return nil, errors.Wrap(err, "destBridge.bridge.MessageStatus")
+		}
+
+		status = relayer.EventStatus(s)
+		resp.Live = true
+	}
+
+	resp.Status = status.String()
+
+	resp.Timeline = append(resp.Timeline, timelineStep{
+		Step:    stepSent,
+		Status:  stepStatusDone,
+		ChainID: sent.ChainID,
+		TxHash:  msgSent.Raw.TxHash.Hex(),
+		BlockID: sent.EmittedBlockID,
+	})
+
+	latestBlock, err := srcEthClient.BlockNumber(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "srcEthClient.BlockNumber")
+	}
+
+	var confirmations uint64
+	if latestBlock > sent.EmittedBlockID {
+		confirmations = latestBlock - sent.EmittedBlockID
+	}
+
+	resp.Timeline = append(resp.Timeline, timelineStep{
+		Step:    stepConfirmations,
+		Status:  stepStatus(confirmations >= srv.confirmations),
+		ChainID: sent.ChainID,
+		Detail:  fmt.Sprintf("%v of %v confirmations", confirmations, srv.confirmations),
+	})
+
+	headerSynced, err := srv.headerSyncedStep(ctx, sent)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Timeline = append(resp.Timeline, *headerSynced)
+
+	// the quota is only waited on before a message is first processed.
+	if destBridge != nil && status == relayer.EventStatusNew {
+		quota, err := quotaStep(ctx, destBridge, sent.DestChainID, msgSent.Message)
+		if err != nil {
+			return nil, err
+		}
+
+		if quota != nil {
+			resp.Timeline = append(resp.Timeline, *quota)
+		}
+	}
+
+	var processed *relayer.Event
+
+	for _, e := range events {
+		switch e.Event {
+		case relayer.EventNameMessageStatusChanged:
+			step, err := eventStep(stepStatusChanged, e)
+			if err != nil {
+				return nil, err
+			}
+
+			step.Detail = e.Status.String()
+
+			if e.Status == relayer.EventStatusFailed {
+				step.Status = stepStatusFailed
+			}
+
+			resp.Timeline = append(resp.Timeline, *step)
+		case relayer.EventNameMessageProcessed:
+			processed = e
+		}
+	}
+
+	var deadLetter *relayer.DeadLetter
+
+	if srv.deadLetterRepo != nil {
+		deadLetter, err = srv.deadLetterRepo.FirstByMsgHash(ctx, sent.MsgHash)
+		if err != nil {
+			return nil, errors.Wrap(err, "srv.deadLetterRepo.FirstByMsgHash")
+		}
+	}
+
+	if deadLetter != nil {
+		step := timelineStep{
+			Step:   stepDeadLetter,
+			Status: stepStatusFailed,
+			Detail: fmt.Sprintf(
+				"processing failed after %v retries: %v %v",
+				deadLetter.TimesRetried,
+				deadLetter.Error,
+				deadLetter.RevertReason,
+			),
+		}
+
+		if deadLetter.Status == relayer.DeadLetterStatusRequeued {
+			step.Status = stepStatusPending
+			step.Detail = "requeued, " + step.Detail
+		}
+
+		resp.Timeline = append(resp.Timeline, step)
+	}
+
+	if processed != nil {
+		step, err := eventStep(stepProcessed, processed)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Timeline = append(resp.Timeline, *step)
+	}
+
+	resp.Blocker = messageBlocker(status, resp.Timeline)
+
+	return resp, nil
+}
+
+// messageClients returns the eth client of the source chain of the message, and the
+// bridge of its destination chain, which is nil if it isn't configured.
+func (srv *Server) messageClients(ctx context.Context, sent *relayer.Event) (ethClient, *bridgeClient, error) {
+	srcChainID, err := srv.srcEthClient.ChainID(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "srv.srcEthClient.ChainID")
+	}
+
+	if srcChainID.Int64() == sent.ChainID {
+		return srv.srcEthClient, srv.destBridge, nil
+	}
+
+	return srv.destEthClient, srv.srcBridge, nil
+}
+
+// headerSyncedStep returns whether the block the message was sent in has been synced
+// to the destination chain, so the message can be proven.
+func (srv *Server) headerSyncedStep(ctx context.Context, sent *relayer.Event) (*timelineStep, error) {
+	synced, err := srv.eventRepo.ChainDataSyncedEventByBlockNumberOrGreater(
+		ctx,
+		uint64(sent.DestChainID),
+		uint64(sent.ChainID),
+		sent.EmittedBlockID,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "srv.eventRepo.ChainDataSyncedEventByBlockNumberOrGreater")
+	}
+
+	if synced != nil {
+		return &timelineStep{
+			Step:    stepHeaderSynced,
+			Status:  stepStatusDone,
+			ChainID: sent.DestChainID,
+			BlockID: synced.SyncedInBlockID,
+			Detail:  fmt.Sprintf("block %v synced", synced.BlockID),
+		}, nil
+	}
+
+	latestSynced, err := srv.eventRepo.LatestChainDataSyncedEvent(
+		ctx,
+		uint64(sent.DestChainID),
+		uint64(sent.ChainID),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "srv.eventRepo.LatestChainDataSyncedEvent")
+	}
+
+	return &timelineStep{
+		Step:    stepHeaderSynced,
+		Status:  stepStatusPending,
+		ChainID: sent.DestChainID,
+		Detail:  fmt.Sprintf("latest synced block is %v, waiting for block %v", latestSynced, sent.EmittedBlockID),
+	}, nil
+}
+
+// quotaStep returns whether the quota manager of the destination chain has enough quota
+// available to process the message, or nil if there's no quota manager, or the message
+// transfers NFTs, which have no quota.
+func quotaStep(
+	ctx context.Context,
+	bc *bridgeClient,
+	destChainID int64,
+	msg bridge.IBridgeMessage,
+) (*timelineStep, error) {
+	opts := &bind.CallOpts{Context: ctx}
+
+	quotaManagerAddress, err := bc.bridge.Resolve0(opts, bytes32("quota_manager"), true)
+	if err != nil {
+		return nil, errors.Wrap(err, "bc.bridge.Resolve0")
+	}
+
+	if quotaManagerAddress == relayer.ZeroAddress {
+		return nil, nil
+	}
+
+	eventType, canonicalToken, amount, err := relayer.DecodeMessageData(msg.Data, msg.Value)
+	if err != nil {
+		return nil, errors.Wrap(err, "relayer.DecodeMessageData")
+	}
+
+	if eventType != relayer.EventTypeSendETH && eventType != relayer.EventTypeSendERC20 {
+		return nil, nil
+	}
+
+	// default to ETH (zero address) and msg value, overwrite if ERC20
+	tokenAddress := relayer.ZeroAddress
+
+	value := msg.Value
+
+	if eventType == relayer.EventTypeSendERC20 {
+		tokenAddress = canonicalToken.Address()
+		value = amount
+	}
+
+	quotaManager, err := quotamanager.NewQuotaManagerCaller(quotaManagerAddress, bc.caller)
+	if err != nil {
+		return nil, errors.Wrap(err, "quotamanager.NewQuotaManagerCaller")
+	}
+
+	available, err := quotaManager.AvailableQuota(opts, tokenAddress, common.Big0)
+	if err != nil {
+		return nil, errors.Wrap(err, "quotaManager.AvailableQuota")
+	}
+
+	step := &timelineStep{
+		Step:    stepQuota,
+		Status:  stepStatusDone,
+		ChainID: destChainID,
+		Detail:  fmt.Sprintf("%v available, %v required", available, value),
+	}
+
+	if available.Cmp(value) == -1 {
+		period, err := quotaManager.QuotaPeriod(opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "quotaManager.QuotaPeriod")
+		}
+
+		step.Status = stepStatusPending
+		step.Detail = fmt.Sprintf("%v, quota period is %v seconds", step.Detail, period)
+	}
+
+	return step, nil
+}
+
+// eventStep returns the step of a stored event, emitted in a transaction.
+func eventStep(name string, e *relayer.Event) (*timelineStep, error) {
+	stored := &storedEvent{}
+	if err := json.Unmarshal(e.Data, stored); err != nil {
+		return nil, errors.Wrap(err, "json.Unmarshal")
+	}
+
+	return &timelineStep{
+		Step:    name,
+		Status:  stepStatusDone,
+		ChainID: e.ChainID,
+		TxHash:  stored.Raw.TxHash.Hex(),
+		BlockID: e.EmittedBlockID,
+	}, nil
+}
+
+// messageBlocker returns what the message is waiting on to be processed: the first
+// pending step before processing, the dead letter it's in, or the processor itself.
+// Messages which are done, failed or recalled aren't blocked, and retriable ones
+// wait on their owner to retry them.
+func messageBlocker(status relayer.EventStatus, timeline []timelineStep) string {
+	switch status {
+	case relayer.EventStatusDone, relayer.EventStatusFailed, relayer.EventStatusRecalled:
+		return ""
+	case relayer.EventStatusRetriable:
+		return relayer.EventStatusRetriable.String()
+	}
+
+	for _, step := range timeline {
+		if step.Status == stepStatusPending && step.Step != stepDeadLetter {
+			return step.Step
+		}
+	}
+
+	for _, step := range timeline {
+		if step.Step == stepDeadLetter && step.Status == stepStatusFailed {
+			return stepDeadLetter
+		}
+	}
+
+	return blockerProcessing
+}
+
+func stepStatus(done bool) string {
+	if done {
+		return stepStatusDone
+	}
+
+	return stepStatusPending
+}
@@ -0,0 +1,172 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cyberhorsey/webutils/testutils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+var (
+	testMsgHash   = common.HexToHash("0x1").Hex()
+	testSentTx    = common.HexToHash("0x2")
+	testProcessTx = common.HexToHash("0x3")
+)
+
+func newTestMessageServer(t *testing.T) *Server {
+	srv := newTestServer("")
+	srv.srcEthClient = &mock.EthClient{}
+	srv.destEthClient = &mock.EthClient{}
+
+	marshaled, err := json.Marshal(&bridge.BridgeMessageSent{
+		Message: bridge.IBridgeMessage{Value: big.NewInt(1)},
+		Raw:     types.Log{TxHash: testSentTx},
+	})
+	assert.Nil(t, err)
+
+	_, err = srv.eventRepo.Save(context.Background(), relayer.SaveEventOpts{
+		Name:           relayer.EventNameMessageSent,
+		Event:          relayer.EventNameMessageSent,
+		Data:           string(marshaled),
+		ChainID:        mock.MockChainID,
+		DestChainID:    big.NewInt(2),
+		Status:         relayer.EventStatusNew,
+		MsgHash:        testMsgHash,
+		EmittedBlockID: 5,
+	})
+	assert.Nil(t, err)
+
+	return srv
+}
+
+func Test_GetMessage(t *testing.T) {
+	tests := []struct {
+		name                  string
+		path                  string
+		setup                 func(t *testing.T, srv *Server)
+		wantStatus            int
+		wantBodyRegexpMatches []string
+	}{
+		{
+			"notFound",
+			"/message/0x4",
+			func(t *testing.T, srv *Server) {},
+			http.StatusNotFound,
+			[]string{`message not found`},
+		},
+		{
+			"processing",
+			"/message/" + testMsgHash,
+			func(t *testing.T, srv *Server) {},
+			http.StatusOK,
+			[]string{
+				`"status":"new"`,
+				`"live":false`,
+				`"blocker":"processing"`,
+				`"step":"sent","status":"done","chainID":167001,"txHash":"` + testSentTx.Hex() + `","blockID":5`,
+				`"step":"headerSynced","status":"done"`,
+			},
+		},
+		{
+			"waitingForConfirmations",
+			"/message/" + testMsgHash,
+			func(t *testing.T, srv *Server) {
+				srv.confirmations = 10
+			},
+			http.StatusOK,
+			[]string{
+				`"blocker":"confirmations"`,
+				`"step":"confirmations","status":"pending","chainID":167001,"detail":"5 of 10 confirmations"`,
+			},
+		},
+		{
+			"deadLetter",
+			"/message/" + testMsgHash,
+			func(t *testing.T, srv *Server) {
+				_, err := srv.deadLetterRepo.Save(context.Background(), relayer.SaveDeadLetterOpts{
+					MsgHash:      testMsgHash,
+					Error:        "execution reverted",
+					RevertReason: "B_INVALID_STATUS",
+					TimesRetried: 5,
+				})
+				assert.Nil(t, err)
+			},
+			http.StatusOK,
+			[]string{
+				`"blocker":"deadLetter"`,
+				`"step":"deadLetter","status":"failed","detail":"processing failed after 5 retries: ` +
+					`execution reverted B_INVALID_STATUS"`,
+			},
+		},
+		{
+			"processed",
+			"/message/" + testMsgHash,
+			func(t *testing.T, srv *Server) {
+				bc, _ := newTestBridgeClient()
+				bc.bridge = &fakeBridgeCaller{status: relayer.EventStatusDone}
+				srv.destBridge = bc
+
+				marshaled, err := json.Marshal(&bridge.BridgeMessageProcessed{
+					Raw: types.Log{TxHash: testProcessTx},
+				})
+				assert.Nil(t, err)
+
+				_, err = srv.eventRepo.Save(context.Background(), relayer.SaveEventOpts{
+					Name:           relayer.EventNameMessageProcessed,
+					Event:          relayer.EventNameMessageProcessed,
+					Data:           string(marshaled),
+					ChainID:        big.NewInt(2),
+					DestChainID:    mock.MockChainID,
+					Status:         relayer.EventStatusDone,
+					MsgHash:        testMsgHash,
+					EmittedBlockID: 7,
+				})
+				assert.Nil(t, err)
+			},
+			http.StatusOK,
+			[]string{
+				`"status":"done"`,
+				`"live":true`,
+				`"blocker":""`,
+				`"step":"processed","status":"done","chainID":2,"txHash":"` + testProcessTx.Hex() + `","blockID":7`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newTestMessageServer(t)
+
+			tt.setup(t, srv)
+
+			rec := httptest.NewRecorder()
+
+			srv.ServeHTTP(rec, testutils.NewUnauthenticatedRequest(echo.GET, tt.path, nil))
+
+			testutils.AssertStatusAndBody(t, rec, tt.wantStatus, tt.wantBodyRegexpMatches)
+		})
+	}
+}
+
+func Test_messageBlocker(t *testing.T) {
+	timeline := []timelineStep{
+		{Step: stepSent, Status: stepStatusDone},
+		{Step: stepHeaderSynced, Status: stepStatusPending},
+		{Step: stepQuota, Status: stepStatusPending},
+	}
+
+	assert.Equal(t, stepHeaderSynced, messageBlocker(relayer.EventStatusNew, timeline))
+	assert.Equal(t, "retriable", messageBlocker(relayer.EventStatusRetriable, timeline))
+	assert.Equal(t, "", messageBlocker(relayer.EventStatusRecalled, timeline))
+}
@@ -24,9 +24,12 @@ type fee struct {
 	GasLimit    string `json:"gasLimit"`
 }
 
+// FeeType is a kind of message processing fees are recommended for. Its value is the
+// static gas limit recommended when the gas limit can't be estimated by simulating
+// processing a message.
 type FeeType uint64
 
-// gas limits
+// static gas limits
 var (
 	Eth                FeeType = 900000
 	ERC20NotDeployed   FeeType = 1650000
@@ -120,18 +123,38 @@ func (srv *Server) GetRecommendedProcessingFees(c echo.Context) error {
 	}
 
 	for _, f := range feeTypes {
+		// messages from the destination chain are processed on the source chain, the L1.
+		gasLimit := srv.feeEstimator.gasLimit(
+			c.Request().Context(),
+			srv.srcBridge,
+			destChainID.Uint64(),
+			srcChainID.Uint64(),
+			f,
+		)
+
+		// proving messages processed on the L1 takes calldata, which isn't simulated.
+		gasLimit += srv.simulatedProofSize * calldataGasPerByte
+
 		fees = append(fees, fee{
 			Type:        f.String(),
-			Amount:      srv.getCost(c.Request().Context(), uint64(f), destGasTipCap, destBaseFee, Layer1).String(),
+			Amount:      srv.getCost(c.Request().Context(), gasLimit, srcGasTipCap, srcBaseFee, Layer1).String(),
 			DestChainID: srcChainID.Uint64(),
-			GasLimit:    strconv.Itoa(int(f)),
+			GasLimit:    strconv.FormatUint(gasLimit, 10),
 		})
 
+		gasLimit = srv.feeEstimator.gasLimit(
+			c.Request().Context(),
+			srv.destBridge,
+			srcChainID.Uint64(),
+			destChainID.Uint64(),
+			f,
+		)
+
 		fees = append(fees, fee{
 			Type:        f.String(),
-			Amount:      srv.getCost(c.Request().Context(), uint64(f), srcGasTipCap, srcBaseFee, Layer2).String(),
+			Amount:      srv.getCost(c.Request().Context(), gasLimit, destGasTipCap, destBaseFee, Layer2).String(),
 			DestChainID: destChainID.Uint64(),
-			GasLimit:    strconv.Itoa(int(f)),
+			GasLimit:    strconv.FormatUint(gasLimit, 10),
 		})
 	}
 
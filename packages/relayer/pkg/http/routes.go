@@ -5,6 +5,18 @@ func (srv *Server) configureRoutes() {
 	srv.echo.GET("/", srv.Health)
 
 	srv.echo.GET("/events", srv.GetEventsByAddress)
+	srv.echo.GET("/events/stream", srv.StreamEvents)
 	srv.echo.GET("/blockInfo", srv.GetBlockInfo)
 	srv.echo.GET("/recommendedProcessingFees", srv.GetRecommendedProcessingFees)
+	srv.echo.GET("/message/:msgHash", srv.GetMessage)
+
+	// admin endpoints are only served when an admin token is configured.
+	if srv.adminToken != "" {
+		admin := srv.echo.Group("/admin", srv.adminAuth())
+
+		admin.GET("/deadLetters", srv.GetDeadLetters)
+		admin.GET("/deadLetters/:msgHash", srv.GetDeadLetter)
+		admin.POST("/deadLetters/:msgHash/requeue", srv.RequeueDeadLetter)
+		admin.DELETE("/deadLetters/:msgHash", srv.DiscardDeadLetter)
+	}
 }
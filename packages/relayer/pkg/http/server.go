@@ -5,7 +5,11 @@ import (
 	"math/big"
 	"net/http"
 	"os"
+	"sync/atomic"
+	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/taikoxyz/taiko-mono/packages/relayer"
@@ -37,20 +41,56 @@ type ethClient interface {
 type Server struct {
 	echo                    *echo.Echo
 	eventRepo               relayer.EventRepository
+	deadLetterRepo          relayer.DeadLetterRepository
+	adminToken              string
 	srcEthClient            ethClient
 	destEthClient           ethClient
 	processingFeeMultiplier float64
 	taikoL2                 *taikol2.TaikoL2
+	feeEstimator            *feeEstimator
+	srcBridge               *bridgeClient
+	destBridge              *bridgeClient
+	simulatedProofSize      uint64
+	confirmations           uint64
+	streamPollInterval      time.Duration
+	maxStreams              int64
+	maxStreamDuration       time.Duration
+	streams                 atomic.Int64
 }
 
 type NewServerOpts struct {
 	Echo                    *echo.Echo
 	EventRepo               relayer.EventRepository
+	DeadLetterRepo          relayer.DeadLetterRepository
+	AdminToken              string
 	CorsOrigins             []string
 	SrcEthClient            ethClient
 	DestEthClient           ethClient
 	ProcessingFeeMultiplier float64
 	TaikoL2                 *taikol2.TaikoL2
+	// the bridges messages are simulated with to estimate their gas limits, and
+	// whose status is looked up. If they aren't set, the static gas limits are
+	// recommended, and message lifecycles only show the stored status.
+	SrcBridgeAddress   common.Address
+	SrcBridge          bridgeCaller
+	SrcRPCClient       rpcCaller
+	SrcContractCaller  bind.ContractCaller
+	DestBridgeAddress  common.Address
+	DestBridge         bridgeCaller
+	DestRPCClient      rpcCaller
+	DestContractCaller bind.ContractCaller
+	FeeEstimateTTL     time.Duration
+	SimulatedProofSize uint64
+	// the confirmations the processor waits for before processing messages.
+	Confirmations uint64
+	// how often event streams poll the database for new events.
+	StreamPollInterval time.Duration
+	// the most event streams served at once, further streams are refused until
+	// others close.
+	MaxStreams uint64
+	// how long an event stream is served before it's closed, and the client
+	// reconnects to resume it.
+	MaxStreamDuration time.Duration
 }
 
 func (opts NewServerOpts) Validate() error {
@@ -62,6 +102,10 @@ func (opts NewServerOpts) Validate() error {
 		return relayer.ErrNoEventRepository
 	}
 
+	if opts.AdminToken != "" && opts.DeadLetterRepo == nil {
+		return relayer.ErrNoDeadLetterRepository
+	}
+
 	if opts.CorsOrigins == nil {
 		return relayer.ErrNoCORSOrigins
 	}
@@ -85,10 +129,48 @@ func NewServer(opts NewServerOpts) (*Server, error) {
 	srv := &Server{
 		echo:                    opts.Echo,
 		eventRepo:               opts.EventRepo,
+		deadLetterRepo:          opts.DeadLetterRepo,
+		adminToken:              opts.AdminToken,
 		srcEthClient:            opts.SrcEthClient,
 		destEthClient:           opts.DestEthClient,
 		processingFeeMultiplier: opts.ProcessingFeeMultiplier,
 		taikoL2:                 opts.TaikoL2,
+		feeEstimator:            newFeeEstimator(opts.EventRepo, opts.FeeEstimateTTL),
+		simulatedProofSize:      opts.SimulatedProofSize,
+		confirmations:           opts.Confirmations,
+		streamPollInterval:      opts.StreamPollInterval,
+		maxStreams:              int64(opts.MaxStreams),
+		maxStreamDuration:       opts.MaxStreamDuration,
+	}
+
+	if srv.streamPollInterval == 0 {
+		srv.streamPollInterval = defaultStreamPollInterval
+	}
+
+	if srv.maxStreams == 0 {
+		srv.maxStreams = defaultMaxStreams
+	}
+
+	if srv.maxStreamDuration == 0 {
+		srv.maxStreamDuration = defaultMaxStreamDuration
+	}
+
+	if opts.SrcBridge != nil && opts.SrcRPCClient != nil && opts.SrcContractCaller != nil {
+		srv.srcBridge = &bridgeClient{
+			bridgeAddress: opts.SrcBridgeAddress,
+			bridge:        opts.SrcBridge,
+			rpc:           opts.SrcRPCClient,
+			caller:        opts.SrcContractCaller,
+		}
+	}
+
+	if opts.DestBridge != nil && opts.DestRPCClient != nil && opts.DestContractCaller != nil {
+		srv.destBridge = &bridgeClient{
+			bridgeAddress: opts.DestBridgeAddress,
+			bridge:        opts.DestBridge,
+			rpc:           opts.DestRPCClient,
+			caller:        opts.DestContractCaller,
+		}
 	}
 
 	corsOrigins := opts.CorsOrigins
@@ -147,7 +229,8 @@ func (srv *Server) configureMiddleware(corsOrigins []string) {
 
 	srv.echo.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins: corsOrigins,
-		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept},
+		// event streams are resumed with the Last-Event-ID header.
+		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, "Last-Event-ID"},
 		AllowMethods: []string{http.MethodGet, http.MethodHead},
 	}))
 }
@@ -14,12 +14,16 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/repo"
 )
 
+var testAdminToken = "adminToken"
+
 func newTestServer(url string) *Server {
 	_ = godotenv.Load("../.test.env")
 
 	srv := &Server{
-		echo:      echo.New(),
-		eventRepo: mock.NewEventRepository(),
+		echo:           echo.New(),
+		eventRepo:      mock.NewEventRepository(),
+		deadLetterRepo: mock.NewDeadLetterRepository(),
+		adminToken:     testAdminToken,
 	}
 
 	srv.configureMiddleware([]string{"*"})
@@ -85,6 +89,18 @@ func Test_NewServer(t *testing.T) {
 			},
 			relayer.ErrNoCORSOrigins,
 		},
+		{
+			"noDeadLetterRepo",
+			NewServerOpts{
+				Echo:          echo.New(),
+				EventRepo:     &repo.EventRepository{},
+				AdminToken:    testAdminToken,
+				CorsOrigins:   make([]string, 0),
+				SrcEthClient:  &mock.EthClient{},
+				DestEthClient: &mock.EthClient{},
+			},
+			relayer.ErrNoDeadLetterRepository,
+		},
 		{
 			"noHttpFramework",
 			NewServerOpts{
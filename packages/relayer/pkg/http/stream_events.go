@@ -0,0 +1,208 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+var (
+	// defaultStreamPollInterval is how often event streams poll for new events, if
+	// no interval is set.
+	defaultStreamPollInterval = 2 * time.Second
+
+	// defaultMaxStreams is the most event streams served at once, if no maximum is set.
+	defaultMaxStreams int64 = 1000
+
+	// defaultMaxStreamDuration is how long event streams are served, if no maximum
+	// is set.
+	defaultMaxStreamDuration = 30 * time.Minute
+
+	// streamBatchSize is the most events read from the database per poll.
+	streamBatchSize = 100
+
+	// streamKeepAliveInterval is how often a comment is sent on idle streams, so
+	// proxies don't close them.
+	streamKeepAliveInterval = 15 * time.Second
+
+	// streamRetry is how long clients wait before reconnecting to a closed stream.
+	streamRetry = 3 * time.Second
+)
+
+// streamedEvents are the events streamed to clients, the indexer stores one for
+// each status change of a message.
+var streamedEvents = []string{
+	relayer.EventNameMessageStatusChanged,
+	relayer.EventNameMessageProcessed,
+}
+
+// StreamEvents
+//
+//	 streams the status changes of the messages of an address, or of a single message,
+//	 as server-sent events. The ID of each event is a cursor: clients reconnecting with
+//	 it, in the Last-Event-ID header or the cursor query param, resume after it.
+//	 Without a cursor, the stream starts from the first status change. Streams are
+//	 closed after a while, so clients have to reconnect to keep streaming, and are
+//	 refused while the server streams to too many clients.
+//
+//			@Summary		Stream events
+//			@ID			   	stream-events
+//		    @Param			address	query		string		false	"address to stream the events of"
+//		    @Param			msgHash	query		string		false	"msgHash to stream the events of"
+//		    @Param			cursor	query		string		false	"ID of the last event received"
+//			@Produce		text/event-stream
+//			@Success		200	{object} relayer.Event
+//			@Router			/events/stream [get]
+func (srv *Server) StreamEvents(c echo.Context) error {
+	opts := relayer.FindAllAfterIDOpts{
+		Events: streamedEvents,
+		Limit:  streamBatchSize,
+	}
+
+	if address := html.EscapeString(c.QueryParam("address")); address != "" {
+		if !common.IsHexAddress(address) {
+			return webutils.LogAndRenderErrors(c, http.StatusBadRequest, ErrInvalidAddress)
+		}
+
+		a := common.HexToAddress(address)
+
+		opts.Address = &a
+	}
+
+	if msgHash := html.EscapeString(c.QueryParam("msgHash")); msgHash != "" {
+		opts.MsgHash = &msgHash
+	}
+
+	if opts.Address == nil && opts.MsgHash == nil {
+		return webutils.LogAndRenderErrors(c, http.StatusBadRequest, ErrNoStreamFilter)
+	}
+
+	// browsers send the ID of the last event they received when reconnecting.
+	cursor := c.Request().Header.Get("Last-Event-ID")
+	if cursor == "" {
+		cursor = c.QueryParam("cursor")
+	}
+
+	if cursor != "" {
+		id, err := strconv.Atoi(cursor)
+		if err != nil {
+			return webutils.LogAndRenderErrors(c, http.StatusBadRequest, err)
+		}
+
+		opts.ID = id
+	}
+
+	// a maximum of 0 means streams aren't limited.
+	if srv.maxStreams > 0 {
+		if srv.streams.Add(1) > srv.maxStreams {
+			srv.streams.Add(-1)
+
+			c.Response().Header().Set("Retry-After", strconv.Itoa(int(streamRetry.Seconds())))
+
+			return webutils.LogAndRenderErrors(c, http.StatusServiceUnavailable, ErrTooManyStreams)
+		}
+
+		defer srv.streams.Add(-1)
+	}
+
+	res := c.Response()
+
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	// disables response buffering in nginx.
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+
+	if _, err := fmt.Fprintf(res, "retry: %v\n\n", streamRetry.Milliseconds()); err != nil {
+		return nil
+	}
+
+	res.Flush()
+
+	ctx := c.Request().Context()
+
+	// the stream is closed once it's served for long enough, and the client resumes
+	// it from the last event it received when reconnecting.
+	if srv.maxStreamDuration > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, srv.maxStreamDuration)
+		defer cancel()
+	}
+
+	ticker := time.NewTicker(srv.streamPollInterval)
+	defer ticker.Stop()
+
+	lastWrite := time.Now()
+
+	for {
+		events, err := srv.eventRepo.FindAllAfterID(ctx, opts)
+		if err != nil {
+			// the client disconnected, or the stream was served for long enough.
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			// the response is already streaming, so the client has to reconnect.
+			slog.Error("error finding events to stream",
+				"error", errors.Wrap(err, "srv.eventRepo.FindAllAfterID"),
+			)
+
+			return nil
+		}
+
+		for _, e := range events {
+			data, err := json.Marshal(e)
+			if err != nil {
+				slog.Error("error marshaling streamed event", "id", e.ID, "error", err)
+
+				return nil
+			}
+
+			if _, err := fmt.Fprintf(res, "id: %v\nevent: %v\ndata: %s\n\n", e.ID, e.Event, data); err != nil {
+				return nil
+			}
+
+			opts.ID = e.ID
+		}
+
+		if len(events) > 0 {
+			res.Flush()
+
+			lastWrite = time.Now()
+		}
+
+		// read the next batch right away if there may be more events.
+		if len(events) == streamBatchSize {
+			continue
+		}
+
+		if time.Since(lastWrite) >= streamKeepAliveInterval {
+			if _, err := fmt.Fprint(res, ": keepalive\n\n"); err != nil {
+				return nil
+			}
+
+			res.Flush()
+
+			lastWrite = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package http
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cyberhorsey/webutils/testutils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+func Test_StreamEvents(t *testing.T) {
+	srv := newTestServer("")
+	srv.streamPollInterval = 10 * time.Millisecond
+
+	owner := common.HexToAddress("0x1000000000000000000000000000000000000004")
+	other := common.HexToAddress("0x1000000000000000000000000000000000000005")
+
+	for _, opts := range []relayer.SaveEventOpts{
+		{Event: relayer.EventNameMessageSent, MessageOwner: owner.Hex(), MsgHash: "0x1"},          // id 1
+		{Event: relayer.EventNameMessageStatusChanged, MessageOwner: owner.Hex(), MsgHash: "0x1"}, // id 2
+		{Event: relayer.EventNameMessageProcessed, MessageOwner: owner.Hex(), MsgHash: "0x1"},     // id 3
+		{Event: relayer.EventNameMessageStatusChanged, MessageOwner: other.Hex(), MsgHash: "0x2"}, // id 4
+	} {
+		opts.Name = opts.Event
+		opts.Data = "{}"
+		opts.ChainID = big.NewInt(1)
+		opts.DestChainID = big.NewInt(2)
+
+		_, err := srv.eventRepo.Save(context.Background(), opts)
+		assert.Nil(t, err)
+	}
+
+	tests := []struct {
+		name                     string
+		path                     string
+		lastEventID              string
+		wantStatus               int
+		wantBodyRegexpMatches    []string
+		wantBodyRegexpNotMatches []string
+	}{
+		{
+			"noFilter",
+			"/events/stream",
+			"",
+			http.StatusBadRequest,
+			[]string{`address or msgHash is required`},
+			nil,
+		},
+		{
+			"invalidAddress",
+			"/events/stream?address=0x1",
+			"",
+			http.StatusBadRequest,
+			[]string{`invalid address`},
+			nil,
+		},
+		{
+			"invalidCursor",
+			"/events/stream?msgHash=0x1&cursor=abc",
+			"",
+			http.StatusBadRequest,
+			nil,
+			nil,
+		},
+		{
+			"address",
+			"/events/stream?address=" + strings.ToLower(owner.Hex()),
+			"",
+			http.StatusOK,
+			[]string{`retry: 3000\n`, `id: 2\nevent: MessageStatusChanged\ndata: {"id":2`, `id: 3\nevent: MessageProcessed\n`},
+			[]string{`id: 1\n`, `id: 4\n`},
+		},
+		{
+			"msgHash",
+			"/events/stream?msgHash=0x2",
+			"",
+			http.StatusOK,
+			[]string{`id: 4\n`},
+			[]string{`id: 2\n`, `id: 3\n`},
+		},
+		{
+			"cursor",
+			"/events/stream?address=" + owner.Hex() + "&cursor=2",
+			"",
+			http.StatusOK,
+			[]string{`id: 3\n`},
+			[]string{`id: 2\n`},
+		},
+		{
+			"lastEventID",
+			"/events/stream?address=" + owner.Hex() + "&cursor=0",
+			"2",
+			http.StatusOK,
+			[]string{`id: 3\n`},
+			[]string{`id: 2\n`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// streams are served until the client disconnects.
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			req := testutils.NewUnauthenticatedRequest(echo.GET, tt.path, nil).WithContext(ctx)
+			if tt.lastEventID != "" {
+				req.Header.Set("Last-Event-ID", tt.lastEventID)
+			}
+
+			rec := httptest.NewRecorder()
+
+			srv.ServeHTTP(rec, req)
+
+			testutils.AssertStatusAndBody(t, rec, tt.wantStatus, tt.wantBodyRegexpMatches)
+			testutils.AssertNotRegexp(t, tt.wantBodyRegexpNotMatches, rec.Body.String())
+		})
+	}
+}
+
+func Test_StreamEvents_NewEvents(t *testing.T) {
+	srv := newTestServer("")
+	srv.streamPollInterval = 10 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	go func() {
+		// stored after the stream started.
+		time.Sleep(30 * time.Millisecond)
+
+		_, _ = srv.eventRepo.Save(context.Background(), relayer.SaveEventOpts{
+			Name:        relayer.EventNameMessageStatusChanged,
+			Event:       relayer.EventNameMessageStatusChanged,
+			Data:        "{}",
+			ChainID:     big.NewInt(1),
+			DestChainID: big.NewInt(2),
+			MsgHash:     "0x1",
+		})
+	}()
+
+	rec := httptest.NewRecorder()
+
+	srv.ServeHTTP(rec, testutils.NewUnauthenticatedRequest(echo.GET, "/events/stream?msgHash=0x1", nil).WithContext(ctx))
+
+	testutils.AssertStatusAndBody(t, rec, http.StatusOK, []string{`id: 1\nevent: MessageStatusChanged\n`})
+}
+
+func Test_StreamEvents_MaxStreams(t *testing.T) {
+	srv := newTestServer("")
+	srv.streamPollInterval = 10 * time.Millisecond
+	srv.maxStreams = 1
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		srv.ServeHTTP(
+			httptest.NewRecorder(),
+			testutils.NewUnauthenticatedRequest(echo.GET, "/events/stream?msgHash=0x1", nil).WithContext(ctx),
+		)
+	}()
+
+	time.Sleep(30 * time.Millisecond)
+
+	// further streams are refused while the first one is served.
+	rec := httptest.NewRecorder()
+
+	srv.ServeHTTP(rec, testutils.NewUnauthenticatedRequest(echo.GET, "/events/stream?msgHash=0x1", nil))
+
+	testutils.AssertStatusAndBody(t, rec, http.StatusServiceUnavailable, []string{`too many event streams`})
+	assert.Equal(t, "3", rec.Header().Get("Retry-After"))
+
+	cancel()
+	<-done
+
+	// once it's closed, streams are served again.
+	ctx, cancel = context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+
+	rec = httptest.NewRecorder()
+
+	srv.ServeHTTP(rec, testutils.NewUnauthenticatedRequest(echo.GET, "/events/stream?msgHash=0x1", nil).WithContext(ctx))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, int64(0), srv.streams.Load())
+}
+
+func Test_StreamEvents_MaxStreamDuration(t *testing.T) {
+	srv := newTestServer("")
+	srv.streamPollInterval = 10 * time.Millisecond
+	srv.maxStreamDuration = 50 * time.Millisecond
+
+	rec := httptest.NewRecorder()
+
+	start := time.Now()
+
+	// the client never disconnects, but the stream is closed anyway.
+	srv.ServeHTTP(rec, testutils.NewUnauthenticatedRequest(echo.GET, "/events/stream?msgHash=0x1", nil))
+
+	assert.Less(t, time.Since(start), time.Second)
+	testutils.AssertStatusAndBody(t, rec, http.StatusOK, []string{`retry: 3000\n`})
+}
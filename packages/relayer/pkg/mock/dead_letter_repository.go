@@ -0,0 +1,138 @@
+package mock
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/morkid/paginate"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"gorm.io/datatypes"
+)
+
+type DeadLetterRepository struct {
+	mu          sync.Mutex
+	nextID      int
+	deadLetters []*relayer.DeadLetter
+}
+
+func NewDeadLetterRepository() *DeadLetterRepository {
+	return &DeadLetterRepository{
+		deadLetters: make([]*relayer.DeadLetter, 0),
+	}
+}
+
+func (r *DeadLetterRepository) Save(
+	ctx context.Context,
+	opts relayer.SaveDeadLetterOpts,
+) (*relayer.DeadLetter, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.nextID++
+
+	d := &relayer.DeadLetter{
+		ID:           r.nextID,
+		MsgHash:      opts.MsgHash,
+		QueueName:    opts.QueueName,
+		Body:         datatypes.JSON(opts.Body),
+		Error:        opts.Error,
+		RevertReason: opts.RevertReason,
+		TimesRetried: opts.TimesRetried,
+		Status:       relayer.DeadLetterStatusNew,
+	}
+
+	r.deadLetters = append(r.deadLetters, d)
+
+	return d, nil
+}
+
+func (r *DeadLetterRepository) FindAll(
+	ctx context.Context,
+	req *http.Request,
+	opts relayer.FindAllDeadLettersOpts,
+) (paginate.Page, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deadLetters := make([]*relayer.DeadLetter, 0)
+
+	for _, d := range r.deadLetters {
+		if opts.QueueName != nil && *opts.QueueName != "" && d.QueueName != *opts.QueueName {
+			continue
+		}
+
+		if opts.Status != nil && d.Status != *opts.Status {
+			continue
+		}
+
+		deadLetters = append(deadLetters, d)
+	}
+
+	return paginate.Page{
+		Items: deadLetters,
+	}, nil
+}
+
+func (r *DeadLetterRepository) FindAllByQueueNameAndStatus(
+	ctx context.Context,
+	queueName string,
+	status relayer.DeadLetterStatus,
+) ([]*relayer.DeadLetter, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deadLetters := make([]*relayer.DeadLetter, 0)
+
+	for _, d := range r.deadLetters {
+		if d.QueueName == queueName && d.Status == status {
+			deadLetters = append(deadLetters, d)
+		}
+	}
+
+	return deadLetters, nil
+}
+
+func (r *DeadLetterRepository) FirstByMsgHash(
+	ctx context.Context,
+	msgHash string,
+) (*relayer.DeadLetter, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := len(r.deadLetters) - 1; i >= 0; i-- {
+		if r.deadLetters[i].MsgHash == msgHash {
+			return r.deadLetters[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *DeadLetterRepository) UpdateStatus(ctx context.Context, id int, status relayer.DeadLetterStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, d := range r.deadLetters {
+		if d.ID == id {
+			d.Status = status
+		}
+	}
+
+	return nil
+}
+
+func (r *DeadLetterRepository) Delete(ctx context.Context, id int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, d := range r.deadLetters {
+		if d.ID == id {
+			r.deadLetters = append(r.deadLetters[:i], r.deadLetters[i+1:]...)
+
+			break
+		}
+	}
+
+	return nil
+}
@@ -20,6 +20,7 @@ var (
 	FailTxHash               = common.HexToHash("0x789")
 	BlockNum                 = 10
 	PendingNonce      uint64 = 10
+	Balance                  = big.NewInt(1000000000000000000)
 )
 
 type Subscription struct {
@@ -113,6 +114,10 @@ func (c *EthClient) HeaderByHash(ctx context.Context, hash common.Hash) (*types.
 	return Header, nil
 }
 
+func (c *EthClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return Balance, nil
+}
+
 func (c *EthClient) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
 	return 1, nil
 }
@@ -6,6 +6,9 @@ import (
 	"errors"
 	"math/rand"
 	"net/http"
+	"slices"
+	"strings"
+	"sync"
 
 	"github.com/morkid/paginate"
 	"github.com/taikoxyz/taiko-mono/packages/relayer"
@@ -13,6 +16,9 @@ import (
 )
 
 type EventRepository struct {
+	// mu guards saving events while they're streamed.
+	mu     sync.Mutex
+	nextID int
 	events []*relayer.Event
 }
 
@@ -22,16 +28,23 @@ func NewEventRepository() *EventRepository {
 	}
 }
 func (r *EventRepository) Save(ctx context.Context, opts relayer.SaveEventOpts) (*relayer.Event, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.nextID++
+
 	r.events = append(r.events, &relayer.Event{
-		ID:           rand.Int(), // nolint: gosec
-		Data:         datatypes.JSON(opts.Data),
-		Status:       opts.Status,
-		ChainID:      opts.ChainID.Int64(),
-		DestChainID:  opts.DestChainID.Int64(),
-		Name:         opts.Name,
-		MessageOwner: opts.MessageOwner,
-		MsgHash:      opts.MsgHash,
-		EventType:    opts.EventType,
+		ID:             r.nextID,
+		Data:           datatypes.JSON(opts.Data),
+		Status:         opts.Status,
+		ChainID:        opts.ChainID.Int64(),
+		DestChainID:    opts.DestChainID.Int64(),
+		Name:           opts.Name,
+		Event:          opts.Event,
+		MessageOwner:   opts.MessageOwner,
+		MsgHash:        opts.MsgHash,
+		EventType:      opts.EventType,
+		EmittedBlockID: opts.EmittedBlockID,
 	})
 
 	return nil, nil
@@ -122,6 +135,57 @@ func (r *EventRepository) FirstByEventAndMsgHash(
 	return nil, nil
 }
 
+func (r *EventRepository) FindAllByMsgHash(
+	ctx context.Context,
+	msgHash string,
+) ([]*relayer.Event, error) {
+	events := make([]*relayer.Event, 0)
+
+	for _, e := range r.events {
+		if e.MsgHash == msgHash {
+			events = append(events, e)
+		}
+	}
+
+	return events, nil
+}
+
+func (r *EventRepository) FindAllAfterID(
+	ctx context.Context,
+	opts relayer.FindAllAfterIDOpts,
+) ([]*relayer.Event, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	events := make([]*relayer.Event, 0)
+
+	for _, e := range r.events {
+		if e.ID <= opts.ID {
+			continue
+		}
+
+		if opts.Address != nil && !strings.EqualFold(e.MessageOwner, opts.Address.Hex()) {
+			continue
+		}
+
+		if opts.MsgHash != nil && *opts.MsgHash != "" && e.MsgHash != *opts.MsgHash {
+			continue
+		}
+
+		if len(opts.Events) > 0 && !slices.Contains(opts.Events, e.Event) {
+			continue
+		}
+
+		events = append(events, e)
+
+		if opts.Limit > 0 && len(events) == opts.Limit {
+			break
+		}
+	}
+
+	return events, nil
+}
+
 func (r *EventRepository) Delete(
 	ctx context.Context,
 	id int,
@@ -172,3 +236,26 @@ func (r *EventRepository) FindLatestBlockID(
 
 	return 0, errors.New("invalid")
 }
+
+func (r *EventRepository) FindLatestByEventType(
+	ctx context.Context,
+	event string,
+	eventType relayer.EventType,
+	status relayer.EventStatus,
+	srcChainID uint64,
+	destChainID uint64,
+) (*relayer.Event, error) {
+	for i := len(r.events) - 1; i >= 0; i-- {
+		e := r.events[i]
+
+		if e.Event == event &&
+			e.EventType == eventType &&
+			e.Status == status &&
+			e.ChainID == int64(srcChainID) &&
+			e.DestChainID == int64(destChainID) {
+			return e, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -26,11 +26,24 @@ func (p *Prover) blockHeader(
 			return encoding.BlockHeader{}, errors.Wrap(err, "blocker.BlockByNumber")
 		}
 	} else {
+		// only headers by hash are cached, the latest one changes.
+		if p.cache != nil {
+			if header, ok := p.cache.getHeader(blockHash); ok {
+				return header, nil
+			}
+		}
+
 		b, err = blocker.BlockByHash(ctx, blockHash)
 		if err != nil {
 			return encoding.BlockHeader{}, errors.Wrap(err, "blocker.BlockByHash")
 		}
 	}
 
-	return encoding.BlockToBlockHeader(b), nil
+	header := encoding.BlockToBlockHeader(b)
+
+	if p.cache != nil && blockHash != (common.Hash{}) {
+		p.cache.addHeader(blockHash, header)
+	}
+
+	return header, nil
 }
@@ -0,0 +1,170 @@
+package proof
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/pkg/errors"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
+)
+
+// cache names used in the cache hit and miss metrics.
+const (
+	cacheNameProof  = "proof"
+	cacheNameBlock  = "block"
+	cacheNameHeader = "header"
+)
+
+// proofCacheKey identifies the proofs of a contract at a block of a hop's chain.
+type proofCacheKey struct {
+	chainID     uint64
+	blockNumber uint64
+	address     common.Address
+}
+
+// blockCacheKey identifies a block of a hop's chain.
+type blockCacheKey struct {
+	chainID     uint64
+	blockNumber uint64
+}
+
+// cachedProof is the account proof of a contract at a block, along with the storage
+// proofs of its slots fetched so far. It is never modified once cached, so it can be
+// read concurrently.
+type cachedProof struct {
+	accountProof  Slice
+	storageProofs map[string]StorageResult
+}
+
+// Cache is a bounded LRU cache of the account and storage proofs, blocks and block
+// headers fetched by a Prover. It is safe for concurrent use, so it can be shared
+// by the goroutines of a processor, and by all the hops of a proof.
+type Cache struct {
+	proofs  *lru.Cache[proofCacheKey, *cachedProof]
+	blocks  *lru.Cache[blockCacheKey, *types.Block]
+	headers *lru.Cache[common.Hash, encoding.BlockHeader]
+}
+
+// NewCache creates a cache keeping up to size entries of each kind.
+func NewCache(size int) (*Cache, error) {
+	proofs, err := lru.New[proofCacheKey, *cachedProof](size)
+	if err != nil {
+		return nil, errors.Wrap(err, "lru.New")
+	}
+
+	blocks, err := lru.New[blockCacheKey, *types.Block](size)
+	if err != nil {
+		return nil, errors.Wrap(err, "lru.New")
+	}
+
+	headers, err := lru.New[common.Hash, encoding.BlockHeader](size)
+	if err != nil {
+		return nil, errors.Wrap(err, "lru.New")
+	}
+
+	return &Cache{
+		proofs:  proofs,
+		blocks:  blocks,
+		headers: headers,
+	}, nil
+}
+
+// storageProof returns the proofs of the given storage keys, in their order, which
+// must all be cached.
+func (cp *cachedProof) storageProof(keys []string) *StorageProof {
+	ethProof := &StorageProof{
+		AccountProof: cp.accountProof,
+		StorageProof: make([]StorageResult, 0, len(keys)),
+	}
+
+	for _, k := range keys {
+		ethProof.StorageProof = append(ethProof.StorageProof, cp.storageProofs[k])
+	}
+
+	return ethProof
+}
+
+// getProof returns the cached proofs of a contract at a block, along with the
+// given storage keys which aren't cached yet.
+func (c *Cache) getProof(key proofCacheKey, keys []string) (*cachedProof, []string) {
+	cached, ok := c.proofs.Get(key)
+	if !ok {
+		relayer.ProofCacheMisses.WithLabelValues(cacheNameProof).Inc()
+
+		return nil, keys
+	}
+
+	missing := []string{}
+
+	for _, k := range keys {
+		if _, ok := cached.storageProofs[k]; !ok {
+			missing = append(missing, k)
+		}
+	}
+
+	if len(missing) > 0 {
+		relayer.ProofCacheMisses.WithLabelValues(cacheNameProof).Inc()
+	} else {
+		relayer.ProofCacheHits.WithLabelValues(cacheNameProof).Inc()
+	}
+
+	return cached, missing
+}
+
+// addProof caches the proofs fetched for the given storage keys, along with the
+// already cached ones of the same contract at the same block, and returns them.
+func (c *Cache) addProof(key proofCacheKey, cached *cachedProof, keys []string, ethProof *StorageProof) *cachedProof {
+	merged := &cachedProof{
+		accountProof:  ethProof.AccountProof,
+		storageProofs: make(map[string]StorageResult, len(keys)),
+	}
+
+	if cached != nil {
+		for k, storageProof := range cached.storageProofs {
+			merged.storageProofs[k] = storageProof
+		}
+	}
+
+	for i, k := range keys {
+		merged.storageProofs[k] = ethProof.StorageProof[i]
+	}
+
+	c.proofs.Add(key, merged)
+
+	return merged
+}
+
+func (c *Cache) getBlock(key blockCacheKey) (*types.Block, bool) {
+	block, ok := c.blocks.Get(key)
+	if !ok {
+		relayer.ProofCacheMisses.WithLabelValues(cacheNameBlock).Inc()
+
+		return nil, false
+	}
+
+	relayer.ProofCacheHits.WithLabelValues(cacheNameBlock).Inc()
+
+	return block, true
+}
+
+func (c *Cache) addBlock(key blockCacheKey, block *types.Block) {
+	c.blocks.Add(key, block)
+}
+
+func (c *Cache) getHeader(blockHash common.Hash) (encoding.BlockHeader, bool) {
+	header, ok := c.headers.Get(blockHash)
+	if !ok {
+		relayer.ProofCacheMisses.WithLabelValues(cacheNameHeader).Inc()
+
+		return encoding.BlockHeader{}, false
+	}
+
+	relayer.ProofCacheHits.WithLabelValues(cacheNameHeader).Inc()
+
+	return header, true
+}
+
+func (c *Cache) addHeader(blockHash common.Hash, header encoding.BlockHeader) {
+	c.headers.Add(blockHash, header)
+}
@@ -0,0 +1,110 @@
+package proof
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+// countingBlocker counts the blocks fetched.
+type countingBlocker struct {
+	blocker blocker
+	calls   int
+}
+
+func (b *countingBlocker) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	b.calls++
+
+	return b.blocker.BlockByHash(ctx, hash)
+}
+
+func (b *countingBlocker) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	b.calls++
+
+	return b.blocker.BlockByNumber(ctx, number)
+}
+
+func newTestCachedProver(t *testing.T, size int) *Prover {
+	cache, err := NewCache(size)
+	assert.Nil(t, err)
+
+	p, err := New(&mock.Blocker{}, encoding.CACHE_BOTH, cache)
+	assert.Nil(t, err)
+
+	return p
+}
+
+func Test_NewCache_InvalidSize(t *testing.T) {
+	_, err := NewCache(0)
+	assert.NotNil(t, err)
+}
+
+func Test_EncodedSignalProofsWithHops_Cached(t *testing.T) {
+	p := newTestCachedProver(t, 10)
+
+	caller := &countingCaller{}
+	blocker := &countingBlocker{blocker: &mock.EthClient{}}
+
+	newHop := func(key [32]byte) HopParams {
+		return HopParams{
+			ChainID:              mock.MockChainID,
+			SignalServiceAddress: common.Address{},
+			SignalService:        &mock.SignalService{},
+			Key:                  key,
+			Blocker:              blocker,
+			Caller:               caller,
+			BlockNumber:          uint64(mock.BlockNum),
+		}
+	}
+
+	encoded, err := p.EncodedSignalProofWithHops(context.Background(), []HopParams{newHop([32]byte{})})
+	assert.Nil(t, err)
+	assert.Equal(t, wantEncoded, hexutil.Encode(encoded))
+
+	// the same proof is served from the cache.
+	encoded, err = p.EncodedSignalProofWithHops(context.Background(), []HopParams{newHop([32]byte{})})
+	assert.Nil(t, err)
+	assert.Equal(t, wantEncoded, hexutil.Encode(encoded))
+	assert.Equal(t, 1, caller.calls)
+	assert.Equal(t, 1, blocker.calls)
+
+	// only the storage proof of the new key is fetched, at the cached block.
+	encoded2, err := p.EncodedSignalProofsWithHops(
+		context.Background(),
+		[][]HopParams{{newHop([32]byte{})}, {newHop([32]byte{0x1})}},
+	)
+	assert.Nil(t, err)
+	assert.Len(t, encoded2, 2)
+	assert.Equal(t, wantEncoded, hexutil.Encode(encoded2[0]))
+	assert.Equal(t, 2, caller.calls)
+	assert.Equal(t, 1, blocker.calls)
+}
+
+func Test_blockHeader_Cached(t *testing.T) {
+	p := newTestCachedProver(t, 10)
+
+	blocker := &countingBlocker{blocker: &mock.Blocker{}}
+
+	for i := 0; i < 2; i++ {
+		header, err := p.blockHeader(context.Background(), blocker, common.HexToHash("0x123"))
+		assert.Nil(t, err)
+		assert.Equal(t, encoding.BlockToBlockHeader(types.NewBlockWithHeader(mock.Header)), header)
+	}
+
+	assert.Equal(t, 1, blocker.calls)
+
+	// the latest header isn't cached.
+	for i := 0; i < 2; i++ {
+		_, err := p.blockHeader(context.Background(), blocker, common.Hash{})
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, 3, blocker.calls)
+}
@@ -2,13 +2,16 @@ package proof
 
 import (
 	"context"
+	"fmt"
 	"math/big"
+	"slices"
 
 	"github.com/taikoxyz/taiko-mono/packages/relayer"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
 )
 
@@ -26,63 +29,181 @@ func (p *Prover) EncodedSignalProofWithHops(
 	ctx context.Context,
 	hopParams []HopParams,
 ) ([]byte, error) {
-	return p.abiEncodeSignalProofWithHops(ctx,
-		hopParams,
-	)
+	encodedSignalProofs, err := p.EncodedSignalProofsWithHops(ctx, [][]HopParams{hopParams})
+	if err != nil {
+		return nil, err
+	}
+
+	return encodedSignalProofs[0], nil
 }
 
-func (p *Prover) abiEncodeSignalProofWithHops(ctx context.Context,
-	hopParams []HopParams,
-) ([]byte, error) {
-	hopProofs := []encoding.HopProof{}
+// proofGroup is the set of storage keys proven at the same block of the
+// same signal service, which are fetched with a single `eth_getProof` call.
+type proofGroup struct {
+	hop      HopParams
+	keys     []string
+	ethProof *StorageProof
+	block    *types.Block
+}
+
+type proofGroupKey struct {
+	chainID              uint64
+	signalServiceAddress common.Address
+	blockNumber          uint64
+}
+
+// EncodedSignalProofsWithHops encodes the signal proofs of multiple messages at
+// once. The storage proofs of hops at the same block of the same signal service
+// are fetched once, so messages from the same source block, which share their hop
+// proofs, don't fetch them again.
+func (p *Prover) EncodedSignalProofsWithHops(
+	ctx context.Context,
+	hopParams [][]HopParams,
+) ([][]byte, error) {
+	groups := make(map[proofGroupKey]*proofGroup)
+
+	// groups are kept in the order they are first seen in, so proofs are fetched
+	// in a deterministic order.
+	orderedGroups := []*proofGroup{}
+
+	for _, hops := range hopParams {
+		for _, hop := range hops {
+			groupKey := proofGroupKey{
+				chainID:              hop.ChainID.Uint64(),
+				signalServiceAddress: hop.SignalServiceAddress,
+				blockNumber:          hop.BlockNumber,
+			}
+
+			g, ok := groups[groupKey]
+			if !ok {
+				g = &proofGroup{hop: hop}
+				groups[groupKey] = g
+				orderedGroups = append(orderedGroups, g)
+			}
 
-	for _, hop := range hopParams {
-		block, err := hop.Blocker.BlockByNumber(
-			ctx,
-			new(big.Int).SetUint64(hop.BlockNumber),
-		)
+			key := common.Bytes2Hex(hop.Key[:])
+			if !slices.Contains(g.keys, key) {
+				g.keys = append(g.keys, key)
+			}
+		}
+	}
+
+	for _, g := range orderedGroups {
+		block, err := p.hopBlock(ctx, g.hop)
 		if err != nil {
 			return nil, errors.Wrap(err, "p.blockHeader")
 		}
 
-		ethProof, err := p.getProof(
-			ctx,
-			hop.Caller,
-			hop.SignalServiceAddress,
-			common.Bytes2Hex(hop.Key[:]),
-			int64(hop.BlockNumber),
-		)
+		ethProof, err := p.hopProof(ctx, g.hop, g.keys)
 		if err != nil {
 			return nil, errors.Wrap(err, "hop p.getEncodedMerkleProof")
 		}
 
-		hopProofs = append(hopProofs, encoding.HopProof{
-			BlockID:      block.NumberU64(),
-			ChainID:      hop.ChainID.Uint64(),
-			RootHash:     block.Root(),
-			CacheOption:  encoding.CACHE_NOTHING,
-			AccountProof: ethProof.AccountProof,
-			StorageProof: ethProof.StorageProof[0].Proof,
-		},
-		)
+		g.block = block
+		g.ethProof = ethProof
 	}
 
-	encodedSignalProof, err := encoding.EncodeHopProofs(hopProofs)
+	encodedSignalProofs := make([][]byte, 0, len(hopParams))
+
+	for _, hops := range hopParams {
+		hopProofs := []encoding.HopProof{}
+
+		for _, hop := range hops {
+			g := groups[proofGroupKey{
+				chainID:              hop.ChainID.Uint64(),
+				signalServiceAddress: hop.SignalServiceAddress,
+				blockNumber:          hop.BlockNumber,
+			}]
+
+			// eth_getProof returns the storage proofs in the order of the keys.
+			i := slices.Index(g.keys, common.Bytes2Hex(hop.Key[:]))
+
+			hopProofs = append(hopProofs, encoding.HopProof{
+				BlockID:      g.block.NumberU64(),
+				ChainID:      hop.ChainID.Uint64(),
+				RootHash:     g.block.Root(),
+				CacheOption:  encoding.CACHE_NOTHING,
+				AccountProof: g.ethProof.AccountProof,
+				StorageProof: g.ethProof.StorageProof[i].Proof,
+			},
+			)
+		}
+
+		encodedSignalProof, err := encoding.EncodeHopProofs(hopProofs)
+		if err != nil {
+			return nil, errors.Wrap(err, "enoding.EncodeHopProofs")
+		}
+
+		encodedSignalProofs = append(encodedSignalProofs, encodedSignalProof)
+	}
+
+	return encodedSignalProofs, nil
+}
+
+// hopBlock returns the block a hop is proven at, from the cache if it was
+// already fetched.
+func (p *Prover) hopBlock(ctx context.Context, hop HopParams) (*types.Block, error) {
+	key := blockCacheKey{
+		chainID:     hop.ChainID.Uint64(),
+		blockNumber: hop.BlockNumber,
+	}
+
+	if p.cache != nil {
+		if block, ok := p.cache.getBlock(key); ok {
+			return block, nil
+		}
+	}
+
+	block, err := hop.Blocker.BlockByNumber(
+		ctx,
+		new(big.Int).SetUint64(hop.BlockNumber),
+	)
 	if err != nil {
-		return nil, errors.Wrap(err, "enoding.EncodeHopProofs")
+		return nil, err
+	}
+
+	if p.cache != nil {
+		p.cache.addBlock(key, block)
+	}
+
+	return block, nil
+}
+
+// hopProof returns the proofs of the given storage keys of a hop's signal service,
+// only fetching the ones which aren't cached yet.
+func (p *Prover) hopProof(ctx context.Context, hop HopParams, keys []string) (*StorageProof, error) {
+	if p.cache == nil {
+		return p.getProof(ctx, hop.Caller, hop.SignalServiceAddress, keys, int64(hop.BlockNumber))
+	}
+
+	key := proofCacheKey{
+		chainID:     hop.ChainID.Uint64(),
+		blockNumber: hop.BlockNumber,
+		address:     hop.SignalServiceAddress,
 	}
 
-	return encodedSignalProof, nil
+	cached, missing := p.cache.getProof(key, keys)
+
+	if len(missing) > 0 {
+		ethProof, err := p.getProof(ctx, hop.Caller, hop.SignalServiceAddress, missing, int64(hop.BlockNumber))
+		if err != nil {
+			return nil, err
+		}
+
+		cached = p.cache.addProof(key, cached, missing, ethProof)
+	}
+
+	return cached.storageProof(keys), nil
 }
 
 // getProof rlp and abi encodes a proof for SignalService,
-// where `proof` is an rlp and abi encoded (bytes, bytes) consisting of storageProof.Proofs[0]
-// response from `eth_getProof`, and returns the storageHash to be used as the signalRoot.
+// where `proof` is an rlp and abi encoded (bytes, bytes) consisting of storageProof.Proofs
+// response from `eth_getProof` for each key, and returns the storageHash to be used as the signalRoot.
 func (p *Prover) getProof(
 	ctx context.Context,
 	c relayer.Caller,
 	signalServiceAddress common.Address,
-	key string,
+	keys []string,
 	blockNumber int64,
 ) (*StorageProof, error) {
 	var ethProof StorageProof
@@ -91,15 +212,21 @@ func (p *Prover) getProof(
 		&ethProof,
 		"eth_getProof",
 		signalServiceAddress,
-		[]string{key},
+		keys,
 		hexutil.EncodeBig(new(big.Int).SetInt64(blockNumber)),
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "c.CallContext")
 	}
 
-	if new(big.Int).SetBytes(ethProof.StorageProof[0].Value).Int64() == int64(0) {
-		return nil, errors.New("proof will not be valid, expected storageProof to not be 0 but was not")
+	if len(ethProof.StorageProof) != len(keys) {
+		return nil, fmt.Errorf("expected %v storage proofs, got %v", len(keys), len(ethProof.StorageProof))
+	}
+
+	for _, storageProof := range ethProof.StorageProof {
+		if new(big.Int).SetBytes(storageProof.Value).Int64() == int64(0) {
+			return nil, errors.New("proof will not be valid, expected storageProof to not be 0 but was not")
+		}
 	}
 
 	return &ethProof, nil
@@ -39,3 +39,50 @@ func Test_EncodedSignalProof(t *testing.T) {
 
 	assert.Equal(t, wantEncoded, hexutil.Encode(encoded))
 }
+
+// countingCaller counts the eth_getProof calls made.
+type countingCaller struct {
+	mock.Caller
+	calls int
+}
+
+func (c *countingCaller) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	c.calls++
+
+	return c.Caller.CallContext(ctx, result, method, args...)
+}
+
+func Test_EncodedSignalProofsWithHops(t *testing.T) {
+	p := newTestProver()
+
+	caller := &countingCaller{}
+
+	newHop := func(key [32]byte, blockNumber uint64) HopParams {
+		return HopParams{
+			ChainID:              mock.MockChainID,
+			SignalServiceAddress: common.Address{},
+			SignalService:        &mock.SignalService{},
+			Key:                  key,
+			Blocker:              &mock.EthClient{},
+			Caller:               caller,
+			BlockNumber:          blockNumber,
+		}
+	}
+
+	encoded, err := p.EncodedSignalProofsWithHops(
+		context.Background(),
+		[][]HopParams{
+			{newHop([32]byte{}, uint64(mock.BlockNum))},
+			{newHop([32]byte{0x1}, uint64(mock.BlockNum))},
+			{newHop([32]byte{}, uint64(mock.BlockNum))},
+		},
+	)
+
+	assert.Nil(t, err)
+	assert.Len(t, encoded, 3)
+	assert.Equal(t, wantEncoded, hexutil.Encode(encoded[0]))
+	assert.Equal(t, wantEncoded, hexutil.Encode(encoded[2]))
+
+	// the keys at the same block of the same signal service are proven in one call.
+	assert.Equal(t, 1, caller.calls)
+}
@@ -21,9 +21,12 @@ type blocker interface {
 type Prover struct {
 	blocker     blocker
 	cacheOption int
+	cache       *Cache
 }
 
-func New(blocker blocker, cacheOption int) (*Prover, error) {
+// New creates a new Prover. The cache is optional, proofs and blocks are fetched
+// again for each proof without one.
+func New(blocker blocker, cacheOption int, cache *Cache) (*Prover, error) {
 	if blocker == nil {
 		return nil, relayer.ErrNoEthClient
 	}
@@ -31,5 +34,6 @@ func New(blocker blocker, cacheOption int) (*Prover, error) {
 	return &Prover{
 		blocker:     blocker,
 		cacheOption: cacheOption,
+		cache:       cache,
 	}, nil
 }
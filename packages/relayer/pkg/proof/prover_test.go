@@ -37,7 +37,7 @@ func Test_New(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := New(tt.blocker, encoding.CACHE_BOTH)
+			_, err := New(tt.blocker, encoding.CACHE_BOTH, nil)
 			assert.Equal(t, tt.wantErr, err)
 		})
 	}
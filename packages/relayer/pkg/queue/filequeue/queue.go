@@ -0,0 +1,614 @@
+package filequeue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+const (
+	readyDir    = "ready"
+	inflightDir = "inflight"
+	deadDir     = "dead"
+	tmpDir      = "tmp"
+
+	// unprofitableSuffix is the suffix of the queue name unprofitable messages are published to,
+	// they are delivered to the main queue again once they are expired, the same as the
+	// dead-lettered unprofitable queue of the RabbitMQ implementation.
+	unprofitableSuffix = "-unprofitable"
+
+	defaultPollInterval = 1 * time.Second
+	// defaultLeaseTTL is the time after which the messages in flight of a consumer which stopped
+	// renewing its lease are requeued.
+	defaultLeaseTTL = 30 * time.Second
+)
+
+// record is a message persisted on disk.
+type record struct {
+	ID      string                 `json:"id"`
+	Body    []byte                 `json:"body"`
+	Headers map[string]interface{} `json:"headers,omitempty"`
+	// ExpiresAt is the unix time in nanoseconds after which the message is dead-lettered
+	// instead of delivered, zero means the message never expires.
+	ExpiresAt int64 `json:"expiresAt,omitempty"`
+}
+
+// delivery is the internal value of a delivered queue.Message.
+type delivery struct {
+	id   string
+	file string
+}
+
+// FileQueue is a durable queue embedded in the relayer process, each message is a file in a
+// directory, and messages are claimed by atomically renaming them, so the queue directory
+// can be shared by the indexer and processor processes running on the same machine.
+//
+// Each consumer claims messages into its own directory in the inflight directory, and holds
+// a lease on it by renewing the modification time of the directory. Once a lease expires, the
+// consumer is considered dead, and its messages are requeued by the other consumers.
+type FileQueue struct {
+	dir           string
+	prefetchCount uint64
+	pollInterval  time.Duration
+	leaseTTL      time.Duration
+
+	queueName string
+	// owner is the name of the inflight directory of this consumer.
+	owner string
+
+	// wakeCh wakes up the subscription when a message is published or requeued by this process.
+	wakeCh chan struct{}
+
+	mu       sync.Mutex
+	inflight uint64
+
+	closeOnce sync.Once
+	closeCh   chan struct{}
+}
+
+// NewQueue creates a new file backed queue in the directory of the given options.
+func NewQueue(opts queue.NewQueueOpts) (*FileQueue, error) {
+	if opts.Dir == "" {
+		return nil, errors.New("queue directory is required")
+	}
+
+	slog.Info("opening file queue", "dir", opts.Dir)
+
+	if err := os.MkdirAll(opts.Dir, 0o755); err != nil {
+		relayer.QueueConnectionInstantiatedErrors.Inc()
+
+		return nil, err
+	}
+
+	relayer.QueueConnectionInstantiated.Inc()
+
+	return &FileQueue{
+		dir:           opts.Dir,
+		prefetchCount: opts.PrefetchCount,
+		pollInterval:  defaultPollInterval,
+		leaseTTL:      defaultLeaseTTL,
+		owner:         uuid.New().String(),
+		wakeCh:        make(chan struct{}, 1),
+		closeCh:       make(chan struct{}),
+	}, nil
+}
+
+// Start creates the directories of the given queue.
+func (q *FileQueue) Start(ctx context.Context, queueName string) error {
+	slog.Info("declaring file queue", "queue", queueName)
+
+	if err := q.mkdirs(queueName); err != nil {
+		return err
+	}
+
+	q.queueName = queueName
+
+	return nil
+}
+
+// mkdirs creates the directories of the given queue.
+func (q *FileQueue) mkdirs(queueName string) error {
+	for _, dir := range []string{readyDir, inflightDir, deadDir, tmpDir} {
+		if err := os.MkdirAll(filepath.Join(q.dir, queueName, dir), 0o755); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close stops the subscription, messages still in flight are redelivered once its lease expires.
+func (q *FileQueue) Close(ctx context.Context) {
+	q.closeOnce.Do(func() {
+		close(q.closeCh)
+	})
+
+	slog.Info("closed file queue")
+}
+
+// Publish persists the message in the given queue. Messages published to the unprofitable
+// queue of the started queue are delivered to it once they are expired, otherwise the message
+// is dead-lettered once it is expired.
+func (q *FileQueue) Publish(
+	ctx context.Context,
+	queueName string,
+	msg []byte,
+	headers map[string]interface{},
+	expiration *string,
+) error {
+	slog.Info("publishing file queue msg to queue", "queue", queueName)
+
+	var ttl time.Duration
+
+	if expiration != nil {
+		ms, err := strconv.ParseInt(*expiration, 10, 64)
+		if err != nil || ms < 0 {
+			relayer.QueueMessagePublishedErrors.Inc()
+
+			return fmt.Errorf("invalid message expiration: %v", *expiration)
+		}
+
+		ttl = time.Duration(ms) * time.Millisecond
+	}
+
+	var (
+		now         = time.Now()
+		availableAt = now
+		r           = &record{ID: uuid.New().String(), Body: msg, Headers: headers}
+	)
+
+	if q.queueName != "" && queueName == q.queueName+unprofitableSuffix {
+		queueName = q.queueName
+		availableAt = now.Add(ttl)
+	} else if expiration != nil {
+		r.ExpiresAt = now.Add(ttl).UnixNano()
+	}
+
+	if err := q.write(queueName, r, availableAt); err != nil {
+		relayer.QueueMessagePublishedErrors.Inc()
+
+		return err
+	}
+
+	if queueName == q.queueName {
+		q.wake()
+	}
+
+	relayer.QueueMessagePublished.Inc()
+
+	return nil
+}
+
+// write persists the given record in the ready directory of the given queue, it's written to a
+// temporary file first, so consumers never see a partially written message.
+func (q *FileQueue) write(queueName string, r *record, availableAt time.Time) error {
+	if err := q.mkdirs(queueName); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	name := fileName(availableAt, r.ID)
+	tmp := filepath.Join(q.dir, queueName, tmpDir, name)
+
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, filepath.Join(q.dir, queueName, readyDir, name))
+}
+
+// Ack removes the message from the queue.
+func (q *FileQueue) Ack(ctx context.Context, msg queue.Message) error {
+	d := msg.Internal.(*delivery)
+
+	if err := os.Remove(q.inflightPath(d.file)); err != nil {
+		slog.Error("error acknowledging file queue message", "err", err.Error())
+		return err
+	}
+
+	q.release()
+
+	slog.Info("acknowledged file queue message", "msgId", d.id)
+
+	relayer.QueueMessageAcknowledged.Inc()
+
+	return nil
+}
+
+// Nack puts the message back to the queue if requeue is true, otherwise the message is dead-lettered.
+func (q *FileQueue) Nack(ctx context.Context, msg queue.Message, requeue bool) error {
+	d := msg.Internal.(*delivery)
+
+	dest := deadDir
+	if requeue {
+		dest = readyDir
+	}
+
+	if err := os.Rename(q.inflightPath(d.file), q.path(dest, d.file)); err != nil {
+		slog.Error("error negatively acknowledging file queue message", "err", err.Error())
+		return err
+	}
+
+	q.release()
+
+	if requeue {
+		q.wake()
+	}
+
+	slog.Info("negatively acknowledged file queue message", "msgId", d.id, "requeue", requeue)
+
+	relayer.QueueMessageNegativelyAcknowledged.Inc()
+
+	return nil
+}
+
+// Notify blocks until the context is cancelled, since the embedded queue has no connection
+// which can be closed.
+func (q *FileQueue) Notify(ctx context.Context, wg *sync.WaitGroup) error {
+	wg.Add(1)
+
+	defer func() {
+		wg.Done()
+	}()
+
+	slog.Info("file queue notify running")
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case <-q.closeCh:
+		return queue.ErrClosed
+	}
+}
+
+// Subscribe should be called by consumers.
+func (q *FileQueue) Subscribe(ctx context.Context, msgChan chan<- queue.Message, wg *sync.WaitGroup) error {
+	wg.Add(1)
+
+	defer func() {
+		wg.Done()
+	}()
+
+	slog.Info("subscribing to file queue messages", "queue", q.queueName)
+
+	if err := q.renewLease(); err != nil {
+		return err
+	}
+
+	if err := q.recover(); err != nil {
+		return err
+	}
+
+	leaseCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go q.holdLease(leaseCtx)
+
+	for {
+		next, err := q.deliver(ctx, msgChan)
+		if err != nil {
+			return err
+		}
+
+		wait := q.pollInterval
+		if !next.IsZero() && time.Until(next) < wait {
+			wait = time.Until(next)
+		}
+
+		timer := time.NewTimer(wait)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			slog.Info("file queue context cancelled")
+
+			return nil
+		case <-q.closeCh:
+			timer.Stop()
+
+			slog.Info("file queue closed")
+
+			return queue.ErrClosed
+		case <-q.wakeCh:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// holdLease renews the lease of this consumer, and requeues the messages of the consumers whose
+// lease has expired, until the context is cancelled or the queue is closed. It runs separately from
+// the delivery loop, which may block on a slow consumer for longer than the lease.
+func (q *FileQueue) holdLease(ctx context.Context) {
+	ticker := time.NewTicker(q.leaseTTL / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-q.closeCh:
+			return
+		case <-ticker.C:
+			if err := q.renewLease(); err != nil {
+				slog.Error("error renewing file queue lease", "err", err.Error())
+			}
+
+			if err := q.recover(); err != nil {
+				slog.Error("error recovering file queue messages", "err", err.Error())
+			}
+		}
+	}
+}
+
+// renewLease creates the inflight directory of this consumer if needed, and extends its lease.
+func (q *FileQueue) renewLease() error {
+	dir := filepath.Join(q.dir, q.queueName, inflightDir, q.owner)
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	return os.Chtimes(dir, now, now)
+}
+
+// recover puts the messages in flight of the consumers whose lease has expired, e.g. a previous
+// process which crashed, back to the queue.
+func (q *FileQueue) recover() error {
+	owners, err := os.ReadDir(filepath.Join(q.dir, q.queueName, inflightDir))
+	if err != nil {
+		return err
+	}
+
+	for _, owner := range owners {
+		if !owner.IsDir() || owner.Name() == q.owner {
+			continue
+		}
+
+		info, err := owner.Info()
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+
+			return err
+		}
+
+		if time.Since(info.ModTime()) < q.leaseTTL {
+			continue
+		}
+
+		count, err := q.requeue(owner.Name())
+		if err != nil {
+			return err
+		}
+
+		if count > 0 {
+			slog.Info("requeued file queue messages of an expired consumer", "owner", owner.Name(), "count", count)
+
+			q.wake()
+		}
+	}
+
+	return nil
+}
+
+// requeue moves all messages in flight of the given consumer back to the queue, and removes its
+// inflight directory. Another consumer may be requeueing the same messages concurrently.
+func (q *FileQueue) requeue(owner string) (int, error) {
+	dir := filepath.Join(q.dir, q.queueName, inflightDir, owner)
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	count := 0
+
+	for _, f := range files {
+		if err := os.Rename(filepath.Join(dir, f.Name()), q.path(readyDir, f.Name())); err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+
+			return count, err
+		}
+
+		count++
+	}
+
+	if err := os.Remove(dir); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return count, err
+	}
+
+	return count, nil
+}
+
+// deliver sends all available messages to the given channel, until the prefetch limit is reached,
+// and returns the time the next delayed message is available at.
+func (q *FileQueue) deliver(ctx context.Context, msgChan chan<- queue.Message) (time.Time, error) {
+	files, err := os.ReadDir(filepath.Join(q.dir, q.queueName, readyDir))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	names := make([]string, 0, len(files))
+	for _, f := range files {
+		names = append(names, f.Name())
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		availableAt, err := parseFileName(name)
+		if err != nil {
+			slog.Error("invalid file queue message file", "file", name, "err", err.Error())
+			continue
+		}
+
+		if availableAt.After(time.Now()) {
+			return availableAt, nil
+		}
+
+		if !q.acquire() {
+			return time.Time{}, nil
+		}
+
+		msg, ok, err := q.claim(name)
+		if err != nil || !ok {
+			q.release()
+
+			if err != nil {
+				slog.Error("error claiming file queue message", "file", name, "err", err.Error())
+			}
+
+			continue
+		}
+
+		slog.Info("file queue message found", "msgId", msg.Internal.(*delivery).id)
+
+		select {
+		case msgChan <- msg:
+		case <-ctx.Done():
+			return time.Time{}, nil
+		case <-q.closeCh:
+			return time.Time{}, nil
+		}
+	}
+
+	return time.Time{}, nil
+}
+
+// claim moves the given message file in flight, and reads it. It reports false if the message
+// has been claimed by another consumer, or if it has expired and been dead-lettered.
+func (q *FileQueue) claim(name string) (queue.Message, bool, error) {
+	if err := os.Rename(q.path(readyDir, name), q.inflightPath(name)); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return queue.Message{}, false, nil
+		}
+
+		return queue.Message{}, false, err
+	}
+
+	data, err := os.ReadFile(q.inflightPath(name))
+	if err != nil {
+		return queue.Message{}, false, err
+	}
+
+	var r record
+	if err := json.Unmarshal(data, &r); err != nil {
+		// a message which can't be decoded would never be processed, so we dead-letter it.
+		return queue.Message{}, false, errors.Join(err, os.Rename(q.inflightPath(name), q.path(deadDir, name)))
+	}
+
+	if r.ExpiresAt != 0 && time.Now().UnixNano() > r.ExpiresAt {
+		slog.Info("file queue message expired", "msgId", r.ID)
+
+		return queue.Message{}, false, os.Rename(q.inflightPath(name), q.path(deadDir, name))
+	}
+
+	return queue.Message{
+		Body:     r.Body,
+		Internal: &delivery{id: r.ID, file: name},
+	}, true, nil
+}
+
+// acquire reserves a slot for a message in flight, it reports false if the prefetch limit is reached.
+func (q *FileQueue) acquire() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.prefetchCount != 0 && q.inflight >= q.prefetchCount {
+		return false
+	}
+
+	q.inflight++
+
+	return true
+}
+
+// release frees a slot reserved by acquire, and wakes up the subscription waiting for it.
+func (q *FileQueue) release() {
+	q.mu.Lock()
+	q.inflight--
+	q.mu.Unlock()
+
+	q.wake()
+}
+
+// wake wakes up the subscription, without blocking.
+func (q *FileQueue) wake() {
+	select {
+	case q.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+// path returns the path of the given message file in the given directory of the started queue.
+func (q *FileQueue) path(dir string, name string) string {
+	return filepath.Join(q.dir, q.queueName, dir, name)
+}
+
+// inflightPath returns the path of the given message file in the inflight directory of this consumer.
+func (q *FileQueue) inflightPath(name string) string {
+	return filepath.Join(q.dir, q.queueName, inflightDir, q.owner, name)
+}
+
+// fileName returns the name of a message file, which sorts by the time the message is available at.
+func fileName(availableAt time.Time, id string) string {
+	return fmt.Sprintf("%020d-%s.json", availableAt.UnixNano(), id)
+}
+
+// parseFileName returns the time the message of the given file is available at.
+func parseFileName(name string) (time.Time, error) {
+	prefix, _, ok := strings.Cut(name, "-")
+	if !ok {
+		return time.Time{}, fmt.Errorf("invalid message file name: %v", name)
+	}
+
+	ns, err := strconv.ParseInt(prefix, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(0, ns), nil
+}
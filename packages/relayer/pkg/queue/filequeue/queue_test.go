@@ -0,0 +1,273 @@
+package filequeue
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+var testQueueName = "1-2-MessageSent-queue"
+
+func newTestQueue(t *testing.T, dir string, prefetchCount uint64) *FileQueue {
+	q, err := NewQueue(queue.NewQueueOpts{Dir: dir, PrefetchCount: prefetchCount})
+	assert.Nil(t, err)
+
+	q.pollInterval = 10 * time.Millisecond
+	q.leaseTTL = 300 * time.Millisecond
+
+	assert.Nil(t, q.Start(context.Background(), testQueueName))
+
+	return q
+}
+
+func subscribe(t *testing.T, q *FileQueue) (chan queue.Message, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var (
+		msgs = make(chan queue.Message)
+		wg   = &sync.WaitGroup{}
+		done = make(chan struct{})
+	)
+
+	go func() {
+		defer close(done)
+		assert.Nil(t, q.Subscribe(ctx, msgs, wg))
+	}()
+
+	return msgs, func() {
+		cancel()
+		<-done
+	}
+}
+
+func receive(t *testing.T, msgs chan queue.Message) queue.Message {
+	select {
+	case msg := <-msgs:
+		return msg
+	case <-time.After(5 * time.Second):
+		t.Fatal("message not received")
+	}
+
+	return queue.Message{}
+}
+
+func countFiles(t *testing.T, q *FileQueue, dir string) int {
+	files, err := os.ReadDir(filepath.Join(q.dir, testQueueName, dir))
+	assert.Nil(t, err)
+
+	return len(files)
+}
+
+func countInflight(t *testing.T, q *FileQueue) int {
+	files, err := os.ReadDir(filepath.Join(q.dir, testQueueName, inflightDir, q.owner))
+	if os.IsNotExist(err) {
+		return 0
+	}
+
+	assert.Nil(t, err)
+
+	return len(files)
+}
+
+func Test_NewQueue_NoDir(t *testing.T) {
+	_, err := NewQueue(queue.NewQueueOpts{})
+	assert.NotNil(t, err)
+}
+
+func Test_PublishSubscribeAck(t *testing.T) {
+	q := newTestQueue(t, t.TempDir(), 0)
+
+	msgs, stop := subscribe(t, q)
+	defer stop()
+
+	assert.Nil(t, q.Publish(context.Background(), testQueueName, []byte("first"), nil, nil))
+	assert.Nil(t, q.Publish(context.Background(), testQueueName, []byte("second"), nil, nil))
+
+	msg := receive(t, msgs)
+	assert.Equal(t, []byte("first"), msg.Body)
+	assert.NotNil(t, msg.Internal)
+	assert.Nil(t, q.Ack(context.Background(), msg))
+
+	msg = receive(t, msgs)
+	assert.Equal(t, []byte("second"), msg.Body)
+	assert.Nil(t, q.Ack(context.Background(), msg))
+
+	assert.Equal(t, 0, countFiles(t, q, readyDir))
+	assert.Equal(t, 0, countInflight(t, q))
+}
+
+func Test_Nack(t *testing.T) {
+	q := newTestQueue(t, t.TempDir(), 0)
+
+	msgs, stop := subscribe(t, q)
+	defer stop()
+
+	assert.Nil(t, q.Publish(context.Background(), testQueueName, []byte("msg"), nil, nil))
+
+	// requeued messages are delivered again.
+	msg := receive(t, msgs)
+	assert.Nil(t, q.Nack(context.Background(), msg, true))
+
+	msg = receive(t, msgs)
+	assert.Equal(t, []byte("msg"), msg.Body)
+
+	// otherwise they are dead-lettered.
+	assert.Nil(t, q.Nack(context.Background(), msg, false))
+	assert.Equal(t, 1, countFiles(t, q, deadDir))
+	assert.Equal(t, 0, countInflight(t, q))
+}
+
+func Test_PublishUnprofitable(t *testing.T) {
+	q := newTestQueue(t, t.TempDir(), 0)
+
+	msgs, stop := subscribe(t, q)
+	defer stop()
+
+	expiration := "200"
+	publishedAt := time.Now()
+
+	assert.Nil(t, q.Publish(
+		context.Background(),
+		testQueueName+unprofitableSuffix,
+		[]byte("unprofitable"),
+		map[string]interface{}{"retries": int64(1)},
+		&expiration,
+	))
+
+	// unprofitable messages are delivered to the main queue once they are expired.
+	msg := receive(t, msgs)
+	assert.Equal(t, []byte("unprofitable"), msg.Body)
+	assert.GreaterOrEqual(t, time.Since(publishedAt), 200*time.Millisecond)
+	assert.Nil(t, q.Ack(context.Background(), msg))
+}
+
+func Test_PublishExpired(t *testing.T) {
+	q := newTestQueue(t, t.TempDir(), 0)
+
+	expiration := "0"
+
+	assert.Nil(t, q.Publish(context.Background(), testQueueName, []byte("expired"), nil, &expiration))
+
+	time.Sleep(time.Millisecond)
+
+	msgs, stop := subscribe(t, q)
+
+	assert.Nil(t, q.Publish(context.Background(), testQueueName, []byte("msg"), nil, nil))
+
+	msg := receive(t, msgs)
+	assert.Equal(t, []byte("msg"), msg.Body)
+	assert.Nil(t, q.Ack(context.Background(), msg))
+
+	stop()
+
+	assert.Equal(t, 1, countFiles(t, q, deadDir))
+
+	invalid := "invalid"
+	assert.NotNil(t, q.Publish(context.Background(), testQueueName, []byte("msg"), nil, &invalid))
+}
+
+func Test_Prefetch(t *testing.T) {
+	q := newTestQueue(t, t.TempDir(), 1)
+
+	msgs, stop := subscribe(t, q)
+	defer stop()
+
+	assert.Nil(t, q.Publish(context.Background(), testQueueName, []byte("first"), nil, nil))
+	assert.Nil(t, q.Publish(context.Background(), testQueueName, []byte("second"), nil, nil))
+
+	msg := receive(t, msgs)
+	assert.Equal(t, []byte("first"), msg.Body)
+
+	// the second message is not delivered until the first one is acknowledged.
+	select {
+	case <-msgs:
+		t.Fatal("prefetch count exceeded")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	assert.Nil(t, q.Ack(context.Background(), msg))
+
+	msg = receive(t, msgs)
+	assert.Equal(t, []byte("second"), msg.Body)
+	assert.Nil(t, q.Ack(context.Background(), msg))
+}
+
+func Test_RecoverInflight(t *testing.T) {
+	dir := t.TempDir()
+
+	q := newTestQueue(t, dir, 0)
+
+	msgs, stop := subscribe(t, q)
+
+	assert.Nil(t, q.Publish(context.Background(), testQueueName, []byte("msg"), nil, nil))
+
+	receive(t, msgs)
+
+	stop()
+	q.Close(context.Background())
+
+	assert.Equal(t, 1, countInflight(t, q))
+
+	// messages left unacknowledged are delivered again after a restart, once the lease expires.
+	q = newTestQueue(t, dir, 0)
+
+	msgs, stop = subscribe(t, q)
+	defer stop()
+
+	msg := receive(t, msgs)
+	assert.Equal(t, []byte("msg"), msg.Body)
+	assert.Nil(t, q.Ack(context.Background(), msg))
+}
+
+func Test_RecoverInflight_LiveConsumer(t *testing.T) {
+	dir := t.TempDir()
+
+	first := newTestQueue(t, dir, 0)
+
+	msgs, stop := subscribe(t, first)
+	defer stop()
+
+	assert.Nil(t, first.Publish(context.Background(), testQueueName, []byte("msg"), nil, nil))
+
+	msg := receive(t, msgs)
+
+	// the messages in flight of a consumer holding its lease are not delivered to other consumers.
+	second := newTestQueue(t, dir, 0)
+
+	secondMsgs, secondStop := subscribe(t, second)
+	defer secondStop()
+
+	select {
+	case <-secondMsgs:
+		t.Fatal("message of a live consumer requeued")
+	case <-time.After(3 * second.leaseTTL):
+	}
+
+	assert.Nil(t, first.Ack(context.Background(), msg))
+	assert.Equal(t, 0, countFiles(t, first, readyDir))
+}
+
+func Test_Close(t *testing.T) {
+	q := newTestQueue(t, t.TempDir(), 0)
+
+	errCh := make(chan error)
+
+	go func() {
+		errCh <- q.Subscribe(context.Background(), make(chan queue.Message), &sync.WaitGroup{})
+	}()
+
+	q.Close(context.Background())
+
+	select {
+	case err := <-errCh:
+		assert.Equal(t, queue.ErrClosed, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription not closed")
+	}
+}
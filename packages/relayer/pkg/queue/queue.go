@@ -12,6 +12,12 @@ var (
 	ErrClosed = errors.New("queue connection closed")
 )
 
+// Queue types, selected with the `queue.type` flag.
+const (
+	TypeRabbitMQ = "rabbitmq"
+	TypeFile     = "file"
+)
+
 type Queue interface {
 	Start(ctx context.Context, queueName string) error
 	Close(ctx context.Context)
@@ -32,6 +38,8 @@ type QueueMessageSentBody struct {
 	Event        *bridge.BridgeMessageSent
 	ID           int
 	TimesRetried uint64
+	// LastError is the error the message last failed to be processed with.
+	LastError string `json:",omitempty"`
 }
 
 type QueueMessageProcessedBody struct {
@@ -50,4 +58,6 @@ type NewQueueOpts struct {
 	Host          string
 	Port          string
 	PrefetchCount uint64
+	// Dir is the directory the messages are stored in, only used by the file queue.
+	Dir string
 }
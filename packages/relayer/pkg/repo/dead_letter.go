@@ -0,0 +1,124 @@
+package repo
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/morkid/paginate"
+	"github.com/pkg/errors"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type DeadLetterRepository struct {
+	db DB
+}
+
+func NewDeadLetterRepository(db DB) (*DeadLetterRepository, error) {
+	if db == nil {
+		return nil, ErrNoDB
+	}
+
+	return &DeadLetterRepository{
+		db: db,
+	}, nil
+}
+
+func (r *DeadLetterRepository) Save(
+	ctx context.Context,
+	opts relayer.SaveDeadLetterOpts,
+) (*relayer.DeadLetter, error) {
+	d := &relayer.DeadLetter{
+		MsgHash:      opts.MsgHash,
+		QueueName:    opts.QueueName,
+		Body:         datatypes.JSON(opts.Body),
+		Error:        opts.Error,
+		RevertReason: opts.RevertReason,
+		TimesRetried: opts.TimesRetried,
+		Status:       relayer.DeadLetterStatusNew,
+	}
+
+	if err := r.db.GormDB().Create(d).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Create")
+	}
+
+	return d, nil
+}
+
+func (r *DeadLetterRepository) FindAll(
+	ctx context.Context,
+	req *http.Request,
+	opts relayer.FindAllDeadLettersOpts,
+) (paginate.Page, error) {
+	pg := paginate.New(&paginate.Config{
+		DefaultSize: 100,
+	})
+
+	q := r.db.GormDB().Model(&relayer.DeadLetter{})
+
+	if opts.QueueName != nil && *opts.QueueName != "" {
+		q = q.Where("queue_name = ?", *opts.QueueName)
+	}
+
+	if opts.Status != nil {
+		q = q.Where("status = ?", *opts.Status)
+	}
+
+	reqCtx := pg.With(q.Order("id DESC"))
+
+	page := reqCtx.Request(req).Response(&[]relayer.DeadLetter{})
+
+	return page, nil
+}
+
+func (r *DeadLetterRepository) FindAllByQueueNameAndStatus(
+	ctx context.Context,
+	queueName string,
+	status relayer.DeadLetterStatus,
+) ([]*relayer.DeadLetter, error) {
+	deadLetters := make([]*relayer.DeadLetter, 0)
+
+	if err := r.db.GormDB().Where("queue_name = ?", queueName).
+		Where("status = ?", status).
+		Order("id ASC").
+		Find(&deadLetters).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return deadLetters, nil
+}
+
+// FirstByMsgHash returns the latest dead letter of the message with the given hash,
+// since a requeued message can be dead-lettered again.
+func (r *DeadLetterRepository) FirstByMsgHash(
+	ctx context.Context,
+	msgHash string,
+) (*relayer.DeadLetter, error) {
+	d := &relayer.DeadLetter{}
+	if err := r.db.GormDB().Where("msg_hash = ?", msgHash).
+		Order("id DESC").
+		First(&d).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+
+		return nil, errors.Wrap(err, "r.db.First")
+	}
+
+	return d, nil
+}
+
+func (r *DeadLetterRepository) UpdateStatus(ctx context.Context, id int, status relayer.DeadLetterStatus) error {
+	if err := r.db.GormDB().Model(&relayer.DeadLetter{}).
+		Where("id = ?", id).
+		Update("status", status).Error; err != nil {
+		return errors.Wrap(err, "r.db.Update")
+	}
+
+	return nil
+}
+
+func (r *DeadLetterRepository) Delete(ctx context.Context, id int) error {
+	return r.db.GormDB().Delete(relayer.DeadLetter{}, id).Error
+}
@@ -0,0 +1,102 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+var testDeadLetterOpts = relayer.SaveDeadLetterOpts{
+	MsgHash:      testMsgHash,
+	QueueName:    "1-2-MessageSent-queue",
+	Body:         "{\"ID\":1}",
+	Error:        "execution reverted",
+	RevertReason: "B_INVALID_STATUS",
+	TimesRetried: 5,
+}
+
+func Test_NewDeadLetterRepo(t *testing.T) {
+	tests := []struct {
+		name    string
+		db      DB
+		wantErr error
+	}{
+		{
+			"success",
+			&db.DB{},
+			nil,
+		},
+		{
+			"noDb",
+			nil,
+			ErrNoDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewDeadLetterRepository(tt.db)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestIntegration_DeadLetter_SaveAndFirstByMsgHash(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	deadLetterRepo, err := NewDeadLetterRepository(db)
+	assert.Equal(t, nil, err)
+
+	_, err = deadLetterRepo.Save(context.Background(), testDeadLetterOpts)
+	assert.Equal(t, nil, err)
+
+	d, err := deadLetterRepo.FirstByMsgHash(context.Background(), testMsgHash)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, testDeadLetterOpts.QueueName, d.QueueName)
+	assert.Equal(t, testDeadLetterOpts.Error, d.Error)
+	assert.Equal(t, testDeadLetterOpts.RevertReason, d.RevertReason)
+	assert.Equal(t, testDeadLetterOpts.TimesRetried, d.TimesRetried)
+	assert.Equal(t, relayer.DeadLetterStatusNew, d.Status)
+
+	d, err = deadLetterRepo.FirstByMsgHash(context.Background(), testSecondMsgHash)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, (*relayer.DeadLetter)(nil), d)
+}
+
+func TestIntegration_DeadLetter_RequeueAndDelete(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	deadLetterRepo, err := NewDeadLetterRepository(db)
+	assert.Equal(t, nil, err)
+
+	d, err := deadLetterRepo.Save(context.Background(), testDeadLetterOpts)
+	assert.Equal(t, nil, err)
+
+	err = deadLetterRepo.UpdateStatus(context.Background(), d.ID, relayer.DeadLetterStatusRequeued)
+	assert.Equal(t, nil, err)
+
+	deadLetters, err := deadLetterRepo.FindAllByQueueNameAndStatus(
+		context.Background(),
+		testDeadLetterOpts.QueueName,
+		relayer.DeadLetterStatusRequeued,
+	)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(deadLetters))
+	assert.Equal(t, d.ID, deadLetters[0].ID)
+
+	err = deadLetterRepo.Delete(context.Background(), d.ID)
+	assert.Equal(t, nil, err)
+
+	d, err = deadLetterRepo.FirstByMsgHash(context.Background(), testMsgHash)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, (*relayer.DeadLetter)(nil), d)
+}
@@ -110,6 +110,53 @@ func (r *EventRepository) FirstByEventAndMsgHash(
 	return e, nil
 }
 
+// FindAllByMsgHash returns all the events of a message, in the order they were stored.
+func (r *EventRepository) FindAllByMsgHash(
+	ctx context.Context,
+	msgHash string,
+) ([]*relayer.Event, error) {
+	events := []*relayer.Event{}
+
+	if err := r.db.GormDB().Where("msg_hash = ?", msgHash).
+		Order("id ASC").
+		Find(&events).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return events, nil
+}
+
+// FindAllAfterID returns the events stored after the one with the given ID, in the
+// order they were stored.
+func (r *EventRepository) FindAllAfterID(
+	ctx context.Context,
+	opts relayer.FindAllAfterIDOpts,
+) ([]*relayer.Event, error) {
+	events := []*relayer.Event{}
+
+	q := r.db.GormDB().Where("id > ?", opts.ID)
+
+	if opts.Address != nil {
+		q = q.Where("message_owner = ?", strings.ToLower(opts.Address.Hex()))
+	}
+
+	if opts.MsgHash != nil && *opts.MsgHash != "" {
+		q = q.Where("msg_hash = ?", *opts.MsgHash)
+	}
+
+	if len(opts.Events) > 0 {
+		q = q.Where("event IN ?", opts.Events)
+	}
+
+	if err := q.Order("id ASC").
+		Limit(opts.Limit).
+		Find(&events).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return events, nil
+}
+
 func (r *EventRepository) FindAllByAddress(
 	ctx context.Context,
 	req *http.Request,
@@ -225,3 +272,32 @@ func (r *EventRepository) FindLatestBlockID(
 
 	return b, nil
 }
+
+// FindLatestByEventType returns the latest event of the given type and status,
+// emitted on the source chain for the destination chain, or nil if there are none.
+func (r *EventRepository) FindLatestByEventType(
+	ctx context.Context,
+	event string,
+	eventType relayer.EventType,
+	status relayer.EventStatus,
+	srcChainID uint64,
+	destChainID uint64,
+) (*relayer.Event, error) {
+	e := &relayer.Event{}
+	if err := r.db.GormDB().Where("event = ?", event).
+		Where("event_type = ?", eventType).
+		Where("status = ?", status).
+		Where("chain_id = ?", srcChainID).
+		Where("dest_chain_id = ?", destChainID).
+		Order("id DESC").
+		Limit(1).
+		First(&e).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+
+		return nil, errors.Wrap(err, "r.db.First")
+	}
+
+	return e, nil
+}
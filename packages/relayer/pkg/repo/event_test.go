@@ -505,3 +505,229 @@ func TestIntegration_Event_FirstByMsgHash(t *testing.T) {
 		})
 	}
 }
+
+func TestIntegration_Event_FindLatestByEventType(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	eventRepo, err := NewEventRepository(db)
+	assert.Equal(t, nil, err)
+
+	for _, msgHash := range []string{"0x1", "0x2"} {
+		_, err = eventRepo.Save(context.Background(), relayer.SaveEventOpts{
+			Name:           relayer.EventNameMessageSent,
+			Data:           "{}",
+			ChainID:        big.NewInt(1),
+			DestChainID:    big.NewInt(2),
+			Status:         relayer.EventStatusDone,
+			EventType:      relayer.EventTypeSendERC20,
+			MsgHash:        msgHash,
+			MessageOwner:   addr.Hex(),
+			Event:          relayer.EventNameMessageSent,
+			EmittedBlockID: 1,
+		})
+		assert.Equal(t, nil, err)
+	}
+
+	tests := []struct {
+		name        string
+		eventType   relayer.EventType
+		status      relayer.EventStatus
+		destChainID uint64
+		wantMsgHash string
+	}{
+		{
+			"success",
+			relayer.EventTypeSendERC20,
+			relayer.EventStatusDone,
+			2,
+			"0x2",
+		},
+		{
+			"noneByEventType",
+			relayer.EventTypeSendERC721,
+			relayer.EventStatusDone,
+			2,
+			"",
+		},
+		{
+			"noneByStatus",
+			relayer.EventTypeSendERC20,
+			relayer.EventStatusNew,
+			2,
+			"",
+		},
+		{
+			"noneByDestChainID",
+			relayer.EventTypeSendERC20,
+			relayer.EventStatusDone,
+			3,
+			"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := eventRepo.FindLatestByEventType(
+				context.Background(),
+				relayer.EventNameMessageSent,
+				tt.eventType,
+				tt.status,
+				1,
+				tt.destChainID,
+			)
+			assert.Equal(t, nil, err)
+
+			if tt.wantMsgHash == "" {
+				assert.Equal(t, (*relayer.Event)(nil), resp)
+			} else {
+				assert.Equal(t, tt.wantMsgHash, resp.MsgHash)
+			}
+		})
+	}
+}
+
+func TestIntegration_Event_FindAllByMsgHash(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	eventRepo, err := NewEventRepository(db)
+	assert.Equal(t, nil, err)
+
+	for _, opts := range []relayer.SaveEventOpts{
+		{Name: relayer.EventNameMessageSent, MsgHash: "0x1"},
+		{Name: relayer.EventNameMessageSent, MsgHash: "0x2"},
+		{Name: relayer.EventNameMessageProcessed, MsgHash: "0x1"},
+	} {
+		opts.Data = "{}"
+		opts.ChainID = big.NewInt(1)
+		opts.DestChainID = big.NewInt(2)
+		opts.MessageOwner = addr.Hex()
+		opts.Event = opts.Name
+
+		_, err = eventRepo.Save(context.Background(), opts)
+		assert.Equal(t, nil, err)
+	}
+
+	tests := []struct {
+		name       string
+		msgHash    string
+		wantEvents []string
+	}{
+		{
+			"success",
+			"0x1",
+			[]string{relayer.EventNameMessageSent, relayer.EventNameMessageProcessed},
+		},
+		{
+			"none",
+			"0x3",
+			[]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := eventRepo.FindAllByMsgHash(context.Background(), tt.msgHash)
+			assert.Equal(t, nil, err)
+
+			events := make([]string, 0)
+			for _, e := range resp {
+				events = append(events, e.Event)
+			}
+
+			assert.Equal(t, tt.wantEvents, events)
+		})
+	}
+}
+
+func TestIntegration_Event_FindAllAfterID(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	eventRepo, err := NewEventRepository(db)
+	assert.Equal(t, nil, err)
+
+	other := common.HexToAddress("0x2")
+
+	ids := []int{}
+
+	for _, opts := range []relayer.SaveEventOpts{
+		{Name: relayer.EventNameMessageSent, MsgHash: "0x1", MessageOwner: addr.Hex()},
+		{Name: relayer.EventNameMessageStatusChanged, MsgHash: "0x1", MessageOwner: addr.Hex()},
+		{Name: relayer.EventNameMessageProcessed, MsgHash: "0x1", MessageOwner: addr.Hex()},
+		{Name: relayer.EventNameMessageStatusChanged, MsgHash: "0x2", MessageOwner: other.Hex()},
+	} {
+		opts.Data = "{}"
+		opts.ChainID = big.NewInt(1)
+		opts.DestChainID = big.NewInt(2)
+		opts.Event = opts.Name
+
+		e, err := eventRepo.Save(context.Background(), opts)
+		assert.Equal(t, nil, err)
+
+		ids = append(ids, e.ID)
+	}
+
+	msgHash := "0x2"
+
+	tests := []struct {
+		name    string
+		opts    relayer.FindAllAfterIDOpts
+		wantIDs []int
+	}{
+		{
+			"address",
+			relayer.FindAllAfterIDOpts{
+				Address: &addr,
+				Events:  []string{relayer.EventNameMessageStatusChanged, relayer.EventNameMessageProcessed},
+				Limit:   100,
+			},
+			[]int{ids[1], ids[2]},
+		},
+		{
+			"afterID",
+			relayer.FindAllAfterIDOpts{
+				ID:      ids[1],
+				Address: &addr,
+				Limit:   100,
+			},
+			[]int{ids[2]},
+		},
+		{
+			"msgHash",
+			relayer.FindAllAfterIDOpts{
+				MsgHash: &msgHash,
+				Limit:   100,
+			},
+			[]int{ids[3]},
+		},
+		{
+			"limit",
+			relayer.FindAllAfterIDOpts{
+				Limit: 2,
+			},
+			[]int{ids[0], ids[1]},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := eventRepo.FindAllAfterID(context.Background(), tt.opts)
+			assert.Equal(t, nil, err)
+
+			gotIDs := make([]int, 0)
+			for _, e := range resp {
+				gotIDs = append(gotIDs, e.ID)
+			}
+
+			assert.Equal(t, tt.wantIDs, gotIDs)
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package processor
+
+import (
+	"context"
+	"encoding/hex"
+	"log/slog"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/proof"
+)
+
+// batchCallGasOverhead is the gas added to a batch transaction for each message,
+// to cover the cost of the multicall contract forwarding the call.
+var batchCallGasOverhead uint64 = 50_000
+
+// batchRequest is a message waiting to be processed in a batch.
+type batchRequest struct {
+	event  *bridge.BridgeMessageSent
+	hops   []proof.HopParams
+	result chan batchResult
+}
+
+// batchResult is the outcome of processing a message in a batch. If it wasn't
+// processed, and there is no error, it has to be processed on its own, with the
+// encoded signal proof if one was generated.
+type batchResult struct {
+	processed          bool
+	encodedSignalProof []byte
+	err                error
+}
+
+// processInBatch waits for the message to be ready to be proven, and has it
+// processed in a batch with the other messages which are ready at the same time.
+func (p *Processor) processInBatch(
+	ctx context.Context,
+	event *bridge.BridgeMessageSent,
+) (batchResult, error) {
+	hops, err := p.signalProofHopParams(ctx, event)
+	if err != nil {
+		return batchResult{}, err
+	}
+
+	req := &batchRequest{
+		event:  event,
+		hops:   hops,
+		result: make(chan batchResult, 1),
+	}
+
+	select {
+	case <-ctx.Done():
+		return batchResult{}, ctx.Err()
+	case p.batchCh <- req:
+	}
+
+	select {
+	case <-ctx.Done():
+		return batchResult{}, ctx.Err()
+	case result := <-req.result:
+		return result, result.err
+	}
+}
+
+// batchLoop groups the messages which are ready to be processed at the same time,
+// for up to the batch window or the max batch size, and processes each group in
+// a single transaction, until the context is done.
+func (p *Processor) batchLoop(ctx context.Context) {
+	defer p.wg.Done()
+
+	for {
+		var reqs []*batchRequest
+
+		select {
+		case <-ctx.Done():
+			return
+		case req := <-p.batchCh:
+			reqs = append(reqs, req)
+		}
+
+		window := time.NewTimer(p.batchWindow)
+
+	collect:
+		for uint64(len(reqs)) < p.batchMaxSize {
+			select {
+			case <-ctx.Done():
+				window.Stop()
+
+				return
+			case req := <-p.batchCh:
+				reqs = append(reqs, req)
+			case <-window.C:
+				break collect
+			}
+		}
+
+		window.Stop()
+
+		go p.processBatch(ctx, reqs)
+	}
+}
+
+// processBatch generates the proofs of a group of messages together, reusing the
+// hop proofs they share, and processes them in one multicall transaction. Messages
+// which couldn't be processed in the batch are handed back to be processed on
+// their own.
+func (p *Processor) processBatch(ctx context.Context, reqs []*batchRequest) {
+	hops := make([][]proof.HopParams, 0, len(reqs))
+
+	for _, req := range reqs {
+		hops = append(hops, req.hops)
+	}
+
+	encodedSignalProofs, err := p.prover.EncodedSignalProofsWithHops(ctx, hops)
+	if err != nil {
+		slog.Warn("error generating batch proofs, processing messages on their own", "error", err)
+
+		for _, req := range reqs {
+			req.result <- batchResult{}
+		}
+
+		return
+	}
+
+	ready := []*batchRequest{}
+	readyProofs := [][]byte{}
+	calls := []*processMessageCall{}
+
+	for i, req := range reqs {
+		call, err := p.prepareProcessMessageCall(ctx, req.event, encodedSignalProofs[i])
+		if err != nil {
+			req.result <- batchResult{encodedSignalProof: encodedSignalProofs[i], err: err}
+
+			continue
+		}
+
+		ready = append(ready, req)
+		readyProofs = append(readyProofs, encodedSignalProofs[i])
+		calls = append(calls, call)
+	}
+
+	fallback := func(i int) {
+		ready[i].result <- batchResult{encodedSignalProof: readyProofs[i]}
+	}
+
+	// a single message is cheaper to process on its own.
+	if len(ready) < 2 {
+		for i := range ready {
+			fallback(i)
+		}
+
+		return
+	}
+
+	receipt, err := p.sendBatch(ctx, calls)
+	if err != nil {
+		slog.Warn("error sending batch, processing messages on their own", "error", err, "size", len(ready))
+
+		for i := range ready {
+			fallback(i)
+		}
+
+		return
+	}
+
+	for i, req := range ready {
+		// a message still new wasn't processed by its call in the batch.
+		eventStatus, err := p.eventStatusFromMsgHash(ctx, req.event.MsgHash)
+		if err != nil || eventStatus == relayer.EventStatusNew {
+			slog.Warn("message not processed in batch, processing it on its own",
+				"msgHash", common.Hash(req.event.MsgHash).Hex(),
+				"txHash", receipt.TxHash.Hex(),
+			)
+
+			fallback(i)
+
+			continue
+		}
+
+		relayer.MessageSentEventsProcessed.Inc()
+		relayer.MessagesProcessedInBatch.Inc()
+
+		// the message is already processed on chain, so failing to save its new status
+		// must not have it retried or dead-lettered.
+		if err := p.saveMessageStatusChangedEvent(ctx, receipt, req.event); err != nil {
+			slog.Error("error saving status of message processed in batch",
+				"msgHash", common.Hash(req.event.MsgHash).Hex(),
+				"txHash", receipt.TxHash.Hex(),
+				"error", err,
+			)
+		}
+
+		req.result <- batchResult{processed: true}
+	}
+}
+
+// sendBatch sends the `bridge.processMessage` calls of a batch in one transaction
+// to the multicall contract. Each call is allowed to fail without the others
+// failing with it.
+func (p *Processor) sendBatch(ctx context.Context, calls []*processMessageCall) (*types.Receipt, error) {
+	multicalls := make([]encoding.Multicall3Call, 0, len(calls))
+
+	var gasLimit uint64 = 0
+
+	for _, call := range calls {
+		multicalls = append(multicalls, encoding.Multicall3Call{
+			Target:       p.cfg.DestBridgeAddress,
+			AllowFailure: true,
+			CallData:     call.data,
+		})
+
+		gasLimit += call.gasLimit + batchCallGasOverhead
+	}
+
+	data, err := encoding.Multicall3ABI.Pack("aggregate3", multicalls)
+	if err != nil {
+		return nil, errors.Wrap(err, "encoding.Multicall3ABI.Pack")
+	}
+
+	txSigner, err := p.signers.acquire(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, err := txSigner.txmgr.Send(ctx, txmgr.TxCandidate{
+		TxData:   data,
+		To:       &p.multicallAddress,
+		GasLimit: gasLimit,
+	})
+
+	p.signers.release(txSigner)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "txSigner.txmgr.Send")
+	}
+
+	slog.Info("Mined batch tx",
+		"txHash", hex.EncodeToString(receipt.TxHash.Bytes()),
+		"size", len(calls),
+	)
+
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		relayer.MessageSentEventsProcessedReverted.Inc()
+
+		return nil, errTxReverted
+	}
+
+	return receipt, nil
+}
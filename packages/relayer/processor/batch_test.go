@@ -0,0 +1,86 @@
+package processor
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/proof"
+)
+
+func newTestBatchRequest(msgHash [32]byte, key [32]byte) *batchRequest {
+	return &batchRequest{
+		event: &bridge.BridgeMessageSent{
+			MsgHash: msgHash,
+			Message: bridge.IBridgeMessage{
+				Id:          1,
+				DestChainId: mock.MockChainID.Uint64(),
+				SrcChainId:  mock.MockChainID.Uint64(),
+				Value:       big.NewInt(0),
+				GasLimit:    100,
+				Data:        []byte{},
+			},
+			Raw: types.Log{
+				Address: relayer.ZeroAddress,
+				Topics:  []common.Hash{relayer.ZeroHash},
+				Data:    []byte{0xff},
+			},
+		},
+		hops: []proof.HopParams{
+			{
+				ChainID:              mock.MockChainID,
+				SignalServiceAddress: common.Address{},
+				SignalService:        &mock.SignalService{},
+				Key:                  key,
+				Blocker:              &mock.EthClient{},
+				Caller:               &mock.Caller{},
+				BlockNumber:          uint64(mock.BlockNum),
+			},
+		},
+		result: make(chan batchResult, 1),
+	}
+}
+
+func Test_processBatch_FallsBackWhenBatchFails(t *testing.T) {
+	p := newTestProcessor(false)
+
+	reqs := []*batchRequest{
+		newTestBatchRequest(mock.SuccessMsgHash, [32]byte{0x1}),
+		newTestBatchRequest(mock.SuccessMsgHash, [32]byte{0x2}),
+	}
+
+	// the mock transaction manager returns a failed receipt, so the batch reverts.
+	p.processBatch(context.Background(), reqs)
+
+	for _, req := range reqs {
+		result := <-req.result
+		assert.Nil(t, result.err)
+		assert.False(t, result.processed)
+		assert.NotNil(t, result.encodedSignalProof)
+	}
+}
+
+func Test_processBatch_UnprocessableMessage(t *testing.T) {
+	p := newTestProcessor(false)
+
+	reqs := []*batchRequest{
+		newTestBatchRequest(mock.SuccessMsgHash, [32]byte{0x1}),
+		newTestBatchRequest([32]byte{0x3}, [32]byte{0x2}),
+	}
+
+	p.processBatch(context.Background(), reqs)
+
+	// the first message is the only one left, so it is processed on its own.
+	result := <-reqs[0].result
+	assert.Nil(t, result.err)
+	assert.False(t, result.processed)
+
+	result = <-reqs[1].result
+	assert.Equal(t, errUnprocessable, result.err)
+}
@@ -62,6 +62,14 @@ func Test_canProcessMessage(t *testing.T) {
 			5,
 			false,
 		},
+		{
+			"canProcess, eventStatusNew, gasLimit 0, and another relayer key is owner",
+			relayer.EventStatusNew,
+			common.HexToAddress("0x1234"),
+			relayerAddr,
+			0,
+			true,
+		},
 		{
 			"canProcess, eventStatusNew, gasLimit 0, and relayer address is owner",
 			relayer.EventStatusNew,
@@ -78,7 +86,7 @@ func Test_canProcessMessage(t *testing.T) {
 				context.Background(),
 				tt.eventStatus,
 				tt.messageOwner,
-				tt.relayerAddress,
+				[]common.Address{tt.relayerAddress, common.HexToAddress("0x1234")},
 				tt.gasLimit,
 			)
 
@@ -3,6 +3,7 @@ package processor
 import (
 	"crypto/ecdsa"
 	"fmt"
+	"math/big"
 
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
@@ -11,7 +12,6 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
 	pkgFlags "github.com/taikoxyz/taiko-mono/packages/relayer/pkg/flags"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
-	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/rabbitmq"
 	"github.com/urfave/cli/v2"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
@@ -66,6 +66,8 @@ type Config struct {
 	DatabaseMaxOpenConns    uint64
 	DatabaseMaxConnLifetime uint64
 	// queue configs
+	QueueType     string
+	QueueDir      string
 	QueueUsername string
 	QueuePassword string
 	QueueHost     string
@@ -86,6 +88,21 @@ type Config struct {
 	TxmgrConfigs *txmgr.CLIConfig
 
 	MaxMessageRetries uint64
+
+	DeadLetterRequeueInterval uint64
+
+	PriorityWorkers uint64
+	PriorityMaxWait uint64
+
+	SignerPrivateKeys          []*ecdsa.PrivateKey
+	SignerMinBalance           *big.Int
+	SignerBalanceCheckInterval uint64
+
+	BatchMulticallAddress common.Address
+	BatchMaxSize          uint64
+	BatchWindow           uint64
+
+	ProofCacheSize uint64
 }
 
 // NewConfigFromCliContext creates a new config instance from command line flags.
@@ -130,6 +147,41 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		unprofitableMessageQueueExpiration = &u
 	}
 
+	signerPrivateKeys := []*ecdsa.PrivateKey{}
+	signerAddresses := map[common.Address]bool{
+		crypto.PubkeyToAddress(processorPrivateKey.PublicKey): true,
+	}
+
+	for _, k := range c.StringSlice(flags.SignerPrivateKeys.Name) {
+		key, err := crypto.ToECDSA(common.Hex2Bytes(k))
+		if err != nil {
+			return nil, fmt.Errorf("invalid signer.privateKeys: %w", err)
+		}
+
+		addr := crypto.PubkeyToAddress(key.PublicKey)
+		if signerAddresses[addr] {
+			return nil, fmt.Errorf("duplicate signer key for address %v", addr.Hex())
+		}
+
+		signerAddresses[addr] = true
+
+		signerPrivateKeys = append(signerPrivateKeys, key)
+	}
+
+	signerMinBalance, ok := new(big.Int).SetString(c.String(flags.SignerMinBalance.Name), 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid signer.minBalance: %v", c.String(flags.SignerMinBalance.Name))
+	}
+
+	if c.Uint64(flags.DeadLetterRequeueInterval.Name) == 0 {
+		return nil, fmt.Errorf("invalid deadLetter.requeueInterval: must be greater than 0")
+	}
+
+	var batchMulticallAddress common.Address
+	if c.IsSet(flags.BatchMulticallAddress.Name) {
+		batchMulticallAddress = common.HexToAddress(c.String(flags.BatchMulticallAddress.Name))
+	}
+
 	var destQuotaManagerAddress common.Address
 	if c.IsSet(flags.DestQuotaManagerAddress.Name) {
 		destQuotaManagerAddress = common.HexToAddress(c.String(flags.DestQuotaManagerAddress.Name))
@@ -152,6 +204,8 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		DatabaseMaxIdleConns:               c.Uint64(flags.DatabaseMaxIdleConns.Name),
 		DatabaseMaxOpenConns:               c.Uint64(flags.DatabaseMaxOpenConns.Name),
 		DatabaseMaxConnLifetime:            c.Uint64(flags.DatabaseConnMaxLifetime.Name),
+		QueueType:                          c.String(flags.QueueType.Name),
+		QueueDir:                           c.String(flags.QueueDir.Name),
 		QueueUsername:                      c.String(flags.QueueUsername.Name),
 		QueuePassword:                      c.String(flags.QueuePassword.Name),
 		QueuePort:                          c.Uint64(flags.QueuePort.Name),
@@ -175,7 +229,17 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 			processorPrivateKey,
 			c,
 		),
-		MaxMessageRetries: c.Uint64(flags.MaxMessageRetries.Name),
+		MaxMessageRetries:          c.Uint64(flags.MaxMessageRetries.Name),
+		DeadLetterRequeueInterval:  c.Uint64(flags.DeadLetterRequeueInterval.Name),
+		PriorityWorkers:            c.Uint64(flags.PriorityWorkers.Name),
+		PriorityMaxWait:            c.Uint64(flags.PriorityMaxWait.Name),
+		SignerPrivateKeys:          signerPrivateKeys,
+		SignerMinBalance:           signerMinBalance,
+		SignerBalanceCheckInterval: c.Uint64(flags.SignerBalanceCheckInterval.Name),
+		BatchMulticallAddress:      batchMulticallAddress,
+		BatchMaxSize:               c.Uint64(flags.BatchMaxSize.Name),
+		BatchWindow:                c.Uint64(flags.BatchWindow.Name),
+		ProofCacheSize:             c.Uint64(flags.ProofCacheSize.Name),
 		OpenDBFunc: func() (DB, error) {
 			return db.OpenDBConnection(db.DBConnectionOpts{
 				Name:            c.String(flags.DatabaseUsername.Name),
@@ -198,20 +262,7 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 			})
 		},
 		OpenQueueFunc: func() (queue.Queue, error) {
-			opts := queue.NewQueueOpts{
-				Username:      c.String(flags.QueueUsername.Name),
-				Password:      c.String(flags.QueuePassword.Name),
-				Host:          c.String(flags.QueueHost.Name),
-				Port:          c.String(flags.QueuePort.Name),
-				PrefetchCount: c.Uint64(flags.QueuePrefetchCount.Name),
-			}
-
-			q, err := rabbitmq.NewQueue(opts)
-			if err != nil {
-				return nil, err
-			}
-
-			return q, nil
+			return pkgFlags.OpenQueueFromCli(c)
 		},
 	}, nil
 }
@@ -1,6 +1,7 @@
 package processor
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -23,6 +24,7 @@ var (
 	databaseMaxOpenConns    = "10"
 	databaseMaxConnLifetime = "30"
 	ethClientTimeout        = "10"
+	dummySignerKey          = "39725efee3fb28614de3bacaffe4cc4bd8c436257e2c8bb887c4b5c4be45e76d"
 )
 
 func setupApp() *cli.App {
@@ -70,6 +72,15 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		assert.Equal(t, true, c.ProfitableOnly)
 		assert.Equal(t, uint64(100), c.QueuePrefetch)
 		assert.Equal(t, true, c.EnableTaikoL2)
+		assert.Equal(t, uint64(4), c.PriorityWorkers)
+		assert.Equal(t, uint64(120), c.PriorityMaxWait)
+		assert.Equal(t, 1, len(c.SignerPrivateKeys))
+		assert.Equal(t, big.NewInt(100000000), c.SignerMinBalance)
+		assert.Equal(t, uint64(30), c.SignerBalanceCheckInterval)
+		assert.Equal(t, common.HexToAddress(destBridgeAddr), c.BatchMulticallAddress)
+		assert.Equal(t, uint64(5), c.BatchMaxSize)
+		assert.Equal(t, uint64(3), c.BatchWindow)
+		assert.Equal(t, uint64(50), c.ProofCacheSize)
 
 		c.OpenDBFunc = func() (DB, error) {
 			return &mock.DB{}, nil
@@ -116,6 +127,15 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		"--" + flags.ProfitableOnly.Name,
 		"--" + flags.EnableTaikoL2.Name,
 		"--" + flags.DestQuotaManagerAddress.Name, destQuotaManagerAddr,
+		"--" + flags.PriorityWorkers.Name, "4",
+		"--" + flags.PriorityMaxWait.Name, "120",
+		"--" + flags.SignerPrivateKeys.Name, dummySignerKey,
+		"--" + flags.SignerMinBalance.Name, "100000000",
+		"--" + flags.SignerBalanceCheckInterval.Name, "30",
+		"--" + flags.BatchMulticallAddress.Name, destBridgeAddr,
+		"--" + flags.BatchMaxSize.Name, "5",
+		"--" + flags.BatchWindow.Name, "3",
+		"--" + flags.ProofCacheSize.Name, "50",
 	}))
 }
 
@@ -143,3 +163,55 @@ func TestNewConfigFromCliContext_PrivKeyError(t *testing.T) {
 		"--" + flags.DestQuotaManagerAddress.Name, destQuotaManagerAddr,
 	}), "invalid processorPrivateKey")
 }
+
+func TestNewConfigFromCliContext_DuplicateSignerKeyError(t *testing.T) {
+	app := setupApp()
+	assert.ErrorContains(t, app.Run([]string{
+		"TestingNewConfigFromCliContext",
+		"--" + flags.DatabaseUsername.Name, "dbuser",
+		"--" + flags.DatabasePassword.Name, "dbpass",
+		"--" + flags.DatabaseHost.Name, "dbhost",
+		"--" + flags.DatabaseName.Name, "dbname",
+		"--" + flags.QueueUsername.Name, "queuename",
+		"--" + flags.QueuePassword.Name, "queuepassword",
+		"--" + flags.QueueHost.Name, "queuehost",
+		"--" + flags.QueuePort.Name, "5555",
+		"--" + flags.SrcRPCUrl.Name, "srcRpcUrl",
+		"--" + flags.DestRPCUrl.Name, "destRpcUrl",
+		"--" + flags.DestBridgeAddress.Name, destBridgeAddr,
+		"--" + flags.SrcSignalServiceAddress.Name, destBridgeAddr,
+		"--" + flags.DestERC721VaultAddress.Name, destBridgeAddr,
+		"--" + flags.DestERC20VaultAddress.Name, destBridgeAddr,
+		"--" + flags.DestERC1155VaultAddress.Name, destBridgeAddr,
+		"--" + flags.DestTaikoAddress.Name, destBridgeAddr,
+		"--" + flags.ProcessorPrivateKey.Name, dummyEcdsaKey,
+		"--" + flags.SignerPrivateKeys.Name, dummyEcdsaKey,
+		"--" + flags.DestQuotaManagerAddress.Name, destQuotaManagerAddr,
+	}), "duplicate signer key")
+}
+
+func TestNewConfigFromCliContext_DeadLetterRequeueIntervalError(t *testing.T) {
+	app := setupApp()
+	assert.ErrorContains(t, app.Run([]string{
+		"TestingNewConfigFromCliContext",
+		"--" + flags.DatabaseUsername.Name, "dbuser",
+		"--" + flags.DatabasePassword.Name, "dbpass",
+		"--" + flags.DatabaseHost.Name, "dbhost",
+		"--" + flags.DatabaseName.Name, "dbname",
+		"--" + flags.QueueUsername.Name, "queuename",
+		"--" + flags.QueuePassword.Name, "queuepassword",
+		"--" + flags.QueueHost.Name, "queuehost",
+		"--" + flags.QueuePort.Name, "5555",
+		"--" + flags.SrcRPCUrl.Name, "srcRpcUrl",
+		"--" + flags.DestRPCUrl.Name, "destRpcUrl",
+		"--" + flags.DestBridgeAddress.Name, destBridgeAddr,
+		"--" + flags.SrcSignalServiceAddress.Name, destBridgeAddr,
+		"--" + flags.DestERC721VaultAddress.Name, destBridgeAddr,
+		"--" + flags.DestERC20VaultAddress.Name, destBridgeAddr,
+		"--" + flags.DestERC1155VaultAddress.Name, destBridgeAddr,
+		"--" + flags.DestTaikoAddress.Name, destBridgeAddr,
+		"--" + flags.ProcessorPrivateKey.Name, dummyEcdsaKey,
+		"--" + flags.DeadLetterRequeueInterval.Name, "0",
+		"--" + flags.DestQuotaManagerAddress.Name, destQuotaManagerAddr,
+	}), "invalid deadLetter.requeueInterval")
+}
@@ -0,0 +1,152 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+// retriedMessageBody returns the body of a message which failed to be processed with the
+// given error, to be published again, with its retry count incremented.
+func retriedMessageBody(body []byte, processErr error) ([]byte, error) {
+	msgBody := &queue.QueueMessageSentBody{}
+	if err := json.Unmarshal(body, msgBody); err != nil {
+		return nil, errors.Wrap(err, "json.Unmarshal")
+	}
+
+	msgBody.TimesRetried++
+	msgBody.LastError = processErr.Error()
+
+	return json.Marshal(msgBody)
+}
+
+// revertReason returns the decoded revert reason of the given error, if it was returned
+// by a reverted contract call, or an empty string otherwise.
+func revertReason(err error) string {
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return ""
+	}
+
+	data, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return ""
+	}
+
+	reason, err := relayer.DecodeRevertReason(data)
+	if err != nil {
+		return ""
+	}
+
+	return reason
+}
+
+// deadLetter stores a message the processor gives up on in the dead-letter store,
+// with the error it failed with, and then acknowledges it. If it can't be stored, the
+// message is negatively acknowledged without being requeued instead.
+func (p *Processor) deadLetter(ctx context.Context, m queue.Message, processErr error) {
+	opts := relayer.SaveDeadLetterOpts{
+		QueueName:    p.queueName(),
+		Body:         string(m.Body),
+		Error:        processErr.Error(),
+		RevertReason: revertReason(processErr),
+	}
+
+	msgBody := &queue.QueueMessageSentBody{}
+	if err := json.Unmarshal(m.Body, msgBody); err == nil {
+		if msgBody.Event != nil {
+			opts.MsgHash = common.Hash(msgBody.Event.MsgHash).Hex()
+		}
+
+		opts.TimesRetried = msgBody.TimesRetried
+
+		// a message reaching the max retries failed with the error of its last retry.
+		if errors.Is(processErr, errMaxRetriesReached) && msgBody.LastError != "" {
+			opts.Error = msgBody.LastError
+		}
+	}
+
+	if _, err := p.deadLetterRepo.Save(ctx, opts); err != nil {
+		slog.Error("error saving dead letter", "msgHash", opts.MsgHash, "error", err)
+
+		if err := p.queue.Nack(ctx, m, false); err != nil {
+			slog.Error("Err nacking message", "err", err.Error())
+		}
+
+		return
+	}
+
+	relayer.DeadLetteredMessages.Inc()
+
+	slog.Warn("message dead-lettered", "msgHash", opts.MsgHash, "error", opts.Error, "revertReason", opts.RevertReason)
+
+	if err := p.queue.Ack(ctx, m); err != nil {
+		slog.Error("Err acking message", "err", err.Error())
+	}
+}
+
+// requeueDeadLetters publishes the dead-lettered messages of this processor's queue, which
+// an operator has requeued, to the queue again, with their retry count reset.
+func (p *Processor) requeueDeadLetters(ctx context.Context) error {
+	deadLetters, err := p.deadLetterRepo.FindAllByQueueNameAndStatus(
+		ctx,
+		p.queueName(),
+		relayer.DeadLetterStatusRequeued,
+	)
+	if err != nil {
+		return errors.Wrap(err, "p.deadLetterRepo.FindAllByQueueNameAndStatus")
+	}
+
+	for _, d := range deadLetters {
+		body := []byte(d.Body)
+
+		msgBody := &queue.QueueMessageSentBody{}
+		if err := json.Unmarshal(body, msgBody); err == nil {
+			msgBody.TimesRetried = 0
+			msgBody.LastError = ""
+
+			if body, err = json.Marshal(msgBody); err != nil {
+				return errors.Wrap(err, "json.Marshal")
+			}
+		}
+
+		if err := p.queue.Publish(ctx, p.queueName(), body, nil, nil); err != nil {
+			return errors.Wrap(err, "p.queue.Publish")
+		}
+
+		if err := p.deadLetterRepo.Delete(ctx, d.ID); err != nil {
+			return errors.Wrap(err, "p.deadLetterRepo.Delete")
+		}
+
+		slog.Info("dead letter requeued", "msgHash", d.MsgHash)
+	}
+
+	return nil
+}
+
+// requeueDeadLettersLoop periodically requeues the dead-lettered messages requeued
+// by an operator, until the context is done.
+func (p *Processor) requeueDeadLettersLoop(ctx context.Context) {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.deadLetterRequeueInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.requeueDeadLetters(ctx); err != nil {
+				slog.Error("error requeueing dead letters", "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+// revertError is a contract call error with revert data, like the ones returned by the RPC.
+type revertError struct {
+	data string
+}
+
+func (e *revertError) Error() string          { return "execution reverted" }
+func (e *revertError) ErrorData() interface{} { return e.data }
+
+func newRevertError(reason string) error {
+	data := common.FromHex("0x08c379a0")
+	data = append(data, common.LeftPadBytes(big.NewInt(32).Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(big.NewInt(int64(len(reason))).Bytes(), 32)...)
+	data = append(data, common.RightPadBytes([]byte(reason), 32)...)
+
+	return &revertError{data: hexutil.Encode(data)}
+}
+
+func newTestMessage(t *testing.T, timesRetried uint64, lastError string) queue.Message {
+	body, err := json.Marshal(&queue.QueueMessageSentBody{
+		Event: &bridge.BridgeMessageSent{
+			MsgHash: [32]byte{0x1},
+			Raw: types.Log{
+				Address: relayer.ZeroAddress,
+				Topics:  []common.Hash{relayer.ZeroHash},
+				Data:    []byte{0xff},
+			},
+		},
+		TimesRetried: timesRetried,
+		LastError:    lastError,
+	})
+	assert.Nil(t, err)
+
+	return queue.Message{Body: body}
+}
+
+func Test_revertReason(t *testing.T) {
+	assert.Equal(t, "B_INVALID_STATUS", revertReason(newRevertError("B_INVALID_STATUS")))
+	assert.Equal(t, "", revertReason(errors.New("not a revert")))
+}
+
+func Test_retriedMessageBody(t *testing.T) {
+	body, err := retriedMessageBody(newTestMessage(t, 1, "").Body, relayer.ErrUnprofitable)
+	assert.Nil(t, err)
+
+	msgBody := &queue.QueueMessageSentBody{}
+	assert.Nil(t, json.Unmarshal(body, msgBody))
+	assert.Equal(t, uint64(2), msgBody.TimesRetried)
+	assert.Equal(t, relayer.ErrUnprofitable.Error(), msgBody.LastError)
+}
+
+func Test_deadLetter(t *testing.T) {
+	p := newTestProcessor(true)
+
+	p.deadLetter(context.Background(), newTestMessage(t, 0, ""), newRevertError("B_INVALID_STATUS"))
+
+	msgHash := common.Hash([32]byte{0x1}).Hex()
+
+	d, err := p.deadLetterRepo.FirstByMsgHash(context.Background(), msgHash)
+	assert.Nil(t, err)
+	assert.NotNil(t, d)
+	assert.Equal(t, p.queueName(), d.QueueName)
+	assert.Equal(t, "execution reverted", d.Error)
+	assert.Equal(t, "B_INVALID_STATUS", d.RevertReason)
+	assert.Equal(t, relayer.DeadLetterStatusNew, d.Status)
+
+	// messages reaching the max retries keep the error of their last retry.
+	p.deadLetter(context.Background(), newTestMessage(t, 5, "unprofitable"), errMaxRetriesReached)
+
+	d, err = p.deadLetterRepo.FirstByMsgHash(context.Background(), msgHash)
+	assert.Nil(t, err)
+	assert.Equal(t, "unprofitable", d.Error)
+	assert.Equal(t, uint64(5), d.TimesRetried)
+}
+
+func Test_requeueDeadLetters(t *testing.T) {
+	p := newTestProcessor(true)
+
+	p.deadLetter(context.Background(), newTestMessage(t, 5, "unprofitable"), errMaxRetriesReached)
+	p.deadLetter(context.Background(), newTestMessage(t, 0, ""), errors.New("error"))
+
+	deadLetters, err := p.deadLetterRepo.FindAllByQueueNameAndStatus(
+		context.Background(),
+		p.queueName(),
+		relayer.DeadLetterStatusNew,
+	)
+	assert.Nil(t, err)
+	assert.Len(t, deadLetters, 2)
+
+	// only the dead letters requeued by an operator are published again.
+	assert.Nil(t, p.deadLetterRepo.UpdateStatus(context.Background(), deadLetters[0].ID, relayer.DeadLetterStatusRequeued))
+
+	assert.Nil(t, p.requeueDeadLetters(context.Background()))
+
+	remaining, err := p.deadLetterRepo.FindAllByQueueNameAndStatus(
+		context.Background(),
+		p.queueName(),
+		relayer.DeadLetterStatusNew,
+	)
+	assert.Nil(t, err)
+	assert.Len(t, remaining, 1)
+	assert.Equal(t, deadLetters[1].ID, remaining[0].ID)
+
+	requeued, err := p.deadLetterRepo.FindAllByQueueNameAndStatus(
+		context.Background(),
+		p.queueName(),
+		relayer.DeadLetterStatusRequeued,
+	)
+	assert.Nil(t, err)
+	assert.Empty(t, requeued)
+}
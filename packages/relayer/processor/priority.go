@@ -0,0 +1,210 @@
+package processor
+
+import (
+	"container/heap"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+// prioritizedMessage is a queue message waiting in the messageScheduler.
+type prioritizedMessage struct {
+	msg        queue.Message
+	feePerGas  float64
+	seq        uint64
+	receivedAt time.Time
+	index      int
+	scheduled  bool
+}
+
+// messageHeap orders messages by fee per gas, highest first, and by the order
+// they were received in for equal fees.
+type messageHeap []*prioritizedMessage
+
+func (h messageHeap) Len() int { return len(h) }
+
+func (h messageHeap) Less(i, j int) bool {
+	if h[i].feePerGas != h[j].feePerGas {
+		return h[i].feePerGas > h[j].feePerGas
+	}
+
+	return h[i].seq < h[j].seq
+}
+
+func (h messageHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *messageHeap) Push(x interface{}) {
+	m := x.(*prioritizedMessage)
+	m.index = len(*h)
+	*h = append(*h, m)
+}
+
+func (h *messageHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	m := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+
+	return m
+}
+
+// feePerGas returns the fee per gas of the message in a queue message body, which is
+// what the relayer earns for each unit of gas it spends processing it. Messages which
+// can't be decoded, or have no gas limit, have the lowest priority.
+func feePerGas(body []byte) float64 {
+	msgBody := &queue.QueueMessageSentBody{}
+	if err := json.Unmarshal(body, msgBody); err != nil {
+		return 0
+	}
+
+	if msgBody.Event == nil || msgBody.Event.Message.GasLimit == 0 {
+		return 0
+	}
+
+	return float64(msgBody.Event.Message.Fee) / float64(msgBody.Event.Message.GasLimit)
+}
+
+// messageScheduler buffers the messages delivered by the queue, and hands them out
+// to the processing workers by the highest fee per gas first. To keep low-fee
+// messages from starving, a message which has waited for maxWait or longer is
+// handed out before any other, in the order they were received in.
+type messageScheduler struct {
+	mu       sync.Mutex
+	messages messageHeap
+	received []*prioritizedMessage
+	nextSeq  uint64
+	maxWait  time.Duration
+	notify   chan struct{}
+	now      func() time.Time
+}
+
+func newMessageScheduler(maxWait time.Duration) *messageScheduler {
+	return &messageScheduler{
+		messages: make(messageHeap, 0),
+		received: make([]*prioritizedMessage, 0),
+		maxWait:  maxWait,
+		notify:   make(chan struct{}, 1),
+		now:      time.Now,
+	}
+}
+
+// push adds a message to the scheduler.
+func (s *messageScheduler) push(m queue.Message) {
+	s.mu.Lock()
+
+	pm := &prioritizedMessage{
+		msg:        m,
+		feePerGas:  feePerGas(m.Body),
+		seq:        s.nextSeq,
+		receivedAt: s.now(),
+	}
+
+	s.nextSeq++
+
+	heap.Push(&s.messages, pm)
+	s.received = append(s.received, pm)
+
+	s.mu.Unlock()
+
+	s.signal()
+}
+
+// pop blocks until a message is available, and returns the one to process next,
+// or false if the context is done first.
+func (s *messageScheduler) pop(ctx context.Context) (queue.Message, bool) {
+	for {
+		s.mu.Lock()
+
+		pm := s.next()
+		remaining := s.messages.Len()
+
+		s.mu.Unlock()
+
+		if pm != nil {
+			// wake up another worker for the messages left.
+			if remaining > 0 {
+				s.signal()
+			}
+
+			return pm.msg, true
+		}
+
+		select {
+		case <-ctx.Done():
+			return queue.Message{}, false
+		case <-s.notify:
+		}
+	}
+}
+
+// next removes and returns the message to process next, or nil if there are none.
+// It must be called with the lock held.
+func (s *messageScheduler) next() *prioritizedMessage {
+	// drop the messages at the front which were already handed out by fee.
+	for len(s.received) > 0 && s.received[0].scheduled {
+		s.received[0] = nil
+		s.received = s.received[1:]
+	}
+
+	if len(s.received) == 0 {
+		return nil
+	}
+
+	oldest := s.received[0]
+
+	if s.maxWait > 0 && s.now().Sub(oldest.receivedAt) >= s.maxWait {
+		s.received = s.received[1:]
+
+		heap.Remove(&s.messages, oldest.index)
+
+		oldest.scheduled = true
+
+		return oldest
+	}
+
+	pm := heap.Pop(&s.messages).(*prioritizedMessage)
+
+	pm.scheduled = true
+
+	return pm
+}
+
+func (s *messageScheduler) signal() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// scheduleWhenReady waits for the message to be confirmed, and for the headers its proof
+// depends on to be synced, before pushing it to the scheduler, so the priority workers
+// don't sit idle waiting for them. Messages which fail to get ready are still pushed,
+// processing them handles the error the same way.
+func (p *Processor) scheduleWhenReady(ctx context.Context, m queue.Message) {
+	msgBody := &queue.QueueMessageSentBody{}
+	if err := json.Unmarshal(m.Body, msgBody); err == nil &&
+		msgBody.Event != nil &&
+		msgBody.TimesRetried < p.maxMessageRetries {
+		if err := p.waitForConfirmations(ctx, msgBody.Event.Raw.TxHash, msgBody.Event.Raw.BlockNumber); err != nil {
+			slog.Warn("error waiting for message confirmations", "srcTxHash", msgBody.Event.Raw.TxHash.Hex(), "error", err)
+		} else if _, err := p.waitHeadersSynced(ctx, msgBody.Event); err != nil {
+			slog.Warn("error waiting for message headers synced", "srcTxHash", msgBody.Event.Raw.TxHash.Hex(), "error", err)
+		}
+	}
+
+	// the message is redelivered by the queue after a restart.
+	if ctx.Err() != nil {
+		return
+	}
+
+	p.scheduler.push(m)
+}
@@ -0,0 +1,152 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+func newTestFeeMessage(t *testing.T, id uint64, fee uint64, gasLimit uint32) queue.Message {
+	body, err := json.Marshal(&queue.QueueMessageSentBody{
+		Event: &bridge.BridgeMessageSent{
+			Message: bridge.IBridgeMessage{
+				Id:       id,
+				Fee:      fee,
+				GasLimit: gasLimit,
+			},
+			Raw: types.Log{
+				Address: relayer.ZeroAddress,
+				Topics:  []common.Hash{relayer.ZeroHash},
+				Data:    []byte{0xff},
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	return queue.Message{Body: body, Internal: id}
+}
+
+func popIDs(t *testing.T, s *messageScheduler, n int) []uint64 {
+	ids := make([]uint64, 0, n)
+
+	for i := 0; i < n; i++ {
+		m, ok := s.pop(context.Background())
+		assert.True(t, ok)
+
+		ids = append(ids, m.Internal.(uint64))
+	}
+
+	return ids
+}
+
+func Test_feePerGas(t *testing.T) {
+	assert.Equal(t, float64(50), feePerGas(newTestFeeMessage(t, 1, 10000, 200).Body))
+	assert.Equal(t, float64(0), feePerGas(newTestFeeMessage(t, 1, 10000, 0).Body))
+	assert.Equal(t, float64(0), feePerGas([]byte("invalid")))
+}
+
+func Test_messageScheduler_HighestFeePerGasFirst(t *testing.T) {
+	s := newMessageScheduler(time.Minute)
+
+	s.push(newTestFeeMessage(t, 1, 100, 100))
+	s.push(newTestFeeMessage(t, 2, 1000, 100))
+	s.push(newTestFeeMessage(t, 3, 500, 100))
+	s.push(newTestFeeMessage(t, 4, 1000, 100))
+	s.push(newTestFeeMessage(t, 5, 1000, 0))
+
+	// equal fees are processed in the order they were received in.
+	assert.Equal(t, []uint64{2, 4, 3, 1, 5}, popIDs(t, s, 5))
+}
+
+func Test_messageScheduler_StarvationProtection(t *testing.T) {
+	s := newMessageScheduler(time.Minute)
+
+	now := time.Now()
+	s.now = func() time.Time { return now }
+
+	s.push(newTestFeeMessage(t, 1, 100, 100))
+	s.push(newTestFeeMessage(t, 2, 200, 100))
+
+	now = now.Add(2 * time.Minute)
+
+	s.push(newTestFeeMessage(t, 3, 1000, 100))
+	s.push(newTestFeeMessage(t, 4, 500, 100))
+
+	// the messages which waited too long go first, in the order they were received in.
+	assert.Equal(t, []uint64{1, 2, 3, 4}, popIDs(t, s, 4))
+}
+
+func Test_messageScheduler_PopAfterFeeOrderedPops(t *testing.T) {
+	s := newMessageScheduler(time.Minute)
+
+	now := time.Now()
+	s.now = func() time.Time { return now }
+
+	s.push(newTestFeeMessage(t, 1, 1000, 100))
+	s.push(newTestFeeMessage(t, 2, 100, 100))
+	s.push(newTestFeeMessage(t, 3, 500, 100))
+
+	assert.Equal(t, []uint64{1}, popIDs(t, s, 1))
+
+	now = now.Add(2 * time.Minute)
+
+	assert.Equal(t, []uint64{2, 3}, popIDs(t, s, 2))
+}
+
+func Test_messageScheduler_PopWaitsForMessages(t *testing.T) {
+	s := newMessageScheduler(time.Minute)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		s.push(newTestFeeMessage(t, 1, 100, 100))
+	}()
+
+	assert.Equal(t, []uint64{1}, popIDs(t, s, 1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, ok := s.pop(ctx)
+	assert.False(t, ok)
+}
+
+func Test_scheduleWhenReady(t *testing.T) {
+	p := newTestProcessor(true)
+	p.scheduler = newMessageScheduler(0)
+
+	m := newTestFeeMessage(t, 1, 100, 10)
+
+	p.scheduleWhenReady(context.Background(), m)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	popped, ok := p.scheduler.pop(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1), popped.Internal.(uint64))
+}
+
+func Test_scheduleWhenReady_ContextDone(t *testing.T) {
+	p := newTestProcessor(true)
+	p.scheduler = newMessageScheduler(0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// messages are left to the queue to redeliver once the processor is stopping.
+	p.scheduleWhenReady(ctx, newTestFeeMessage(t, 1, 100, 10))
+
+	popCtx, popCancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer popCancel()
+
+	_, ok := p.scheduler.pop(popCtx)
+	assert.False(t, ok)
+}
@@ -30,6 +30,7 @@ var (
 	zeroAddress          = common.HexToAddress("0x0000000000000000000000000000000000000000")
 	errUnprocessable     = errors.New("message is unprocessable")
 	errAlreadyProcessing = errors.New("already processing txHash")
+	errMaxRetriesReached = errors.New("max retries reached")
 )
 
 // eventStatusFromMsgHash will check the event's msgHash/signal, and
@@ -101,7 +102,9 @@ func (p *Processor) processMessage(
 	if msgBody.TimesRetried >= p.maxMessageRetries {
 		slog.Warn("max retries reached", "timesRetried", msgBody.TimesRetried)
 
-		return false, msgBody.TimesRetried, nil
+		relayer.MessageSentEventsMaxRetriesReached.Inc()
+
+		return false, msgBody.TimesRetried, errMaxRetriesReached
 	}
 
 	if err := p.waitForConfirmations(ctx, msgBody.Event.Raw.TxHash, msgBody.Event.Raw.BlockNumber); err != nil {
@@ -117,7 +120,7 @@ func (p *Processor) processMessage(
 		ctx,
 		eventStatus,
 		msgBody.Event.Message.SrcOwner,
-		p.relayerAddr,
+		p.signers.addresses(),
 		uint64(msgBody.Event.Message.GasLimit),
 	) {
 		return false, msgBody.TimesRetried, nil
@@ -177,14 +180,37 @@ func (p *Processor) processMessage(
 		}
 	}
 
-	encodedSignalProof, err := p.generateEncodedSignalProof(ctx, msgBody.Event)
-	if err != nil {
+	// don't build the proofs of a message no signer can send for now.
+	if err := p.signers.checkFunded(messageSigner(msgBody.Event)); err != nil {
 		return false, msgBody.TimesRetried, err
 	}
 
-	_, err = p.sendProcessMessageCall(ctx, msgBody.Event, encodedSignalProof)
-	if err != nil {
-		return false, msgBody.TimesRetried, err
+	var batch batchResult
+
+	// messages only their owner can process can't be sent by the multicall contract,
+	// so they are never batched.
+	if p.batchCh != nil && msgBody.Event.Message.GasLimit != 0 {
+		batch, err = p.processInBatch(ctx, msgBody.Event)
+		if err != nil {
+			return false, msgBody.TimesRetried, err
+		}
+	}
+
+	// otherwise, or if it couldn't be processed in the batch, the message is
+	// processed on its own.
+	if !batch.processed {
+		encodedSignalProof := batch.encodedSignalProof
+		if encodedSignalProof == nil {
+			encodedSignalProof, err = p.generateEncodedSignalProof(ctx, msgBody.Event)
+			if err != nil {
+				return false, msgBody.TimesRetried, err
+			}
+		}
+
+		_, err = p.sendProcessMessageCall(ctx, msgBody.Event, encodedSignalProof)
+		if err != nil {
+			return false, msgBody.TimesRetried, err
+		}
 	}
 
 	messageStatus, err := p.destBridge.MessageStatus(&bind.CallOpts{
@@ -223,10 +249,39 @@ func (p *Processor) processMessage(
 // as well as any additional hops required.
 func (p *Processor) generateEncodedSignalProof(ctx context.Context,
 	event *bridge.BridgeMessageSent) ([]byte, error) {
-	var encodedSignalProof []byte
+	hops, err := p.signalProofHopParams(ctx, event)
+	if err != nil {
+		return nil, err
+	}
 
-	var err error
+	encodedSignalProof, err := p.prover.EncodedSignalProofWithHops(
+		ctx,
+		hops,
+	)
+
+	if err != nil {
+		slog.Error("error encoding hop proof",
+			"srcChainID", event.Message.SrcChainId,
+			"destChainID", event.Message.DestChainId,
+			"txHash", event.Raw.TxHash.Hex(),
+			"msgHash", common.Hash(event.MsgHash).Hex(),
+			"from", event.Message.From.Hex(),
+			"srcOwner", event.Message.SrcOwner.Hex(),
+			"destOwner", event.Message.DestOwner.Hex(),
+			"error", err,
+			"hopsLength", len(hops),
+		)
+
+		return nil, err
+	}
+
+	return encodedSignalProof, nil
+}
 
+// waitHeadersSynced waits for the headers the proof of a MessageSent event depends
+// on to be synced, and returns the block number the proof of each hop is generated at.
+func (p *Processor) waitHeadersSynced(ctx context.Context,
+	event *bridge.BridgeMessageSent) (uint64, error) {
 	var blockNum uint64 = event.Raw.BlockNumber
 
 	// wait for srcChain => destChain header to sync if no hops,
@@ -242,11 +297,11 @@ func (p *Processor) generateEncodedSignalProof(ctx context.Context,
 			event, err := p.waitHeaderSynced(ctx, hopEthClient, hop.chainID.Uint64(), blockNum)
 
 			if err != nil {
-				return nil, errors.Wrap(err, "p.waitHeaderSynced")
+				return 0, errors.Wrap(err, "p.waitHeaderSynced")
 			}
 
 			if err != nil {
-				return nil, errors.Wrap(err, "hop.headerSyncer.GetSyncedSnippet")
+				return 0, errors.Wrap(err, "hop.headerSyncer.GetSyncedSnippet")
 			}
 
 			blockNum = event.SyncedInBlockID
@@ -258,16 +313,28 @@ func (p *Processor) generateEncodedSignalProof(ctx context.Context,
 
 		event, err := p.waitHeaderSynced(ctx, hopEthClient, hopChainID.Uint64(), blockNum)
 		if err != nil {
-			return nil, err
+			return 0, err
 		}
 
 		blockNum = event.SyncedInBlockID
 	} else {
 		if _, err := p.waitHeaderSynced(ctx, p.srcEthClient, p.destChainId.Uint64(), event.Raw.BlockNumber); err != nil {
-			return nil, err
+			return 0, err
 		}
 	}
 
+	return blockNum, nil
+}
+
+// signalProofHopParams waits for the headers the proof of a MessageSent event
+// depends on to be synced, and returns the params of each hop to generate it.
+func (p *Processor) signalProofHopParams(ctx context.Context,
+	event *bridge.BridgeMessageSent) ([]proof.HopParams, error) {
+	blockNum, err := p.waitHeadersSynced(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+
 	hops := []proof.HopParams{}
 
 	key, err := p.srcSignalService.GetSignalSlot(&bind.CallOpts{},
@@ -356,37 +423,95 @@ func (p *Processor) generateEncodedSignalProof(ctx context.Context,
 		})
 	}
 
-	encodedSignalProof, err = p.prover.EncodedSignalProofWithHops(
-		ctx,
-		hops,
-	)
+	return hops, nil
+}
+
+// processMessageCall is a `bridge.processMessage` call ready to be sent.
+type processMessageCall struct {
+	data          []byte
+	gasLimit      uint64
+	estimatedCost uint64
+}
 
+// sendProcessMessageCall calls `bridge.processMessage` with latest nonce
+// after estimating gas, and checking profitability.
+func (p *Processor) sendProcessMessageCall(
+	ctx context.Context,
+	event *bridge.BridgeMessageSent,
+	proof []byte,
+) (*types.Receipt, error) {
+	call, err := p.prepareProcessMessageCall(ctx, event, proof)
 	if err != nil {
-		slog.Error("error encoding hop proof",
-			"srcChainID", event.Message.SrcChainId,
-			"destChainID", event.Message.DestChainId,
-			"txHash", event.Raw.TxHash.Hex(),
-			"msgHash", common.Hash(event.MsgHash).Hex(),
-			"from", event.Message.From.Hex(),
-			"srcOwner", event.Message.SrcOwner.Hex(),
-			"destOwner", event.Message.DestOwner.Hex(),
-			"error", err,
-			"hopsLength", len(hops),
+		return nil, err
+	}
+
+	candidate := txmgr.TxCandidate{
+		TxData:   call.data,
+		Blobs:    nil,
+		To:       &p.cfg.DestBridgeAddress,
+		GasLimit: call.gasLimit,
+	}
+
+	txSigner, err := p.signers.acquire(ctx, messageSigner(event))
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, err := txSigner.txmgr.Send(ctx, candidate)
+
+	p.signers.release(txSigner)
+
+	if err != nil {
+		slog.Warn("Failed to send ProcessMessage transaction", "error", err.Error())
+		return nil, err
+	}
+
+	slog.Info("Mined tx",
+		"txHash", hex.EncodeToString(receipt.TxHash.Bytes()),
+		"srcTxHash", event.Raw.TxHash.Hex(),
+	)
+
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		relayer.MessageSentEventsProcessedReverted.Inc()
+		slog.Warn("Transaction reverted", "txHash", hex.EncodeToString(receipt.TxHash.Bytes()),
+			"srcTxHash", event.Raw.TxHash.Hex(),
+			"status", receipt.Status)
+
+		return nil, errTxReverted
+	}
+
+	relayer.MessageSentEventsProcessed.Inc()
+
+	if p.profitableOnly {
+		cost := receipt.GasUsed * receipt.EffectiveGasPrice.Uint64()
+
+		slog.Info("tx cost", "txHash", hex.EncodeToString(receipt.TxHash.Bytes()),
+			"srcTxHash", event.Raw.TxHash.Hex(),
+			"actualCost", cost,
+			"estimatedCost", call.estimatedCost,
 		)
 
+		if cost > call.estimatedCost {
+			relayer.UnprofitableMessageAfterTransacting.Inc()
+		} else {
+			relayer.ProfitableMessageAfterTransacting.Inc()
+		}
+	}
+
+	if err := p.saveMessageStatusChangedEvent(ctx, receipt, event); err != nil {
 		return nil, err
 	}
 
-	return encodedSignalProof, nil
+	return receipt, nil
 }
 
-// sendProcessMessageCall calls `bridge.processMessage` with latest nonce
-// after estimating gas, and checking profitability.
-func (p *Processor) sendProcessMessageCall(
+// prepareProcessMessageCall checks that a message can be processed, and is
+// profitable if required, and returns the `bridge.processMessage` call to send.
+func (p *Processor) prepareProcessMessageCall(
 	ctx context.Context,
 	event *bridge.BridgeMessageSent,
 	proof []byte,
-) (*types.Receipt, error) {
+) (*processMessageCall, error) {
 	received, err := p.destBridge.IsMessageReceived(nil, event.Message, proof)
 	if err != nil {
 		return nil, err
@@ -485,7 +610,7 @@ func (p *Processor) sendProcessMessageCall(
 		ctx,
 		eventStatus,
 		event.Message.SrcOwner,
-		p.relayerAddr,
+		p.signers.addresses(),
 		uint64(event.Message.GasLimit),
 	) {
 		slog.Error("can not process message after waiting for confirmations", "err", errUnprocessable)
@@ -493,56 +618,11 @@ func (p *Processor) sendProcessMessageCall(
 		return nil, errUnprocessable
 	}
 
-	candidate := txmgr.TxCandidate{
-		TxData:   data,
-		Blobs:    nil,
-		To:       &p.cfg.DestBridgeAddress,
-		GasLimit: gasLimit,
-	}
-
-	receipt, err := p.txmgr.Send(ctx, candidate)
-	if err != nil {
-		slog.Warn("Failed to send ProcessMessage transaction", "error", err.Error())
-		return nil, err
-	}
-
-	slog.Info("Mined tx",
-		"txHash", hex.EncodeToString(receipt.TxHash.Bytes()),
-		"srcTxHash", event.Raw.TxHash.Hex(),
-	)
-
-	if receipt.Status != types.ReceiptStatusSuccessful {
-		relayer.MessageSentEventsProcessedReverted.Inc()
-		slog.Warn("Transaction reverted", "txHash", hex.EncodeToString(receipt.TxHash.Bytes()),
-			"srcTxHash", event.Raw.TxHash.Hex(),
-			"status", receipt.Status)
-
-		return nil, errTxReverted
-	}
-
-	relayer.MessageSentEventsProcessed.Inc()
-
-	if p.profitableOnly {
-		cost := receipt.GasUsed * receipt.EffectiveGasPrice.Uint64()
-
-		slog.Info("tx cost", "txHash", hex.EncodeToString(receipt.TxHash.Bytes()),
-			"srcTxHash", event.Raw.TxHash.Hex(),
-			"actualCost", cost,
-			"estimatedCost", estimatedCost,
-		)
-
-		if cost > estimatedCost {
-			relayer.UnprofitableMessageAfterTransacting.Inc()
-		} else {
-			relayer.ProfitableMessageAfterTransacting.Inc()
-		}
-	}
-
-	if err := p.saveMessageStatusChangedEvent(ctx, receipt, event); err != nil {
-		return nil, err
-	}
-
-	return receipt, nil
+	return &processMessageCall{
+		data:          data,
+		gasLimit:      gasLimit,
+		estimatedCost: estimatedCost,
+	}, nil
 }
 
 // saveMessageStatusChangedEvent writes the MessageStatusChanged event to the
@@ -559,7 +639,13 @@ func (p *Processor) saveMessageStatusChangedEvent(
 
 	m := make(map[string]interface{})
 
+	// a receipt can have the events of multiple messages when they were processed
+	// in a batch, so we look for the one of this message.
 	for _, log := range receipt.Logs {
+		if len(log.Topics) < 2 || log.Topics[1] != common.Hash(event.MsgHash) {
+			continue
+		}
+
 		topic := log.Topics[0]
 		if topic == bridgeAbi.Events["MessageStatusChanged"].ID {
 			err = bridgeAbi.UnpackIntoMap(m, "MessageStatusChanged", log.Data)
@@ -9,6 +9,7 @@ import (
 	"log/slog"
 	"math/big"
 	"os"
+	"strconv"
 	"sync"
 	"time"
 
@@ -58,6 +59,7 @@ type ethClient interface {
 	ChainID(ctx context.Context) (*big.Int, error)
 	SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error)
 	EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error)
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
 }
 
 // hop is a struct which needs to be created based on the config parameters
@@ -80,6 +82,8 @@ type Processor struct {
 
 	eventRepo relayer.EventRepository
 
+	deadLetterRepo relayer.DeadLetterRepository
+
 	queue queue.Queue
 
 	hops []hop
@@ -129,10 +133,28 @@ type Processor struct {
 
 	cfg *Config
 
-	txmgr txmgr.TxManager
+	// signers are the keys messages are processed with, the first one being
+	// ecdsaKey, each with its own transaction manager.
+	signers                    *signerPool
+	signerMinBalance           *big.Int
+	signerBalanceCheckInterval time.Duration
 
 	maxMessageRetries uint64
 
+	deadLetterRequeueInterval time.Duration
+
+	// priorityWorkers is how many messages are processed at once by the highest
+	// fee per gas first, or zero to process them as they are delivered.
+	priorityWorkers uint64
+	scheduler       *messageScheduler
+
+	// batchCh is set when messages are processed in batches through the
+	// multicall contract.
+	batchCh          chan *batchRequest
+	batchMaxSize     uint64
+	batchWindow      time.Duration
+	multicallAddress common.Address
+
 	processingTxHashes map[common.Hash]bool
 	processingTxHashMu *sync.Mutex
 }
@@ -161,6 +183,11 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 		return err
 	}
 
+	deadLetterRepository, err := repo.NewDeadLetterRepository(db)
+	if err != nil {
+		return err
+	}
+
 	srcRpcClient, err := rpc.Dial(cfg.SrcRPCUrl)
 	if err != nil {
 		return err
@@ -288,7 +315,15 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 		return err
 	}
 
-	prover, err := proof.New(srcEthClient, p.cfg.CacheOption)
+	var proofCache *proof.Cache
+	if cfg.ProofCacheSize > 0 {
+		proofCache, err = proof.NewCache(int(cfg.ProofCacheSize))
+		if err != nil {
+			return err
+		}
+	}
+
+	prover, err := proof.New(srcEthClient, p.cfg.CacheOption, proofCache)
 	if err != nil {
 		return err
 	}
@@ -320,18 +355,41 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 		}
 	}
 
-	if p.txmgr, err = txmgr.NewSimpleTxManager(
+	primaryTxmgr, err := txmgr.NewSimpleTxManager(
 		"processor",
 		log.Root(),
 		new(txmgrMetrics.NoopTxMetrics),
 		*cfg.TxmgrConfigs,
-	); err != nil {
+	)
+	if err != nil {
 		return err
 	}
 
+	signers := []*signer{newSigner(cfg.ProcessorPrivateKey, primaryTxmgr)}
+
+	// every additional signer gets its own transaction manager, with the same
+	// configs as the primary one, so it can send with its own nonces.
+	for i, key := range cfg.SignerPrivateKeys {
+		txmgrConfigs := *cfg.TxmgrConfigs
+		txmgrConfigs.PrivateKey = common.Bytes2Hex(crypto.FromECDSA(key))
+
+		signerTxmgr, err := txmgr.NewSimpleTxManager(
+			fmt.Sprintf("processor-signer-%v", i+1),
+			log.Root(),
+			new(txmgrMetrics.NoopTxMetrics),
+			txmgrConfigs,
+		)
+		if err != nil {
+			return err
+		}
+
+		signers = append(signers, newSigner(key, signerTxmgr))
+	}
+
 	p.hops = hops
 	p.prover = prover
 	p.eventRepo = eventRepository
+	p.deadLetterRepo = deadLetterRepository
 
 	p.srcEthClient = srcEthClient
 	p.destEthClient = destEthClient
@@ -346,6 +404,10 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 	p.ecdsaKey = cfg.ProcessorPrivateKey
 	p.relayerAddr = relayerAddr
 
+	p.signers = newSignerPool(signers, cfg.SignerMinBalance)
+	p.signerMinBalance = cfg.SignerMinBalance
+	p.signerBalanceCheckInterval = time.Duration(cfg.SignerBalanceCheckInterval) * time.Second
+
 	p.profitableOnly = cfg.ProfitableOnly
 
 	p.queue = q
@@ -371,6 +433,19 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 	p.targetTxHash = cfg.TargetTxHash
 
 	p.maxMessageRetries = cfg.MaxMessageRetries
+	p.deadLetterRequeueInterval = time.Duration(cfg.DeadLetterRequeueInterval) * time.Second
+
+	if cfg.BatchMulticallAddress != relayer.ZeroAddress {
+		p.batchCh = make(chan *batchRequest)
+		p.batchMaxSize = cfg.BatchMaxSize
+		p.batchWindow = time.Duration(cfg.BatchWindow) * time.Second
+		p.multicallAddress = cfg.BatchMulticallAddress
+	}
+
+	p.priorityWorkers = cfg.PriorityWorkers
+	if p.priorityWorkers > 0 {
+		p.scheduler = newMessageScheduler(time.Duration(cfg.PriorityMaxWait) * time.Second)
+	}
 
 	p.processingTxHashes = make(map[common.Hash]bool, 0)
 	p.processingTxHashMu = &sync.Mutex{}
@@ -430,6 +505,29 @@ func (p *Processor) Start() error {
 
 	go p.eventLoop(ctx)
 
+	if p.batchCh != nil {
+		p.wg.Add(1)
+
+		go p.batchLoop(ctx)
+	}
+
+	for i := uint64(0); i < p.priorityWorkers; i++ {
+		p.wg.Add(1)
+
+		go p.priorityWorker(ctx)
+	}
+
+	p.wg.Add(1)
+
+	go p.requeueDeadLettersLoop(ctx)
+
+	// the signer balances are only monitored if a minimum balance is set.
+	if p.signerMinBalance != nil && p.signerMinBalance.Sign() > 0 {
+		p.wg.Add(1)
+
+		go p.checkSignerBalancesLoop(ctx)
+	}
+
 	go func() {
 		if err := backoff.Retry(func() error {
 			return utils.ScanBlocks(ctx, p.srcEthClient, p.wg)
@@ -457,71 +555,139 @@ func (p *Processor) eventLoop(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case msg := <-p.msgCh:
-			go func(m queue.Message) {
-				shouldRequeue, timesRetried, err := p.processMessage(ctx, m)
-
-				if err != nil {
-					switch {
-					case errors.Is(err, errUnprocessable):
-						if err := p.queue.Ack(ctx, m); err != nil {
-							slog.Error("Err acking message", "err", err.Error())
-						}
-					case errors.Is(err, relayer.ErrUnprofitable):
-						slog.Info("publishing to unprofitable queue")
-
-						headers := make(map[string]interface{}, 0)
-
-						headers["retries"] = int64(timesRetried + 1)
-
-						if err := p.queue.Publish(
-							ctx,
-							fmt.Sprintf("%v-unprofitable", p.queueName()),
-							m.Body,
-							headers,
-							p.cfg.UnprofitableMessageQueueExpiration,
-						); err != nil {
-							slog.Error("error publishing to unprofitable queue", "error", err)
-						}
-
-						// after publishing successfully, we can acknowledge this message to remove it
-						// from our main queue.
-						if err := p.queue.Ack(ctx, m); err != nil {
-							slog.Error("Err acking message", "err", err.Error())
-						}
-					case errors.Is(err, context.Canceled):
-						slog.Error("process message failed due to context cancel", "err", err.Error())
-
-						// we want to negatively acknowledge the message and make sure
-						// we requeue it
-						if err := p.queue.Nack(ctx, m, true); err != nil {
-							slog.Error("Err nacking message", "err", err.Error())
-						}
-					default:
-						slog.Error("process message failed", "err", err.Error())
-
-						// we want to negatively acknowledge the message and requeue it if we
-						// encountered an error, but the message is processable.
-						if err := p.queue.Nack(ctx, m, shouldRequeue); err != nil {
-							slog.Error("Err nacking message", "err", err.Error())
-						}
-					}
-
-					return
-				}
+			// with priority workers, messages are handed out by the scheduler instead,
+			// once they are ready to be processed.
+			if p.scheduler != nil {
+				go p.scheduleWhenReady(ctx, msg)
+
+				continue
+			}
+
+			go p.handleMessage(ctx, msg)
+		}
+	}
+}
+
+// priorityWorker processes the messages handed out by the scheduler, one at a time,
+// until the context is done.
+func (p *Processor) priorityWorker(ctx context.Context) {
+	defer p.wg.Done()
+
+	for {
+		m, ok := p.scheduler.pop(ctx)
+		if !ok {
+			return
+		}
+
+		p.handleMessage(ctx, m)
+	}
+}
+
+// handleMessage processes a message, and then acknowledges it, requeues it or
+// dead-letters it depending on the result.
+func (p *Processor) handleMessage(ctx context.Context, m queue.Message) {
+	shouldRequeue, timesRetried, err := p.processMessage(ctx, m)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, errUnprocessable):
+			if err := p.queue.Ack(ctx, m); err != nil {
+				slog.Error("Err acking message", "err", err.Error())
+			}
+		case errors.Is(err, relayer.ErrUnprofitable):
+			slog.Info("publishing to unprofitable queue")
 
-				if shouldRequeue {
-					// we want to negatively acknowledge the message and make sure
-					// we requeue it
-					if err := p.queue.Nack(ctx, m, true); err != nil {
-						slog.Error("Err nacking message", "err", err.Error())
-					}
-				} else {
-					// otherwise if no error, we can acknowledge it successfully.
-					if err := p.queue.Ack(ctx, m); err != nil {
-						slog.Error("Err acking message", "err", err.Error())
-					}
+			headers := make(map[string]interface{}, 0)
+
+			headers["retries"] = int64(timesRetried + 1)
+
+			body, err := retriedMessageBody(m.Body, err)
+			if err != nil {
+				slog.Error("error updating retried message", "error", err)
+
+				body = m.Body
+			}
+
+			if err := p.queue.Publish(
+				ctx,
+				fmt.Sprintf("%v-unprofitable", p.queueName()),
+				body,
+				headers,
+				p.cfg.UnprofitableMessageQueueExpiration,
+			); err != nil {
+				slog.Error("error publishing to unprofitable queue", "error", err)
+			}
+
+			// after publishing successfully, we can acknowledge this message to remove it
+			// from our main queue.
+			if err := p.queue.Ack(ctx, m); err != nil {
+				slog.Error("Err acking message", "err", err.Error())
+			}
+		case errors.Is(err, errMaxRetriesReached):
+			p.deadLetter(ctx, m, err)
+		case errors.Is(err, context.Canceled):
+			slog.Error("process message failed due to context cancel", "err", err.Error())
+
+			// we want to negatively acknowledge the message and make sure
+			// we requeue it
+			if err := p.queue.Nack(ctx, m, true); err != nil {
+				slog.Error("Err nacking message", "err", err.Error())
+			}
+		case errors.Is(err, errNoSignerAvailable):
+			slog.Warn("no signer available to process message, delaying it", "err", err.Error())
+
+			// the message comes back from the unprofitable queue once the signer balances
+			// are checked again, without counting as a retry.
+			expiration := strconv.FormatInt(p.signerBalanceCheckInterval.Milliseconds(), 10)
+
+			if err := p.queue.Publish(
+				ctx,
+				fmt.Sprintf("%v-unprofitable", p.queueName()),
+				m.Body,
+				map[string]interface{}{"retries": int64(timesRetried)},
+				&expiration,
+			); err != nil {
+				slog.Error("error publishing to unprofitable queue", "error", err)
+
+				if err := p.queue.Nack(ctx, m, true); err != nil {
+					slog.Error("Err nacking message", "err", err.Error())
 				}
-			}(msg)
+
+				return
+			}
+
+			if err := p.queue.Ack(ctx, m); err != nil {
+				slog.Error("Err acking message", "err", err.Error())
+			}
+		case !shouldRequeue && !errors.Is(err, errAlreadyProcessing):
+			slog.Error("process message failed", "err", err.Error())
+
+			// the message would be dropped, so we keep it in the dead-letter store
+			// instead, where an operator can requeue it.
+			p.deadLetter(ctx, m, err)
+		default:
+			slog.Error("process message failed", "err", err.Error())
+
+			// we want to negatively acknowledge the message and requeue it if we
+			// encountered an error, but the message is processable.
+			if err := p.queue.Nack(ctx, m, shouldRequeue); err != nil {
+				slog.Error("Err nacking message", "err", err.Error())
+			}
+		}
+
+		return
+	}
+
+	if shouldRequeue {
+		// we want to negatively acknowledge the message and make sure
+		// we requeue it
+		if err := p.queue.Nack(ctx, m, true); err != nil {
+			slog.Error("Err nacking message", "err", err.Error())
+		}
+	} else {
+		// otherwise if no error, we can acknowledge it successfully.
+		if err := p.queue.Ack(ctx, m); err != nil {
+			slog.Error("Err acking message", "err", err.Error())
 		}
 	}
 }
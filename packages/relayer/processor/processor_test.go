@@ -1,6 +1,7 @@
 package processor
 
 import (
+	"math/big"
 	"sync"
 	"time"
 
@@ -19,10 +20,12 @@ func newTestProcessor(profitableOnly bool) *Processor {
 	prover, _ := proof.New(
 		&mock.Blocker{},
 		encoding.CACHE_NOTHING,
+		nil,
 	)
 
 	return &Processor{
 		eventRepo:                 &mock.EventRepository{},
+		deadLetterRepo:            mock.NewDeadLetterRepository(),
 		destBridge:                &mock.Bridge{},
 		srcEthClient:              &mock.EthClient{},
 		destEthClient:             &mock.EthClient{},
@@ -42,7 +45,10 @@ func newTestProcessor(profitableOnly bool) *Processor {
 		ethClientTimeout:          10 * time.Second,
 		srcChainId:                mock.MockChainID,
 		destChainId:               mock.MockChainID,
-		txmgr:                     &mock.TxManager{},
+		signers: newSignerPool(
+			[]*signer{newSigner(privateKey, &mock.TxManager{})},
+			big.NewInt(0),
+		),
 		cfg: &Config{
 			DestBridgeAddress: common.HexToAddress("0xC4279588B8dA563D264e286E2ee7CE8c244444d6"),
 		},
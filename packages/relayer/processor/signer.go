@@ -0,0 +1,246 @@
+package processor
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"log/slog"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/pkg/errors"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+)
+
+// errNoSignerAvailable is returned when all the signers a message can be sent with have
+// a low balance, so it is delayed instead of waiting for them to be topped up.
+var errNoSignerAvailable = errors.New("no signer with enough balance available")
+
+// signer is a relayer key the processor sends transactions with. Each signer has
+// its own transaction manager, and so its own nonce lane.
+type signer struct {
+	key        *ecdsa.PrivateKey
+	addr       common.Address
+	txmgr      txmgr.TxManager
+	busy       bool
+	lowBalance bool
+}
+
+func newSigner(key *ecdsa.PrivateKey, txmgr txmgr.TxManager) *signer {
+	return &signer{
+		key:   key,
+		addr:  crypto.PubkeyToAddress(key.PublicKey),
+		txmgr: txmgr,
+	}
+}
+
+// signerPool hands out the processor's signers, so each one sends a single
+// transaction at a time, and its nonces never conflict. A signer whose balance
+// is below the minimum balance isn't handed out until it is topped up.
+type signerPool struct {
+	mu         *sync.Mutex
+	signers    []*signer
+	minBalance *big.Int
+	// available is closed, and replaced, whenever a signer may have become available.
+	available chan struct{}
+}
+
+func newSignerPool(signers []*signer, minBalance *big.Int) *signerPool {
+	return &signerPool{
+		mu:         &sync.Mutex{},
+		signers:    signers,
+		minBalance: minBalance,
+		available:  make(chan struct{}),
+	}
+}
+
+// acquire blocks until a signer is free, and returns it, or an error if the context
+// is done first, or errNoSignerAvailable if all the signers it could return have a
+// low balance. If addr is set, only the signer with that address is returned.
+// The signer must be released once its transaction is done.
+func (sp *signerPool) acquire(ctx context.Context, addr *common.Address) (*signer, error) {
+	for {
+		sp.mu.Lock()
+
+		s, err := sp.next(addr)
+		available := sp.available
+
+		sp.mu.Unlock()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if s != nil {
+			return s, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-available:
+		}
+	}
+}
+
+// next marks the first free signer as busy and returns it, or nil if they are all busy,
+// or errNoSignerAvailable if they all have a low balance. It must be called with the
+// lock held.
+func (sp *signerPool) next(addr *common.Address) (*signer, error) {
+	funded := false
+
+	for _, s := range sp.signers {
+		if addr != nil && s.addr != *addr {
+			continue
+		}
+
+		if s.lowBalance {
+			continue
+		}
+
+		funded = true
+
+		if s.busy {
+			continue
+		}
+
+		s.busy = true
+
+		return s, nil
+	}
+
+	if !funded {
+		return nil, errNoSignerAvailable
+	}
+
+	return nil, nil
+}
+
+// checkFunded returns errNoSignerAvailable if all the signers acquire could return have
+// a low balance, without acquiring one. If addr is set, only the signer with that address
+// is checked.
+func (sp *signerPool) checkFunded(addr *common.Address) error {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	for _, s := range sp.signers {
+		if addr != nil && s.addr != *addr {
+			continue
+		}
+
+		if !s.lowBalance {
+			return nil
+		}
+	}
+
+	return errNoSignerAvailable
+}
+
+// addresses returns the addresses of all the signers.
+func (sp *signerPool) addresses() []common.Address {
+	addrs := make([]common.Address, 0, len(sp.signers))
+
+	for _, s := range sp.signers {
+		addrs = append(addrs, s.addr)
+	}
+
+	return addrs
+}
+
+// messageSigner returns the address of the only signer which can send the given message,
+// or nil if any signer can. A message only its owner can process has to be sent by the
+// owner's key.
+func messageSigner(event *bridge.BridgeMessageSent) *common.Address {
+	if event.Message.GasLimit == 0 {
+		return &event.Message.SrcOwner
+	}
+
+	return nil
+}
+
+// release makes a signer available again.
+func (sp *signerPool) release(s *signer) {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	s.busy = false
+
+	sp.notifyAvailable()
+}
+
+// notifyAvailable wakes up everyone waiting for a signer. It must be called with
+// the lock held.
+func (sp *signerPool) notifyAvailable() {
+	close(sp.available)
+
+	sp.available = make(chan struct{})
+}
+
+// checkBalances fetches the balance of each signer on the destination chain, and
+// stops handing out the ones below the minimum balance until they are topped up.
+func (sp *signerPool) checkBalances(ctx context.Context, client ethClient) error {
+	for _, s := range sp.signers {
+		balance, err := client.BalanceAt(ctx, s.addr, nil)
+		if err != nil {
+			return errors.Wrap(err, "client.BalanceAt")
+		}
+
+		balanceInEth, _ := new(big.Float).Quo(new(big.Float).SetInt(balance), big.NewFloat(params.Ether)).Float64()
+
+		relayer.ProcessorSignerBalance.WithLabelValues(s.addr.Hex()).Set(balanceInEth)
+
+		lowBalance := balance.Cmp(sp.minBalance) < 0
+
+		sp.mu.Lock()
+
+		changed := s.lowBalance != lowBalance
+		s.lowBalance = lowBalance
+
+		if changed && !lowBalance {
+			sp.notifyAvailable()
+		}
+
+		sp.mu.Unlock()
+
+		if !changed {
+			continue
+		}
+
+		if lowBalance {
+			slog.Warn("signer balance is low, not using it", "address", s.addr.Hex(), "balance", balance.String())
+		} else {
+			slog.Info("signer balance topped up, using it again", "address", s.addr.Hex(), "balance", balance.String())
+		}
+	}
+
+	return nil
+}
+
+// checkSignerBalancesLoop periodically checks the balances of the processor's
+// signers, until the context is done.
+func (p *Processor) checkSignerBalancesLoop(ctx context.Context) {
+	defer p.wg.Done()
+
+	if err := p.signers.checkBalances(ctx, p.destEthClient); err != nil {
+		slog.Error("error checking signer balances", "error", err)
+	}
+
+	ticker := time.NewTicker(p.signerBalanceCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.signers.checkBalances(ctx, p.destEthClient); err != nil {
+				slog.Error("error checking signer balances", "error", err)
+			}
+		}
+	}
+}
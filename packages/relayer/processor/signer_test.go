@@ -0,0 +1,135 @@
+package processor
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+func newTestSigners(t *testing.T, n int) []*signer {
+	signers := make([]*signer, 0, n)
+
+	for i := 0; i < n; i++ {
+		key, err := crypto.GenerateKey()
+		assert.Nil(t, err)
+
+		signers = append(signers, newSigner(key, &mock.TxManager{}))
+	}
+
+	return signers
+}
+
+func Test_signerPool_Acquire(t *testing.T) {
+	signers := newTestSigners(t, 2)
+	sp := newSignerPool(signers, big.NewInt(0))
+
+	s1, err := sp.acquire(context.Background(), nil)
+	assert.Nil(t, err)
+
+	s2, err := sp.acquire(context.Background(), nil)
+	assert.Nil(t, err)
+
+	assert.NotEqual(t, s1.addr, s2.addr)
+
+	// both signers are busy, so acquiring has to wait for one to be released.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = sp.acquire(ctx, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		sp.release(s2)
+	}()
+
+	s3, err := sp.acquire(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, s2.addr, s3.addr)
+}
+
+func Test_signerPool_AcquireByAddress(t *testing.T) {
+	signers := newTestSigners(t, 2)
+	sp := newSignerPool(signers, big.NewInt(0))
+
+	s, err := sp.acquire(context.Background(), &signers[1].addr)
+	assert.Nil(t, err)
+	assert.Equal(t, signers[1].addr, s.addr)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = sp.acquire(ctx, &signers[1].addr)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func Test_signerPool_CheckBalances(t *testing.T) {
+	signers := newTestSigners(t, 1)
+
+	// the mock balance is below the minimum balance.
+	sp := newSignerPool(signers, new(big.Int).Add(mock.Balance, big.NewInt(1)))
+
+	assert.Nil(t, sp.checkBalances(context.Background(), &mock.EthClient{}))
+	assert.True(t, signers[0].lowBalance)
+
+	// acquiring doesn't wait for a signer to be topped up.
+	_, err := sp.acquire(context.Background(), nil)
+	assert.ErrorIs(t, err, errNoSignerAvailable)
+
+	// once topped up, the signer is used again.
+	sp.minBalance = mock.Balance
+
+	assert.Nil(t, sp.checkBalances(context.Background(), &mock.EthClient{}))
+	assert.False(t, signers[0].lowBalance)
+
+	s, err := sp.acquire(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, signers[0].addr, s.addr)
+}
+
+func Test_signerPool_AcquireLowBalance(t *testing.T) {
+	signers := newTestSigners(t, 2)
+	sp := newSignerPool(signers, big.NewInt(0))
+
+	signers[0].lowBalance = true
+
+	// other signers are still handed out, but not the one with a low balance.
+	s, err := sp.acquire(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, signers[1].addr, s.addr)
+
+	_, err = sp.acquire(context.Background(), &signers[0].addr)
+	assert.ErrorIs(t, err, errNoSignerAvailable)
+
+	// a busy signer with enough balance is waited for.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = sp.acquire(ctx, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func Test_signerPool_CheckFunded(t *testing.T) {
+	signers := newTestSigners(t, 2)
+	sp := newSignerPool(signers, big.NewInt(0))
+
+	signers[0].lowBalance = true
+
+	// busy signers with enough balance still count as funded.
+	s, err := sp.acquire(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, signers[1].addr, s.addr)
+
+	assert.Nil(t, sp.checkFunded(nil))
+	assert.Nil(t, sp.checkFunded(&signers[1].addr))
+	assert.ErrorIs(t, sp.checkFunded(&signers[0].addr), errNoSignerAvailable)
+
+	signers[1].lowBalance = true
+
+	assert.ErrorIs(t, sp.checkFunded(nil), errNoSignerAvailable)
+}
@@ -70,6 +70,10 @@ var (
 		Name: "message_sent_events_max_retries_reached_ops_total",
 		Help: "The total number of MessageSent events that reached max retries",
 	})
+	DeadLetteredMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "dead_lettered_messages_ops_total",
+		Help: "The total number of messages moved to the dead-letter store",
+	})
 	MessageProcessedEventsIndexingErrors = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "message_processed_events_indexing_errors_ops_total",
 		Help: "The total number of errors indexing MessageProcessed events",
@@ -130,4 +134,20 @@ var (
 		Name: "unprofitable_message_after_transacting_ops_total",
 		Help: "The total number of processed events that ended up unprofitable",
 	})
+	MessagesProcessedInBatch = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "messages_processed_in_batch_ops_total",
+		Help: "The total number of messages processed in a batch transaction",
+	})
+	ProofCacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "proof_cache_hits_ops_total",
+		Help: "The total number of proof cache hits, by cache",
+	}, []string{"cache"})
+	ProofCacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "proof_cache_misses_ops_total",
+		Help: "The total number of proof cache misses, by cache",
+	}, []string{"cache"})
+	ProcessorSignerBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "processor_signer_balance_eth",
+		Help: "The balance in ETH of each processor signer on the destination chain",
+	}, []string{"address"})
 )
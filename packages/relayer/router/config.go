@@ -0,0 +1,336 @@
+package router
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/indexer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/processor"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/watchdog"
+	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v3"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// Component types which can be run by a route.
+const (
+	ComponentIndexer   = "indexer"
+	ComponentProcessor = "processor"
+	ComponentWatchdog  = "watchdog"
+)
+
+// componentFlags are the flags each component type can be configured with.
+var componentFlags = map[string][]cli.Flag{
+	ComponentIndexer:   flags.IndexerFlags,
+	ComponentProcessor: flags.ProcessorFlags,
+	ComponentWatchdog:  flags.WatchdogFlags,
+}
+
+// unsupportedFlags can't be set in a route, since the processor exits the process
+// once the target transaction is processed.
+var unsupportedFlags = map[string]bool{
+	flags.TargetTxHash.Name: true,
+}
+
+// FlagValue is the value of a flag in the route configuration file, either a scalar,
+// or a sequence for flags which accept multiple values.
+type FlagValue []string
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (v *FlagValue) UnmarshalYAML(node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		*v = FlagValue{node.Value}
+	case yaml.SequenceNode:
+		var values []string
+		if err := node.Decode(&values); err != nil {
+			return err
+		}
+
+		*v = values
+	default:
+		return fmt.Errorf("line %d: flag value must be a scalar or a sequence", node.Line)
+	}
+
+	return nil
+}
+
+// RouteFile is the route configuration file, listing the routes a router runs.
+type RouteFile struct {
+	Routes []RouteSpec `yaml:"routes"`
+}
+
+// RouteSpec is a single src->dest chain pair in the route configuration file. Its flags
+// are applied to all its components which support them.
+type RouteSpec struct {
+	Name       string               `yaml:"name"`
+	Flags      map[string]FlagValue `yaml:"flags"`
+	Components []ComponentSpec      `yaml:"components"`
+}
+
+// ComponentSpec is a component of a route, with the flags only applied to itself,
+// which take precedence over the route flags.
+type ComponentSpec struct {
+	Type  string               `yaml:"type"`
+	Flags map[string]FlagValue `yaml:"flags"`
+}
+
+// LoadRouteFile reads and validates the route configuration file at the given path.
+func LoadRouteFile(path string) (*RouteFile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	decoder := yaml.NewDecoder(f)
+	decoder.KnownFields(true)
+
+	routeFile := &RouteFile{}
+	if err := decoder.Decode(routeFile); err != nil {
+		return nil, fmt.Errorf("invalid route file %v: %w", path, err)
+	}
+
+	if err := routeFile.validate(); err != nil {
+		return nil, fmt.Errorf("invalid route file %v: %w", path, err)
+	}
+
+	return routeFile, nil
+}
+
+func (f *RouteFile) validate() error {
+	if len(f.Routes) == 0 {
+		return fmt.Errorf("no routes")
+	}
+
+	names := make(map[string]bool)
+
+	for _, route := range f.Routes {
+		if route.Name == "" {
+			return fmt.Errorf("route without name")
+		}
+
+		if names[route.Name] {
+			return fmt.Errorf("duplicate route %v", route.Name)
+		}
+
+		names[route.Name] = true
+
+		if len(route.Components) == 0 {
+			return fmt.Errorf("route %v: no components", route.Name)
+		}
+
+		for _, component := range route.Components {
+			if _, ok := componentFlags[component.Type]; !ok {
+				return fmt.Errorf("route %v: invalid component type %v", route.Name, component.Type)
+			}
+
+			for name := range component.Flags {
+				if !isRouteFlag(component.Type, name) {
+					return fmt.Errorf("route %v: flag %v can not be set for %v", route.Name, name, component.Type)
+				}
+			}
+		}
+
+		for name := range route.Flags {
+			known := false
+
+			for _, component := range route.Components {
+				known = known || isRouteFlag(component.Type, name)
+			}
+
+			if !known {
+				return fmt.Errorf("route %v: flag %v can not be set for any of its components", route.Name, name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// isSharedFlag returns whether the flag is shared by all routes, and so is configured
+// for the router instead of in the route configuration file.
+func isSharedFlag(name string) bool {
+	for _, f := range flags.RouterFlags {
+		for _, n := range f.Names() {
+			if n == name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isRouteFlag returns whether the flag can be set for the given component type in the
+// route configuration file.
+func isRouteFlag(componentType string, name string) bool {
+	if isSharedFlag(name) || unsupportedFlags[name] {
+		return false
+	}
+
+	for _, f := range componentFlags[componentType] {
+		for _, n := range f.Names() {
+			if n == name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ComponentConfig is the config of a single component of a route.
+type ComponentConfig struct {
+	Route     string
+	Type      string
+	Indexer   *indexer.Config
+	Processor *processor.Config
+	Watchdog  *watchdog.Config
+}
+
+// Name returns the name of the component, used in logs.
+func (c *ComponentConfig) Name() string {
+	return fmt.Sprintf("%v/%v", c.Route, c.Type)
+}
+
+// Config is a struct which should be created from the cli or environment variables, populated,
+// and used to create a new Router.
+type Config struct {
+	Components    []*ComponentConfig
+	RetryInterval time.Duration
+	OpenDBFunc    func() (DB, error)
+}
+
+// NewConfigFromCliContext creates a new config instance from command line flags, and
+// the route configuration file they point to.
+func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
+	routeFile, err := LoadRouteFile(c.String(flags.RoutesFile.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &Config{
+		RetryInterval: time.Duration(c.Uint64(flags.RouteRetryInterval.Name)) * time.Second,
+		OpenDBFunc: func() (DB, error) {
+			return db.OpenDBConnection(db.DBConnectionOpts{
+				Name:            c.String(flags.DatabaseUsername.Name),
+				Password:        c.String(flags.DatabasePassword.Name),
+				Database:        c.String(flags.DatabaseName.Name),
+				Host:            c.String(flags.DatabaseHost.Name),
+				MaxIdleConns:    c.Uint64(flags.DatabaseMaxIdleConns.Name),
+				MaxOpenConns:    c.Uint64(flags.DatabaseMaxOpenConns.Name),
+				MaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
+				OpenFunc: func(dsn string) (*db.DB, error) {
+					gormDB, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+						Logger: logger.Default.LogMode(logger.Silent),
+					})
+					if err != nil {
+						return nil, err
+					}
+
+					return db.New(gormDB), nil
+				},
+			})
+		},
+	}
+
+	for _, route := range routeFile.Routes {
+		for _, component := range route.Components {
+			componentCfg, err := newComponentConfig(c, route, component)
+			if err != nil {
+				return nil, err
+			}
+
+			cfg.Components = append(cfg.Components, componentCfg)
+		}
+	}
+
+	return cfg, nil
+}
+
+// newComponentConfig creates the config of a route component, from a child context of the
+// router command, so the shared flags are read from the router command line.
+func newComponentConfig(parent *cli.Context, route RouteSpec, component ComponentSpec) (*ComponentConfig, error) {
+	componentCfg := &ComponentConfig{Route: route.Name, Type: component.Type}
+
+	c, err := newComponentContext(parent, route, component)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", componentCfg.Name(), err)
+	}
+
+	switch component.Type {
+	case ComponentIndexer:
+		componentCfg.Indexer, err = indexer.NewConfigFromCliContext(c)
+	case ComponentProcessor:
+		componentCfg.Processor, err = processor.NewConfigFromCliContext(c)
+	case ComponentWatchdog:
+		componentCfg.Watchdog, err = watchdog.NewConfigFromCliContext(c)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", componentCfg.Name(), err)
+	}
+
+	return componentCfg, nil
+}
+
+// newComponentContext creates a cli context with the flags of the component type which are not
+// shared by the router, set to the values of the route and the component in the route
+// configuration file. Flags not set there keep their defaults and environment variables.
+func newComponentContext(parent *cli.Context, route RouteSpec, component ComponentSpec) (*cli.Context, error) {
+	set := flag.NewFlagSet(fmt.Sprintf("%v/%v", route.Name, component.Type), flag.ContinueOnError)
+
+	var componentFlagList []cli.Flag
+
+	for _, f := range componentFlags[component.Type] {
+		if isSharedFlag(f.Names()[0]) {
+			continue
+		}
+
+		if err := f.Apply(set); err != nil {
+			return nil, err
+		}
+
+		componentFlagList = append(componentFlagList, f)
+	}
+
+	values := make(map[string]FlagValue)
+
+	for name, value := range route.Flags {
+		if isRouteFlag(component.Type, name) {
+			values[name] = value
+		}
+	}
+
+	for name, value := range component.Flags {
+		values[name] = value
+	}
+
+	for name, value := range values {
+		for _, v := range value {
+			if err := set.Set(name, v); err != nil {
+				return nil, fmt.Errorf("invalid value for flag %v: %w", name, err)
+			}
+		}
+	}
+
+	c := cli.NewContext(parent.App, set, parent)
+	// the command is only used to look the flags up, to tell whether they are set
+	// by environment variables.
+	c.Command = &cli.Command{Name: component.Type, Flags: componentFlagList}
+
+	for _, f := range componentFlagList {
+		if required, ok := f.(cli.RequiredFlag); ok && required.IsRequired() && !c.IsSet(f.Names()[0]) {
+			return nil, fmt.Errorf("required flag %v not set", f.Names()[0])
+		}
+	}
+
+	return c, nil
+}
@@ -0,0 +1,193 @@
+package router
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+	"github.com/urfave/cli/v2"
+)
+
+var testRouteFile = `
+routes:
+  - name: l1-to-l2
+    flags:
+      srcRpcUrl: l1RpcUrl
+      destRpcUrl: l2RpcUrl
+      srcBridgeAddress: "0x73FaC9201494f0bd17B9892B9fae4d52fe3BD377"
+      destBridgeAddress: "0x63FaC9201494f0bd17B9892B9fae4d52fe3BD377"
+      destTaikoAddress: "0x53FaC9201494f0bd17B9892B9fae4d52fe3BD377"
+      destERC20VaultAddress: "0x43FaC9201494f0bd17B9892B9fae4d52fe3BD377"
+      destERC721Address: "0x33FaC9201494f0bd17B9892B9fae4d52fe3BD377"
+      destERC1155Address: "0x23FaC9201494f0bd17B9892B9fae4d52fe3BD377"
+      processorPrivateKey: 8da4ef21b864d2cc526dbdb2a120bd2874c36c9d0a1fb7f8c63d7f7a8b41de8f
+    components:
+      - type: indexer
+        flags:
+          event: MessageSent
+      - type: indexer
+        flags:
+          event: MessageProcessed
+          blockBatchSize: 10
+      - type: processor
+        flags:
+          hopRpcUrls: [hop1RpcUrl, hop2RpcUrl]
+          hopSignalServiceAddresses: ["0x1", "0x2"]
+          hopTaikoAddresses: ["0x3", "0x4"]
+  - name: l2-to-l1
+    flags:
+      srcRpcUrl: l2RpcUrl
+      destRpcUrl: l1RpcUrl
+      srcBridgeAddress: "0x63FaC9201494f0bd17B9892B9fae4d52fe3BD377"
+      destBridgeAddress: "0x73FaC9201494f0bd17B9892B9fae4d52fe3BD377"
+    components:
+      - type: watchdog
+        flags:
+          watchdogPrivateKey: 8da4ef21b864d2cc526dbdb2a120bd2874c36c9d0a1fb7f8c63d7f7a8b41de8f
+`
+
+func writeRouteFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "routes.yaml")
+	assert.Nil(t, os.WriteFile(path, []byte(content), 0600))
+
+	return path
+}
+
+func runApp(routeFile string, action cli.ActionFunc) error {
+	app := cli.NewApp()
+	app.Flags = flags.RouterFlags
+	app.Action = action
+
+	return app.Run([]string{
+		"TestNewConfigFromCliContext",
+		"--" + flags.DatabaseUsername.Name, "dbuser",
+		"--" + flags.DatabasePassword.Name, "dbpass",
+		"--" + flags.DatabaseHost.Name, "dbhost",
+		"--" + flags.DatabaseName.Name, "dbname",
+		"--" + flags.QueueType.Name, queue.TypeFile,
+		"--" + flags.QueueDir.Name, "queuedir",
+		"--" + flags.RoutesFile.Name, routeFile,
+		"--" + flags.RouteRetryInterval.Name, "5",
+	})
+}
+
+func TestNewConfigFromCliContext(t *testing.T) {
+	assert.Nil(t, runApp(writeRouteFile(t, testRouteFile), func(ctx *cli.Context) error {
+		c, err := NewConfigFromCliContext(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, 5*time.Second, c.RetryInterval)
+		assert.Len(t, c.Components, 4)
+
+		// route flags apply to all components, and component flags take precedence.
+		sent := c.Components[0]
+		assert.Equal(t, "l1-to-l2/indexer", sent.Name())
+		assert.Equal(t, "l1RpcUrl", sent.Indexer.SrcRPCUrl)
+		assert.Equal(t, "l2RpcUrl", sent.Indexer.DestRPCUrl)
+		assert.Equal(t, common.HexToAddress("0x73FaC9201494f0bd17B9892B9fae4d52fe3BD377"), sent.Indexer.SrcBridgeAddress)
+		assert.Equal(t, relayer.EventNameMessageSent, sent.Indexer.EventName)
+		assert.Equal(t, flags.BlockBatchSize.Value, sent.Indexer.BlockBatchSize)
+
+		processed := c.Components[1]
+		assert.Equal(t, relayer.EventNameMessageProcessed, processed.Indexer.EventName)
+		assert.Equal(t, uint64(10), processed.Indexer.BlockBatchSize)
+
+		// shared flags are read from the router command line.
+		assert.Equal(t, "dbuser", processed.Indexer.DatabaseUsername)
+		assert.Equal(t, queue.TypeFile, processed.Indexer.QueueType)
+		assert.Equal(t, "queuedir", processed.Indexer.QueueDir)
+
+		processor := c.Components[2]
+		assert.Equal(t, ComponentProcessor, processor.Type)
+		assert.Equal(t, common.HexToAddress("0x53FaC9201494f0bd17B9892B9fae4d52fe3BD377"), processor.Processor.DestTaikoAddress)
+		assert.Equal(t, "l1RpcUrl", processor.Processor.SrcRPCUrl)
+		assert.NotNil(t, processor.Processor.ProcessorPrivateKey)
+
+		watchdog := c.Components[3]
+		assert.Equal(t, "l2-to-l1/watchdog", watchdog.Name())
+		assert.Equal(t, "l2RpcUrl", watchdog.Watchdog.SrcRPCUrl)
+		assert.Equal(t, "dbuser", watchdog.Watchdog.DatabaseUsername)
+
+		return err
+	}))
+}
+
+func TestNewConfigFromCliContext_RequiredFlag(t *testing.T) {
+	routeFile := writeRouteFile(t, `
+routes:
+  - name: l1-to-l2
+    flags:
+      srcRpcUrl: l1RpcUrl
+      destRpcUrl: l2RpcUrl
+    components:
+      - type: indexer
+`)
+
+	assert.Nil(t, runApp(routeFile, func(ctx *cli.Context) error {
+		_, err := NewConfigFromCliContext(ctx)
+		assert.ErrorContains(t, err, "l1-to-l2/indexer: required flag srcBridgeAddress not set")
+
+		return nil
+	}))
+}
+
+func TestLoadRouteFile_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			"noRoutes",
+			"routes: []",
+			"no routes",
+		},
+		{
+			"unknownField",
+			"routes:\n  - name: a\n    chains: []",
+			"field chains not found",
+		},
+		{
+			"duplicateRoute",
+			"routes:\n  - name: a\n    components: [{type: indexer}]\n  - name: a\n    components: [{type: indexer}]",
+			"duplicate route a",
+		},
+		{
+			"noComponents",
+			"routes:\n  - name: a",
+			"route a: no components",
+		},
+		{
+			"invalidComponentType",
+			"routes:\n  - name: a\n    components: [{type: api}]",
+			"route a: invalid component type api",
+		},
+		{
+			"sharedFlag",
+			"routes:\n  - name: a\n    flags: {db.host: localhost}\n    components: [{type: indexer}]",
+			"route a: flag db.host can not be set for any of its components",
+		},
+		{
+			"unsupportedFlag",
+			"routes:\n  - name: a\n    components: [{type: processor, flags: {targetTxHash: '0x1'}}]",
+			"route a: flag targetTxHash can not be set for processor",
+		},
+		{
+			"componentFlag",
+			"routes:\n  - name: a\n    components: [{type: indexer, flags: {processorPrivateKey: '0x1'}}]",
+			"route a: flag processorPrivateKey can not be set for indexer",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadRouteFile(writeRouteFile(t, tt.content))
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package router
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/indexer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/processor"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/watchdog"
+	"github.com/urfave/cli/v2"
+	"gorm.io/gorm"
+)
+
+type DB interface {
+	DB() (*sql.DB, error)
+	GormDB() *gorm.DB
+}
+
+// app is the lifecycle of a started route component.
+type app interface {
+	Name() string
+	Start() error
+	Close(context.Context)
+}
+
+// Router runs the indexers, processors and watchdogs of many routes in a single process,
+// sharing the database connection, the queue backend and the metrics server between them.
+// Each component is started independently, and retried until it starts, so a route failing
+// doesn't affect the others.
+type Router struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	db DB
+
+	components    []*ComponentConfig
+	retryInterval time.Duration
+
+	// apps are the started components, only accessed once wg is done.
+	apps   []app
+	appsMu sync.Mutex
+
+	wg sync.WaitGroup
+}
+
+func (r *Router) InitFromCli(ctx context.Context, c *cli.Context) error {
+	cfg, err := NewConfigFromCliContext(c)
+	if err != nil {
+		return err
+	}
+
+	return InitFromConfig(ctx, r, cfg)
+}
+
+// InitFromConfig inits a new Router from a provided Config struct
+func InitFromConfig(ctx context.Context, r *Router, cfg *Config) error {
+	db, err := cfg.OpenDBFunc()
+	if err != nil {
+		return err
+	}
+
+	r.db = db
+	r.components = cfg.Components
+	r.retryInterval = cfg.RetryInterval
+	r.ctx, r.cancel = context.WithCancel(ctx)
+
+	return nil
+}
+
+func (r *Router) Name() string {
+	return "router"
+}
+
+// Start starts all route components in the background, retrying each one
+// until it starts or the router is closed.
+func (r *Router) Start() error {
+	for _, component := range r.components {
+		r.wg.Add(1)
+
+		go r.run(component)
+	}
+
+	return nil
+}
+
+func (r *Router) Close(ctx context.Context) {
+	r.cancel()
+
+	r.wg.Wait()
+
+	for _, a := range r.apps {
+		a.Close(ctx)
+	}
+}
+
+func (r *Router) run(component *ComponentConfig) {
+	defer r.wg.Done()
+
+	if err := backoff.Retry(func() error {
+		a, err := r.startComponent(component)
+		if err != nil {
+			slog.Error("error starting route component", "component", component.Name(), "error", err)
+			return err
+		}
+
+		r.appsMu.Lock()
+		r.apps = append(r.apps, a)
+		r.appsMu.Unlock()
+
+		slog.Info("started route component", "component", component.Name())
+
+		return nil
+	}, backoff.WithContext(backoff.NewConstantBackOff(r.retryInterval), r.ctx)); err != nil {
+		slog.Error("route component not started", "component", component.Name(), "error", err)
+	}
+}
+
+// startComponent inits and starts a new component from its config, using the shared
+// database connection.
+func (r *Router) startComponent(component *ComponentConfig) (app, error) {
+	var a app
+
+	switch component.Type {
+	case ComponentIndexer:
+		cfg := *component.Indexer
+		cfg.OpenDBFunc = func() (indexer.DB, error) { return r.db, nil }
+
+		i := new(indexer.Indexer)
+		if err := indexer.InitFromConfig(r.ctx, i, &cfg); err != nil {
+			return nil, err
+		}
+
+		a = i
+	case ComponentProcessor:
+		cfg := *component.Processor
+		cfg.OpenDBFunc = func() (processor.DB, error) { return r.db, nil }
+
+		p := new(processor.Processor)
+		if err := processor.InitFromConfig(r.ctx, p, &cfg); err != nil {
+			return nil, err
+		}
+
+		a = p
+	case ComponentWatchdog:
+		cfg := *component.Watchdog
+		cfg.OpenDBFunc = func() (watchdog.DB, error) { return r.db, nil }
+
+		w := new(watchdog.Watchdog)
+		if err := watchdog.InitFromConfig(r.ctx, w, &cfg); err != nil {
+			return nil, err
+		}
+
+		a = w
+	default:
+		return nil, fmt.Errorf("invalid component type %v", component.Type)
+	}
+
+	if err := a.Start(); err != nil {
+		a.Close(r.ctx)
+
+		return nil, err
+	}
+
+	return a, nil
+}
@@ -0,0 +1,38 @@
+package router
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/indexer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+func TestRouter_ComponentFailure(t *testing.T) {
+	r := new(Router)
+
+	assert.Nil(t, InitFromConfig(context.Background(), r, &Config{
+		Components: []*ComponentConfig{
+			{
+				Route:   "l1-to-l2",
+				Type:    ComponentIndexer,
+				Indexer: &indexer.Config{SrcRPCUrl: "invalid://rpc"},
+			},
+		},
+		RetryInterval: 10 * time.Millisecond,
+		OpenDBFunc: func() (DB, error) {
+			return &mock.DB{}, nil
+		},
+	}))
+
+	// components failing to start are retried in the background, without failing the router.
+	assert.Nil(t, r.Start())
+
+	time.Sleep(50 * time.Millisecond)
+
+	r.Close(context.Background())
+
+	assert.Empty(t, r.apps)
+}
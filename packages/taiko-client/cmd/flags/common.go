@@ -10,11 +10,13 @@ import (
 var (
 	commonCategory   = "COMMON"
 	metricsCategory  = "METRICS"
+	tracingCategory  = "TRACING"
 	loggingCategory  = "LOGGING"
 	driverCategory   = "DRIVER"
 	proposerCategory = "PROPOSER"
 	proverCategory   = "PROVER"
 	txmgrCategory    = "TX_MANAGER"
+	statusCategory   = "STATUS"
 )
 
 // Required flags used by all client software.
@@ -126,6 +128,35 @@ var (
 		Value:    6060,
 		EnvVars:  []string{"METRICS_PORT"},
 	}
+	// Tracing
+	TracingEnabled = &cli.BoolFlag{
+		Name:     "tracing",
+		Usage:    "Enable OpenTelemetry tracing and export the spans to an OTLP collector",
+		Category: tracingCategory,
+		Value:    false,
+		EnvVars:  []string{"TRACING"},
+	}
+	TracingEndpoint = &cli.StringFlag{
+		Name:     "tracing.endpoint",
+		Usage:    "OTLP/HTTP collector endpoint (host:port) the spans are exported to",
+		Category: tracingCategory,
+		Value:    "localhost:4318",
+		EnvVars:  []string{"TRACING_ENDPOINT"},
+	}
+	TracingInsecure = &cli.BoolFlag{
+		Name:     "tracing.insecure",
+		Usage:    "Export the spans to the OTLP collector over plain HTTP instead of HTTPS",
+		Category: tracingCategory,
+		Value:    false,
+		EnvVars:  []string{"TRACING_INSECURE"},
+	}
+	TracingSampleRatio = &cli.Float64Flag{
+		Name:     "tracing.sampleRatio",
+		Usage:    "Ratio of the traces to sample, between 0 and 1",
+		Category: tracingCategory,
+		Value:    1,
+		EnvVars:  []string{"TRACING_SAMPLE_RATIO"},
+	}
 	BackOffMaxRetries = &cli.Uint64Flag{
 		Name:     "backoff.maxRetries",
 		Usage:    "Max retry times when there is an error",
@@ -147,6 +178,21 @@ var (
 		Value:    12 * time.Second,
 		EnvVars:  []string{"RPC_TIMEOUT"},
 	}
+	L1EventStream = &cli.BoolFlag{
+		Name: "l1.eventStream",
+		Usage: "Fetch the protocol events through a reorg-aware L1 event stream shared by all roles in the process, " +
+			"instead of separate subscriptions",
+		Category: commonCategory,
+		Value:    false,
+		EnvVars:  []string{"L1_EVENT_STREAM"},
+	}
+	L1LogCacheBlocks = &cli.Uint64Flag{
+		Name:     "l1.logCacheBlocks",
+		Usage:    "Number of recent L1 blocks whose protocol logs are cached locally by the L1 event stream",
+		Category: commonCategory,
+		Value:    0,
+		EnvVars:  []string{"L1_LOG_CACHE_BLOCKS"},
+	}
 	AssignmentHookAddress = &cli.StringFlag{
 		Name:     "assignmentHookAddress",
 		Usage:    "Address of the AssignmentHook contract",
@@ -167,6 +213,10 @@ var CommonFlags = []cli.Flag{
 	MetricsEnabled,
 	MetricsAddr,
 	MetricsPort,
+	TracingEnabled,
+	TracingEndpoint,
+	TracingInsecure,
+	TracingSampleRatio,
 	BackOffMaxRetries,
 	BackOffRetryInterval,
 	RPCTimeout,
@@ -30,6 +30,21 @@ var (
 		Category: driverCategory,
 		EnvVars:  []string{"P2P_CHECK_POINT_SYNC_URL"},
 	}
+	CheckPointSyncFallbackURLs = &cli.StringSliceFlag{
+		Name: "p2p.checkPointSyncFallbackUrls",
+		Usage: "HTTP RPC endpoints of other synced L2 execution engine nodes, " +
+			"will be tried in order if the primary check point endpoint fails",
+		Category: driverCategory,
+		EnvVars:  []string{"P2P_CHECK_POINT_SYNC_FALLBACK_URLS"},
+	}
+	CheckPointSyncBlockID = &cli.Uint64Flag{
+		Name: "p2p.checkPointSyncBlockId",
+		Usage: "ID of a verified L2 block to beacon sync to, its hash will be checked against the protocol's " +
+			"verified transition before syncing, 0 means using the default head to sync",
+		Value:    0,
+		Category: driverCategory,
+		EnvVars:  []string{"P2P_CHECK_POINT_SYNC_BLOCK_ID"},
+	}
 	// syncer specific flag
 	MaxExponent = &cli.Uint64Flag{
 		Name: "syncer.maxExponent",
@@ -63,7 +78,11 @@ var DriverFlags = MergeFlags(CommonFlags, []cli.Flag{
 	P2PSync,
 	P2PSyncTimeout,
 	CheckPointSyncURL,
+	CheckPointSyncFallbackURLs,
+	CheckPointSyncBlockID,
 	MaxExponent,
 	BlobServerEndpoint,
 	SocialScanEndpoint,
+	L1EventStream,
+	L1LogCacheBlocks,
 })
@@ -9,9 +9,9 @@ import (
 // Required flags used by proposer.
 var (
 	L1ProposerPrivKey = &cli.StringFlag{
-		Name:     "l1.proposerPrivKey",
-		Usage:    "Private key of the L1 proposer, who will send TaikoL1.proposeBlock transactions",
-		Required: true,
+		Name: "l1.proposerPrivKey",
+		Usage: "Private key of the L1 proposer, who will send TaikoL1.proposeBlock transactions, " +
+			"required if signer.endpoint is not set",
 		Category: proposerCategory,
 		EnvVars:  []string{"L1_PROPOSER_PRIV_KEY"},
 	}
@@ -60,6 +60,21 @@ var (
 		Value:    3,
 		EnvVars:  []string{"TIER_FEE_MAX_PRICE_BUMPS"},
 	}
+	// Prover selection related.
+	ProverMarketplace = &cli.BoolFlag{
+		Name: "proverMarketplace",
+		Usage: "Request the tier fees of all prover endpoints in parallel, and pick the prover with the lowest " +
+			"fee weighted by its on-chain reputation",
+		Value:    false,
+		Category: proposerCategory,
+		EnvVars:  []string{"PROVER_MARKETPLACE"},
+	}
+	ProverReputationFile = &cli.StringFlag{
+		Name:     "proverMarketplace.reputationFile",
+		Usage:    "Path to the file to persist the provers' reputation scores, empty means keeping them in memory",
+		Category: proposerCategory,
+		EnvVars:  []string{"PROVER_MARKETPLACE_REPUTATION_FILE"},
+	}
 	// Proposing epoch related.
 	ProposeInterval = &cli.DurationFlag{
 		Name:     "epoch.interval",
@@ -118,6 +133,33 @@ var (
 		Category: proposerCategory,
 		EnvVars:  []string{"TX_POOL_MAX_TX_LISTS_PER_EPOCH"},
 	}
+	MaxTxsPerSender = &cli.Uint64Flag{
+		Name:     "txPool.maxTxsPerSender",
+		Usage:    "Maximum number of transactions from a single sender inside one transactions list, 0 means no limit",
+		Value:    0,
+		Category: proposerCategory,
+		EnvVars:  []string{"TX_POOL_MAX_TXS_PER_SENDER"},
+	}
+	TxPoolDenyList = &cli.StringSliceFlag{
+		Name:     "txPool.denyList",
+		Usage:    "Comma separated accounts whose sent or received transactions will never be proposed",
+		Category: proposerCategory,
+		EnvVars:  []string{"TX_POOL_DENY_LIST"},
+	}
+	TxPoolOrdering = &cli.StringFlag{
+		Name: "txPool.ordering",
+		Usage: "Ordering of the transactions inside one transactions list, " +
+			"pool to keep the order returned by L2 execution engine, or tipPerByte to order by priority fee per byte",
+		Value:    "pool",
+		Category: proposerCategory,
+		EnvVars:  []string{"TX_POOL_ORDERING"},
+	}
+	TxPoolBundles = &cli.StringFlag{
+		Name:     "txPool.bundles",
+		Usage:    "Path to a JSON file of transaction hash groups, each group will be proposed atomically or not at all",
+		Category: proposerCategory,
+		EnvVars:  []string{"TX_POOL_BUNDLES"},
+	}
 	ProposeBlockIncludeParentMetaHash = &cli.BoolFlag{
 		Name:     "includeParentMetaHash",
 		Usage:    "Include parent meta hash when proposing block",
@@ -125,6 +167,38 @@ var (
 		Category: proposerCategory,
 		EnvVars:  []string{"INCLUDE_PARENT_META_HASH"},
 	}
+	// Profitability related.
+	ProfitabilityCheck = &cli.BoolFlag{
+		Name: "profitability.check",
+		Usage: "Estimate the L2 revenue against the L1 costs and the prover fee before proposing a block, " +
+			"and skip the unprofitable proposals",
+		Value:    false,
+		Category: proposerCategory,
+		EnvVars:  []string{"PROFITABILITY_CHECK"},
+	}
+	ProfitabilityTolerance = &cli.Uint64Flag{
+		Name:     "profitability.tolerance",
+		Usage:    "Percentage of the total proposing cost which is allowed to be not covered by the L2 revenue",
+		Value:    0,
+		Category: proposerCategory,
+		EnvVars:  []string{"PROFITABILITY_TOLERANCE"},
+	}
+	ProfitabilityMaxDelay = &cli.DurationFlag{
+		Name: "profitability.maxDelay",
+		Usage: "Maximum time span to delay the unprofitable proposals since the last proposal, " +
+			"after which they will be proposed anyway, 0 means always skipping them",
+		Value:    0,
+		Category: proposerCategory,
+		EnvVars:  []string{"PROFITABILITY_MAX_DELAY"},
+	}
+	ProfitabilityGasUtilization = &cli.Uint64Flag{
+		Name: "profitability.gasUtilization",
+		Usage: "Percentage of the L2 transactions' gas limits expected to be used by them, " +
+			"to estimate the L2 revenue",
+		Value:    50,
+		Category: proposerCategory,
+		EnvVars:  []string{"PROFITABILITY_GAS_UTILIZATION"},
+	}
 	// Transaction related.
 	BlobAllowed = &cli.BoolFlag{
 		Name:    "l1.blobAllowed",
@@ -132,6 +206,14 @@ var (
 		Value:   false,
 		EnvVars: []string{"L1_BLOB_ALLOWED"},
 	}
+	AdaptiveBlob = &cli.BoolFlag{
+		Name: "l1.adaptiveBlob",
+		Usage: "Choose between blob and calldata for each proposal, whichever is cheaper under the current " +
+			"L1 fee market, requires l1.blobAllowed to be set",
+		Value:    false,
+		Category: proposerCategory,
+		EnvVars:  []string{"L1_ADAPTIVE_BLOB"},
+	}
 	L1BlockBuilderTip = &cli.Uint64Flag{
 		Name:     "l1.blockBuilderTip",
 		Usage:    "Amount you wish to tip the L1 block builder",
@@ -157,13 +239,24 @@ var ProposerFlags = MergeFlags(CommonFlags, []cli.Flag{
 	MinTxListBytes,
 	MinProposingInternal,
 	MaxProposedTxListsPerEpoch,
+	MaxTxsPerSender,
+	TxPoolDenyList,
+	TxPoolOrdering,
+	TxPoolBundles,
 	ProverEndpoints,
 	OptimisticTierFee,
 	SgxTierFee,
 	TierFeePriceBump,
 	MaxTierFeePriceBumps,
+	ProverMarketplace,
+	ProverReputationFile,
 	ProposeBlockIncludeParentMetaHash,
 	AssignmentHookAddress,
 	BlobAllowed,
+	AdaptiveBlob,
 	L1BlockBuilderTip,
+	ProfitabilityCheck,
+	ProfitabilityTolerance,
+	ProfitabilityMaxDelay,
+	ProfitabilityGasUtilization,
 }, TxmgrFlags)
@@ -11,9 +11,9 @@ import (
 // Required flags used by prover.
 var (
 	L1ProverPrivKey = &cli.StringFlag{
-		Name:     "l1.proverPrivKey",
-		Usage:    "Private key of L1 prover, who will send TaikoL1.proveBlock transactions",
-		Required: true,
+		Name: "l1.proverPrivKey",
+		Usage: "Private key of L1 prover, who will send TaikoL1.proveBlock transactions, " +
+			"required if signer.endpoint is not set",
 		Category: proverCategory,
 		EnvVars:  []string{"L1_PROVER_PRIV_KEY"},
 	}
@@ -101,6 +101,94 @@ var (
 		Category: proverCategory,
 		EnvVars:  []string{"MIN_TIER_FEE_SGX_AND_ZKVM"},
 	}
+	// Backlog catch-up mode.
+	CatchUpMode = &cli.BoolFlag{
+		Name: "prover.catchUp",
+		Usage: "Request the proofs concurrently with a worker pool per tier, proving the blocks " +
+			"closest to their proving window expiry first",
+		Value:    false,
+		Category: proverCategory,
+		EnvVars:  []string{"PROVER_CATCH_UP"},
+	}
+	CatchUpWorkersPerTier = &cli.Uint64Flag{
+		Name:     "prover.catchUp.workersPerTier",
+		Usage:    "Number of proof request workers for each tier in catch-up mode",
+		Value:    4,
+		Category: proverCategory,
+		EnvVars:  []string{"PROVER_CATCH_UP_WORKERS_PER_TIER"},
+	}
+	CatchUpProducerConcurrency = &cli.Uint64Flag{
+		Name:     "prover.catchUp.producerConcurrency",
+		Usage:    "Maximum number of concurrent proof requests sent to each proof producer in catch-up mode",
+		Value:    2,
+		Category: proverCategory,
+		EnvVars:  []string{"PROVER_CATCH_UP_PRODUCER_CONCURRENCY"},
+	}
+	// Proof submission gas strategy.
+	ProofGasMaxSpend = &cli.Float64Flag{
+		Name: "prover.gas.maxSpendPerProof",
+		Usage: "Maximum fee (in Ether) a proof submission transaction can pay, " +
+			"enables the deadline-aware gas strategy if set",
+		Category: proverCategory,
+		EnvVars:  []string{"PROVER_GAS_MAX_SPEND_PER_PROOF"},
+	}
+	ProofGasSlackFeeCap = &cli.Float64Flag{
+		Name:     "prover.gas.slackFeeCap",
+		Usage:    "Maximum L1 gas price (in GWei) paid for proof submissions while the proving window deadline is far",
+		Value:    10,
+		Category: proverCategory,
+		EnvVars:  []string{"PROVER_GAS_SLACK_FEE_CAP"},
+	}
+	ProofGasEscalationWindow = &cli.DurationFlag{
+		Name: "prover.gas.escalationWindow",
+		Usage: "Time before the proving window deadline when the gas price cap starts escalating " +
+			"towards the maximum spend per proof",
+		Value:    30 * time.Minute,
+		Category: proverCategory,
+		EnvVars:  []string{"PROVER_GAS_ESCALATION_WINDOW"},
+	}
+	// Liveness bond management.
+	BondCheckInterval = &cli.DurationFlag{
+		Name: "bond.checkInterval",
+		Usage: "Time interval to check the TaikoToken balance and allowances used to pay the liveness bonds, " +
+			"0 means disabling the liveness bond management",
+		Value:    1 * time.Minute,
+		Category: proverCategory,
+		EnvVars:  []string{"BOND_CHECK_INTERVAL"},
+	}
+	BondMinBalance = &cli.Float64Flag{
+		Name: "bond.minBalance",
+		Usage: "TaikoToken balance (without decimal) below which an alert is raised and a top up is made, " +
+			"defaults to the liveness bond",
+		Category: proverCategory,
+		EnvVars:  []string{"BOND_MIN_BALANCE"},
+	}
+	BondTreasury = &cli.StringFlag{
+		Name: "bond.treasury",
+		Usage: "Treasury `address` to top up the TaikoToken balance from, " +
+			"which should have approved the prover to transfer its tokens",
+		Category: proverCategory,
+		EnvVars:  []string{"BOND_TREASURY"},
+	}
+	BondTopUpAmount = &cli.Float64Flag{
+		Name:     "bond.topUpAmount",
+		Usage:    "Amount of TaikoToken (without decimal) to transfer from the treasury in a single top up",
+		Category: proverCategory,
+		EnvVars:  []string{"BOND_TOP_UP_AMOUNT"},
+	}
+	BondMaxTopUpPerDay = &cli.Float64Flag{
+		Name:     "bond.maxTopUpPerDay",
+		Usage:    "Maximum amount of TaikoToken (without decimal) to transfer from the treasury in 24 hours",
+		Category: proverCategory,
+		EnvVars:  []string{"BOND_MAX_TOP_UP_PER_DAY"},
+	}
+	BondAllowanceThreshold = &cli.Float64Flag{
+		Name: "bond.allowanceThreshold",
+		Usage: "Allowance (without decimal) of TaikoL1 and AssignmentHook contracts, " +
+			"below which prover.allowance will be approved again",
+		Category: proverCategory,
+		EnvVars:  []string{"BOND_ALLOWANCE_THRESHOLD"},
+	}
 	// Running mode
 	ContesterMode = &cli.BoolFlag{
 		Name:     "mode.contester",
@@ -181,6 +269,28 @@ var (
 		Category: proverCategory,
 		EnvVars:  []string{"GUARDIAN_SUBMISSION_DELAY"},
 	}
+	GuardianSigningHistory = &cli.StringFlag{
+		Name: "guardian.signingHistory",
+		Usage: "Path to the local database of the blocks signed by this guardian prover, which is used to " +
+			"refuse signing a different hash for an already signed block, empty means keeping it in memory",
+		Category: proverCategory,
+		EnvVars:  []string{"GUARDIAN_SIGNING_HISTORY"},
+	}
+	GuardianHeartbeatInterval = &cli.DurationFlag{
+		Name:     "guardian.heartbeatInterval",
+		Usage:    "Time interval to send heartbeats to the guardian prover health check server",
+		Value:    12 * time.Second,
+		Category: proverCategory,
+		EnvVars:  []string{"GUARDIAN_HEARTBEAT_INTERVAL"},
+	}
+	GuardianHeartbeatDiagnostics = &cli.BoolFlag{
+		Name: "guardian.heartbeatDiagnostics",
+		Usage: "Send the signed node health diagnostics (sync lag, peer counts, pending proofs, " +
+			"last signed block) alongside each heartbeat",
+		Value:    true,
+		Category: proverCategory,
+		EnvVars:  []string{"GUARDIAN_HEARTBEAT_DIAGNOSTICS"},
+	}
 	EnableLivenessBondProof = &cli.BoolFlag{
 		Name:     "prover.enableLivenessBondProof",
 		Usage:    "Toggles whether the proof is a dummy proof or returns keccak256(RETURN_LIVENESS_BOND) as proof",
@@ -230,9 +340,24 @@ var ProverFlags = MergeFlags(CommonFlags, []cli.Flag{
 	GuardianProverMajority,
 	GuardianProofSubmissionDelay,
 	GuardianProverHealthCheckServerEndpoint,
+	GuardianSigningHistory,
+	GuardianHeartbeatInterval,
+	GuardianHeartbeatDiagnostics,
 	Graffiti,
 	ProveUnassignedBlocks,
 	ContesterMode,
+	CatchUpMode,
+	CatchUpWorkersPerTier,
+	CatchUpProducerConcurrency,
+	ProofGasMaxSpend,
+	ProofGasSlackFeeCap,
+	ProofGasEscalationWindow,
+	BondCheckInterval,
+	BondMinBalance,
+	BondTreasury,
+	BondTopUpAmount,
+	BondMaxTopUpPerDay,
+	BondAllowanceThreshold,
 	ProverHTTPServerPort,
 	ProverCapacity,
 	MaxExpiry,
@@ -244,4 +369,34 @@ var ProverFlags = MergeFlags(CommonFlags, []cli.Flag{
 	L1NodeVersion,
 	L2NodeVersion,
 	BlockConfirmations,
+	L1EventStream,
+	L1LogCacheBlocks,
 }, TxmgrFlags)
+
+// Flags used by the guardian signing history subcommands.
+var (
+	GuardianSigningHistoryFile = &cli.StringFlag{
+		Name:     "file",
+		Usage:    "Path to the signing history interchange JSON file",
+		Required: true,
+		EnvVars:  []string{"GUARDIAN_SIGNING_HISTORY_FILE"},
+	}
+	GuardianAddress = &cli.StringFlag{
+		Name:     "guardian.address",
+		Usage:    "Address of the guardian prover who owns the signing history",
+		Required: true,
+		EnvVars:  []string{"GUARDIAN_ADDRESS"},
+	}
+)
+
+// GuardianSigningHistoryFlags All guardian signing history subcommands flags.
+var GuardianSigningHistoryFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:     GuardianSigningHistory.Name,
+		Usage:    "Path to the local database of the blocks signed by the guardian prover",
+		Required: true,
+		EnvVars:  GuardianSigningHistory.EnvVars,
+	},
+	GuardianSigningHistoryFile,
+	GuardianAddress,
+}
@@ -0,0 +1,51 @@
+package flags
+
+import (
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Optional flags used by the status command.
+var (
+	StatusProver = &cli.StringFlag{
+		Name: "status.prover",
+		Usage: "Address of the prover (or the ProverSet contract) to report the pending proofs " +
+			"and the bond balances for",
+		Category: statusCategory,
+		EnvVars:  []string{"STATUS_PROVER"},
+	}
+	StatusJSON = &cli.BoolFlag{
+		Name:     "status.json",
+		Usage:    "Print the status in JSON format instead of the human-readable text",
+		Value:    false,
+		Category: statusCategory,
+		EnvVars:  []string{"STATUS_JSON"},
+	}
+	StatusTimeout = &cli.DurationFlag{
+		Name:     "status.timeout",
+		Usage:    "Timeout for collecting the whole status",
+		Value:    1 * time.Minute,
+		Category: statusCategory,
+		EnvVars:  []string{"STATUS_TIMEOUT"},
+	}
+	StatusLimit = &cli.Uint64Flag{
+		Name: "status.limit",
+		Usage: "Maximum number of the latest unverified blocks to search for the pending proofs, " +
+			"0 means searching all of them",
+		Value:    256,
+		Category: statusCategory,
+		EnvVars:  []string{"STATUS_LIMIT"},
+	}
+)
+
+// StatusFlags All status command flags.
+var StatusFlags = MergeFlags(CommonFlags, []cli.Flag{
+	L2WSEndpoint,
+	TaikoTokenAddress,
+	AssignmentHookAddress,
+	StatusProver,
+	StatusJSON,
+	StatusTimeout,
+	StatusLimit,
+})
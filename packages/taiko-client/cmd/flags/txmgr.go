@@ -86,6 +86,37 @@ var (
 		Category: txmgrCategory,
 		EnvVars:  []string{"TX_GAS_LIMIT"},
 	}
+	RemoteSignerEndpoint = &cli.StringFlag{
+		Name: "signer.endpoint",
+		Usage: "HTTP endpoint of a remote Web3Signer, which will be used to sign the L1 transactions " +
+			"and messages instead of a local private key",
+		Category: txmgrCategory,
+		EnvVars:  []string{"SIGNER_ENDPOINT"},
+	}
+	RemoteSignerAddress = &cli.StringFlag{
+		Name:     "signer.address",
+		Usage:    "Address of the account managed by the remote signer",
+		Category: txmgrCategory,
+		EnvVars:  []string{"SIGNER_ADDRESS"},
+	}
+	RemoteSignerTLSCaCert = &cli.StringFlag{
+		Name:     "signer.tls.ca",
+		Usage:    "Path of the CA certificate used to verify the remote signer, enables mutual TLS",
+		Category: txmgrCategory,
+		EnvVars:  []string{"SIGNER_TLS_CA"},
+	}
+	RemoteSignerTLSCert = &cli.StringFlag{
+		Name:     "signer.tls.cert",
+		Usage:    "Path of the client certificate used to authenticate to the remote signer",
+		Category: txmgrCategory,
+		EnvVars:  []string{"SIGNER_TLS_CERT"},
+	}
+	RemoteSignerTLSKey = &cli.StringFlag{
+		Name:     "signer.tls.key",
+		Usage:    "Path of the client certificate key used to authenticate to the remote signer",
+		Category: txmgrCategory,
+		EnvVars:  []string{"SIGNER_TLS_KEY"},
+	}
 )
 
 var TxmgrFlags = []cli.Flag{
@@ -100,4 +131,9 @@ var TxmgrFlags = []cli.Flag{
 	TxNotInMempoolTimeout,
 	ReceiptQueryInterval,
 	TxGasLimit,
+	RemoteSignerEndpoint,
+	RemoteSignerAddress,
+	RemoteSignerTLSCaCert,
+	RemoteSignerTLSCert,
+	RemoteSignerTLSKey,
 }
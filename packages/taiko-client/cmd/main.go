@@ -12,6 +12,8 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/version"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover"
+	protection "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/signing_protection"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/status"
 )
 
 func main() {
@@ -48,6 +50,32 @@ func main() {
 			Description: "Taiko prover software",
 			Action:      utils.SubcommandAction(new(prover.Prover)),
 		},
+		{
+			Name:        "status",
+			Flags:       flags.StatusFlags,
+			Usage:       "Prints a summary of the protocol and node state",
+			Description: "Collects the L1 / L2 heads, protocol state, tier configs and prover state in one shot",
+			Action:      status.Action,
+		},
+		{
+			Name:        "guardian-history",
+			Usage:       "Manages the signing history of a guardian prover",
+			Description: "Export or import the guardian prover signing history when moving to another host",
+			Subcommands: []*cli.Command{
+				{
+					Name:   "export",
+					Flags:  flags.GuardianSigningHistoryFlags,
+					Usage:  "Exports the local signing history to an interchange JSON file",
+					Action: protection.ExportAction,
+				},
+				{
+					Name:   "import",
+					Flags:  flags.GuardianSigningHistoryFlags,
+					Usage:  "Imports an interchange JSON file into the local signing history",
+					Action: protection.ImportAction,
+				},
+			},
+		},
 	}
 
 	if err := app.Run(os.Args); err != nil {
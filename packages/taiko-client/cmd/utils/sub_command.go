@@ -9,8 +9,10 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/urfave/cli/v2"
 
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/logger"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/tracing"
 )
 
 type SubcommandApplication interface {
@@ -27,6 +29,22 @@ func SubcommandAction(app SubcommandApplication) cli.ActionFunc {
 		ctx, ctxClose := context.WithCancel(context.Background())
 		defer ctxClose()
 
+		shutdownTracing, err := tracing.Init(ctx, &tracing.Config{
+			Enabled:     c.Bool(flags.TracingEnabled.Name),
+			ServiceName: app.Name(),
+			Endpoint:    c.String(flags.TracingEndpoint.Name),
+			Insecure:    c.Bool(flags.TracingInsecure.Name),
+			SampleRatio: c.Float64(flags.TracingSampleRatio.Name),
+		})
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := shutdownTracing(context.Background()); err != nil {
+				log.Error("Failed to shutdown tracer provider", "error", err)
+			}
+		}()
+
 		if err := app.InitFromCli(ctx, c); err != nil {
 			return err
 		}
@@ -29,6 +29,8 @@ type SyncProgressTracker struct {
 	triggered           bool
 	lastSyncedBlockID   *big.Int
 	lastSyncedBlockHash common.Hash
+	// Whether the last synced block hash has been verified against the protocol
+	verified bool
 
 	// Out-of-sync check related
 	lastSyncProgress   *ethereum.SyncProgress
@@ -86,6 +88,9 @@ func (t *SyncProgressTracker) track(ctx context.Context) {
 		log.Info(
 			"L2 execution engine sync progress",
 			"progress", progress,
+			"targetBlockID", t.lastSyncedBlockID,
+			"targetBlockHash", t.lastSyncedBlockHash,
+			"verified", t.verified,
 			"lastProgressedTime", t.lastProgressedTime,
 			"timeout", t.timeout,
 		)
@@ -148,6 +153,15 @@ func (t *SyncProgressTracker) UpdateMeta(id *big.Int, blockHash common.Hash) {
 	t.triggered = true
 	t.lastSyncedBlockID = id
 	t.lastSyncedBlockHash = blockHash
+	t.verified = false
+}
+
+// UpdateVerified marks whether the last synced block hash has been verified against the protocol.
+func (t *SyncProgressTracker) UpdateVerified(verified bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.verified = verified
 }
 
 // ClearMeta cleans the inner beacon sync metadata.
@@ -160,6 +174,7 @@ func (t *SyncProgressTracker) ClearMeta() {
 	t.triggered = false
 	t.lastSyncedBlockID = nil
 	t.lastSyncedBlockHash = common.Hash{}
+	t.verified = false
 	t.outOfSync = false
 }
 
@@ -228,6 +243,14 @@ func (t *SyncProgressTracker) LastSyncedBlockID() *big.Int {
 	return new(big.Int).Set(t.lastSyncedBlockID)
 }
 
+// Verified returns tracker.verified.
+func (t *SyncProgressTracker) Verified() bool {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.verified
+}
+
 // LastSyncedBlockHash returns tracker.lastSyncedBlockHash.
 func (t *SyncProgressTracker) LastSyncedBlockHash() common.Hash {
 	t.mutex.RLock()
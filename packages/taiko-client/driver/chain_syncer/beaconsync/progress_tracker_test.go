@@ -85,6 +85,18 @@ func (s *BeaconSyncProgressTrackerTestSuite) TestLastSyncedVerifiedBlockHash() {
 	s.Equal(randomHash, s.t.LastSyncedBlockHash())
 }
 
+func (s *BeaconSyncProgressTrackerTestSuite) TestVerified() {
+	s.False(s.t.Verified())
+	s.t.UpdateMeta(common.Big1, testutils.RandomHash())
+	s.t.UpdateVerified(true)
+	s.True(s.t.Verified())
+	s.t.UpdateMeta(common.Big2, testutils.RandomHash())
+	s.False(s.t.Verified())
+	s.t.UpdateVerified(true)
+	s.t.ClearMeta()
+	s.False(s.t.Verified())
+}
+
 func TestBeaconSyncProgressTrackerTestSuite(t *testing.T) {
 	suite.Run(t, new(BeaconSyncProgressTrackerTestSuite))
 }
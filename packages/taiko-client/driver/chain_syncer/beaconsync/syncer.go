@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/beacon/engine"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/downloader"
 	"github.com/ethereum/go-ethereum/log"
 
@@ -18,11 +19,12 @@ import (
 // Syncer responsible for letting the L2 execution engine catching up with protocol's latest
 // verified block through P2P beacon sync.
 type Syncer struct {
-	ctx             context.Context
-	rpc             *rpc.Client
-	state           *state.State
-	syncMode        string
-	progressTracker *SyncProgressTracker // Sync progress tracker
+	ctx               context.Context
+	rpc               *rpc.Client
+	state             *state.State
+	syncMode          string
+	checkPointBlockID uint64               // A verified block ID to sync to, zero means the default head
+	progressTracker   *SyncProgressTracker // Sync progress tracker
 }
 
 // NewSyncer creates a new syncer instance.
@@ -31,9 +33,10 @@ func NewSyncer(
 	rpc *rpc.Client,
 	state *state.State,
 	syncMode string,
+	checkPointBlockID uint64,
 	progressTracker *SyncProgressTracker,
 ) *Syncer {
-	return &Syncer{ctx, rpc, state, syncMode, progressTracker}
+	return &Syncer{ctx, rpc, state, syncMode, checkPointBlockID, progressTracker}
 }
 
 // TriggerBeaconSync triggers the L2 execution engine to start performing a beacon sync, if the
@@ -56,7 +59,7 @@ func (s *Syncer) TriggerBeaconSync(blockID uint64) error {
 		)
 	}
 
-	headPayload, err := s.getBlockPayload(s.ctx, blockID)
+	headPayload, verified, err := s.getBlockPayload(s.ctx, blockID)
 	if err != nil {
 		return err
 	}
@@ -84,44 +87,84 @@ func (s *Syncer) TriggerBeaconSync(blockID uint64) error {
 
 	// Update sync status.
 	s.progressTracker.UpdateMeta(new(big.Int).SetUint64(blockID), headPayload.BlockHash)
+	s.progressTracker.UpdateVerified(verified)
 
 	log.Info(
 		"⛓️ Beacon sync triggered",
 		"newHeadID", blockID,
 		"newHeadHash", s.progressTracker.LastSyncedBlockHash(),
+		"verified", verified,
 	)
 
 	return nil
 }
 
-// getBlockPayload fetches the block's header, and converts it to an Engine API executable data,
-// which will be used to let the node start beacon syncing.
-func (s *Syncer) getBlockPayload(ctx context.Context, blockID uint64) (*engine.ExecutableData, error) {
-	header, err := s.rpc.L2CheckPoint.HeaderByNumber(s.ctx, new(big.Int).SetUint64(blockID))
-	if err != nil {
-		return nil, err
+// getBlockPayload fetches the block's header from the L2 check point endpoints one by one, until one
+// of them returns a header which passes the verification, and converts it to an Engine API executable data,
+// which will be used to let the node start beacon syncing. The returned boolean indicates whether the
+// header's hash has been checked against the protocol.
+func (s *Syncer) getBlockPayload(ctx context.Context, blockID uint64) (*engine.ExecutableData, bool, error) {
+	checkPoints := s.rpc.L2CheckPoints.Clients(ctx)
+	if len(checkPoints) == 0 {
+		checkPoints = []*rpc.EthClient{s.rpc.L2CheckPoint}
 	}
 
-	// If the sync mode is `full`, we need to verify the protocol verified block hash before syncing.
-	if s.syncMode == downloader.FullSync.String() {
-		blockInfo, err := s.rpc.GetL2BlockInfo(ctx, new(big.Int).SetUint64(blockID))
-		if err != nil {
-			return nil, err
-		}
-		ts, err := s.rpc.GetTransition(ctx, new(big.Int).SetUint64(blockInfo.BlockId), blockInfo.VerifiedTransitionId)
+	// If the sync mode is `full`, or a check point block ID is given, we need to verify
+	// the protocol verified block hash before syncing.
+	needVerify := s.syncMode == downloader.FullSync.String() || s.checkPointBlockID != 0
+
+	var lastErr error
+	for i, checkPoint := range checkPoints {
+		header, err := checkPoint.HeaderByNumber(ctx, new(big.Int).SetUint64(blockID))
 		if err != nil {
-			return nil, err
+			log.Warn("Failed to fetch block header from L2 check point", "index", i, "blockID", blockID, "error", err)
+			lastErr = err
+			continue
 		}
-		if header.Hash() != ts.BlockHash {
-			return nil, fmt.Errorf(
-				"latest verified block hash mismatch: %s != %s",
-				header.Hash(),
-				common.BytesToHash(ts.BlockHash[:]),
-			)
+
+		if needVerify {
+			if err := s.verifyBlockHash(ctx, blockID, header); err != nil {
+				log.Warn("Failed to verify block header from L2 check point", "index", i, "blockID", blockID, "error", err)
+				lastErr = err
+				continue
+			}
 		}
+
+		log.Info("Block header to sync retrieved", "index", i, "hash", header.Hash(), "verified", needVerify)
+
+		return encoding.ToExecutableData(header), needVerify, nil
+	}
+
+	return nil, false, fmt.Errorf("failed to get block header from all L2 check points: %w", lastErr)
+}
+
+// verifyBlockHash checks whether the given header is the requested block's, and its hash matches
+// the protocol's verified transition of that block.
+func (s *Syncer) verifyBlockHash(ctx context.Context, blockID uint64, header *types.Header) error {
+	// The header comes from an untrusted check point, which could return another verified block.
+	if header.Number == nil || header.Number.Uint64() != blockID {
+		return fmt.Errorf("block header number mismatch: %v != %d", header.Number, blockID)
 	}
 
-	log.Info("Block header to sync retrieved", "hash", header.Hash())
+	blockInfo, err := s.rpc.GetL2BlockInfo(ctx, new(big.Int).SetUint64(blockID))
+	if err != nil {
+		return err
+	}
+	if blockInfo.VerifiedTransitionId == 0 {
+		return fmt.Errorf("block %d has not been verified yet", blockID)
+	}
 
-	return encoding.ToExecutableData(header), nil
+	ts, err := s.rpc.GetTransition(ctx, new(big.Int).SetUint64(blockInfo.BlockId), blockInfo.VerifiedTransitionId)
+	if err != nil {
+		return err
+	}
+	if header.Hash() != ts.BlockHash {
+		return fmt.Errorf(
+			"latest verified block hash mismatch: %s != %s",
+			header.Hash(),
+			common.BytesToHash(ts.BlockHash[:]),
+		)
+	}
+
+	return nil
 }
@@ -0,0 +1,21 @@
+package beaconsync
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyBlockHashNumberMismatch(t *testing.T) {
+	s := &Syncer{}
+
+	// A check point returning another block is rejected before its hash is checked.
+	err := s.verifyBlockHash(context.Background(), 2, &types.Header{Number: big.NewInt(1)})
+	require.ErrorContains(t, err, "block header number mismatch")
+
+	err = s.verifyBlockHash(context.Background(), 2, &types.Header{})
+	require.ErrorContains(t, err, "block header number mismatch")
+}
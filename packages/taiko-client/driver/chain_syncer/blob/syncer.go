@@ -25,6 +25,7 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/chain_syncer/beaconsync"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/state"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/tracing"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/utils"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
 
@@ -141,6 +142,7 @@ func (s *Syncer) processL1Blocks(ctx context.Context) error {
 		StartHeight:          s.state.GetL1Current().Number,
 		EndHeight:            l1End.Number,
 		FilterQuery:          nil,
+		EventStream:          s.state.EventStream(),
 		OnBlockProposedEvent: s.onBlockProposed,
 	})
 	if err != nil {
@@ -166,12 +168,15 @@ func (s *Syncer) onBlockProposed(
 	ctx context.Context,
 	event *bindings.TaikoL1ClientBlockProposed,
 	endIter eventIterator.EndBlockProposedEventIterFunc,
-) error {
+) (err error) {
 	// We simply ignore the genesis block's `BlockProposed` event.
 	if event.BlockId.Cmp(common.Big0) == 0 {
 		return nil
 	}
 
+	ctx, span := tracing.Start(ctx, "Syncer.onBlockProposed", tracing.BlockID(event.BlockId))
+	defer func() { tracing.End(span, err) }()
+
 	// If we are not inserting a block whose parent block is the latest verified block in protocol,
 	// and the node hasn't just finished the P2P sync, we check if the L1 chain has been reorged.
 	if !s.progressTracker.Triggered() {
@@ -220,10 +225,7 @@ func (s *Syncer) onBlockProposed(
 
 	// Fetch the L2 parent block, if the node is just finished a P2P sync, we simply use the tracker's
 	// last synced verified block as the parent, otherwise, we fetch the parent block from L2 EE.
-	var (
-		parent *types.Header
-		err    error
-	)
+	var parent *types.Header
 	if s.progressTracker.Triggered() {
 		// Already synced through beacon sync, just skip this event.
 		if event.BlockId.Cmp(s.progressTracker.LastSyncedBlockID()) <= 0 {
@@ -257,18 +259,24 @@ func (s *Syncer) onBlockProposed(
 	} else {
 		txListFetcher = new(txlistFetcher.CalldataFetcher)
 	}
-	txListBytes, err := txListFetcher.Fetch(ctx, tx, &event.Meta)
+	fetchCtx, fetchSpan := tracing.Start(ctx, "Syncer.fetchTxList")
+	txListBytes, err := txListFetcher.Fetch(fetchCtx, tx, &event.Meta)
+	tracing.End(fetchSpan, err)
 	if err != nil {
 		return fmt.Errorf("failed to fetch tx list: %w", err)
 	}
 
 	// Decompress the transactions list and try to insert a new head block to L2 EE.
+	_, decompressSpan := tracing.Start(ctx, "Syncer.decompressTxList")
+	txListBytes = s.txListDecompressor.TryDecompress(event.BlockId, txListBytes, event.Meta.BlobUsed)
+	decompressSpan.End()
+
 	payloadData, err := s.insertNewHead(
 		ctx,
 		event,
 		parent,
 		s.state.GetHeadBlockID(),
-		s.txListDecompressor.TryDecompress(event.BlockId, txListBytes, event.Meta.BlobUsed),
+		txListBytes,
 		&rawdb.L1Origin{
 			BlockID:       event.BlockId,
 			L2BlockHash:   common.Hash{}, // Will be set by taiko-geth.
@@ -311,7 +319,10 @@ func (s *Syncer) insertNewHead(
 	headBlockID *big.Int,
 	txListBytes []byte,
 	l1Origin *rawdb.L1Origin,
-) (*engine.ExecutableData, error) {
+) (_ *engine.ExecutableData, err error) {
+	ctx, span := tracing.Start(ctx, "Syncer.insertNewHead", tracing.BlockID(event.BlockId))
+	defer func() { tracing.End(span, err) }()
+
 	log.Debug(
 		"Try to insert a new L2 head block",
 		"parentNumber", parent.Number,
@@ -592,6 +603,9 @@ func (s *Syncer) createExecutionPayloads(
 	baseFee *big.Int,
 	withdrawals types.Withdrawals,
 ) (payloadData *engine.ExecutableData, err error) {
+	ctx, span := tracing.Start(ctx, "Syncer.createExecutionPayloads")
+	defer func() { tracing.End(span, err) }()
+
 	fc := &engine.ForkchoiceStateV1{HeadBlockHash: parentHash}
 
 	var attributes *engine.PayloadAttributes
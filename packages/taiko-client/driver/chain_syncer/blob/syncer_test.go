@@ -36,7 +36,7 @@ type BlobSyncerTestSuite struct {
 func (s *BlobSyncerTestSuite) SetupTest() {
 	s.ClientTestSuite.SetupTest()
 
-	state2, err := state.New(context.Background(), s.RPCClient)
+	state2, err := state.New(context.Background(), s.RPCClient, nil)
 	s.Nil(err)
 
 	syncer, err := NewSyncer(
@@ -36,6 +36,9 @@ type L2ChainSyncer struct {
 	// If this flag is activated, will try P2P beacon sync if current node is behind of the protocol's
 	// the latest verified block head
 	p2pSync bool
+
+	// A verified block ID to beacon sync to, zero means using the default head to sync
+	checkPointBlockID uint64
 }
 
 // New creates a new chain syncer instance.
@@ -45,6 +48,7 @@ func New(
 	state *state.State,
 	p2pSync bool,
 	p2pSyncTimeout time.Duration,
+	checkPointBlockID uint64,
 	maxRetrieveExponent uint64,
 	blobServerEndpoint *url.URL,
 	socialScanEndpoint *url.URL,
@@ -56,7 +60,7 @@ func New(
 	if err != nil {
 		return nil, err
 	}
-	beaconSyncer := beaconsync.NewSyncer(ctx, rpc, state, syncMode, tracker)
+	beaconSyncer := beaconsync.NewSyncer(ctx, rpc, state, syncMode, checkPointBlockID, tracker)
 	blobSyncer, err := blob.NewSyncer(
 		ctx,
 		rpc,
@@ -71,14 +75,15 @@ func New(
 	}
 
 	return &L2ChainSyncer{
-		ctx:             ctx,
-		rpc:             rpc,
-		state:           state,
-		beaconSyncer:    beaconSyncer,
-		blobSyncer:      blobSyncer,
-		progressTracker: tracker,
-		syncMode:        syncMode,
-		p2pSync:         p2pSync,
+		ctx:               ctx,
+		rpc:               rpc,
+		state:             state,
+		beaconSyncer:      beaconSyncer,
+		blobSyncer:        blobSyncer,
+		progressTracker:   tracker,
+		syncMode:          syncMode,
+		p2pSync:           p2pSync,
+		checkPointBlockID: checkPointBlockID,
 	}, nil
 }
 
@@ -181,14 +186,32 @@ func (s *L2ChainSyncer) needNewBeaconSyncTriggered() (uint64, bool, error) {
 	}
 
 	// For full sync mode, we will use the verified block head,
-	// and for snap sync mode, we will use the latest block head.
+	// and for snap sync mode, we will use the latest block head,
+	// unless a check point block ID is given.
 	var (
 		blockID uint64
 		err     error
 	)
+	if s.checkPointBlockID != 0 {
+		stateVars, err := s.rpc.GetProtocolStateVariables(&bind.CallOpts{Context: s.ctx})
+		if err != nil {
+			return 0, false, err
+		}
+		if s.checkPointBlockID > stateVars.B.LastVerifiedBlockId {
+			return 0, false, fmt.Errorf(
+				"check point block ID %d is not verified yet, last verified block ID: %d",
+				s.checkPointBlockID,
+				stateVars.B.LastVerifiedBlockId,
+			)
+		}
+
+		return s.checkPointBlockID, !s.AheadOfHeadToSync(s.checkPointBlockID) &&
+			!s.progressTracker.OutOfSync(), nil
+	}
+
 	switch s.syncMode {
 	case downloader.SnapSync.String():
-		if blockID, err = s.rpc.L2CheckPoint.BlockNumber(s.ctx); err != nil {
+		if blockID, err = s.rpc.L2CheckPoints.BlockNumber(s.ctx); err != nil {
 			return 0, false, err
 		}
 	case downloader.FullSync.String():
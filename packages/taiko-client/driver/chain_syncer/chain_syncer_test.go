@@ -31,7 +31,7 @@ type ChainSyncerTestSuite struct {
 func (s *ChainSyncerTestSuite) SetupTest() {
 	s.ClientTestSuite.SetupTest()
 
-	state, err := state.New(context.Background(), s.RPCClient)
+	state, err := state.New(context.Background(), s.RPCClient, nil)
 	s.Nil(err)
 
 	syncer, err := New(
@@ -41,6 +41,7 @@ func (s *ChainSyncerTestSuite) SetupTest() {
 		false,
 		1*time.Hour,
 		0,
+		0,
 		nil,
 		nil,
 	)
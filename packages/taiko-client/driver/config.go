@@ -19,10 +19,13 @@ type Config struct {
 	*rpc.ClientConfig
 	P2PSync            bool
 	P2PSyncTimeout     time.Duration
+	CheckPointBlockID  uint64
 	RetryInterval      time.Duration
 	MaxExponent        uint64
 	BlobServerEndpoint *url.URL
 	SocialScanEndpoint *url.URL
+	L1EventStream      bool
+	L1LogCacheBlocks   uint64
 }
 
 // NewConfigFromCliContext creates a new config instance from
@@ -42,6 +45,10 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		return nil, errors.New("empty L2 check point URL")
 	}
 
+	if c.IsSet(flags.CheckPointSyncBlockID.Name) && !p2pSync {
+		return nil, errors.New("check point sync block ID is set, but P2P sync is disabled")
+	}
+
 	if !c.IsSet(flags.L1BeaconEndpoint.Name) {
 		return nil, errors.New("empty L1 beacon endpoint")
 	}
@@ -67,21 +74,25 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 	var timeout = c.Duration(flags.RPCTimeout.Name)
 	return &Config{
 		ClientConfig: &rpc.ClientConfig{
-			L1Endpoint:       c.String(flags.L1WSEndpoint.Name),
-			L1BeaconEndpoint: c.String(flags.L1BeaconEndpoint.Name),
-			L2Endpoint:       c.String(flags.L2WSEndpoint.Name),
-			L2CheckPoint:     l2CheckPoint,
-			TaikoL1Address:   common.HexToAddress(c.String(flags.TaikoL1Address.Name)),
-			TaikoL2Address:   common.HexToAddress(c.String(flags.TaikoL2Address.Name)),
-			L2EngineEndpoint: c.String(flags.L2AuthEndpoint.Name),
-			JwtSecret:        string(jwtSecret),
-			Timeout:          timeout,
+			L1Endpoint:            c.String(flags.L1WSEndpoint.Name),
+			L1BeaconEndpoint:      c.String(flags.L1BeaconEndpoint.Name),
+			L2Endpoint:            c.String(flags.L2WSEndpoint.Name),
+			L2CheckPoint:          l2CheckPoint,
+			L2CheckPointFallbacks: c.StringSlice(flags.CheckPointSyncFallbackURLs.Name),
+			TaikoL1Address:        common.HexToAddress(c.String(flags.TaikoL1Address.Name)),
+			TaikoL2Address:        common.HexToAddress(c.String(flags.TaikoL2Address.Name)),
+			L2EngineEndpoint:      c.String(flags.L2AuthEndpoint.Name),
+			JwtSecret:             string(jwtSecret),
+			Timeout:               timeout,
 		},
 		RetryInterval:      c.Duration(flags.BackOffRetryInterval.Name),
 		P2PSync:            p2pSync,
 		P2PSyncTimeout:     c.Duration(flags.P2PSyncTimeout.Name),
+		CheckPointBlockID:  c.Uint64(flags.CheckPointSyncBlockID.Name),
 		MaxExponent:        c.Uint64(flags.MaxExponent.Name),
 		BlobServerEndpoint: blobServerEndpoint,
 		SocialScanEndpoint: socialScanEndpoint,
+		L1EventStream:      c.Bool(flags.L1EventStream.Name),
+		L1LogCacheBlocks:   c.Uint64(flags.L1LogCacheBlocks.Name),
 	}, nil
 }
@@ -36,6 +36,8 @@ func (s *DriverTestSuite) TestNewConfigFromCliContext() {
 		s.NotEmpty(c.JwtSecret)
 		s.True(c.P2PSync)
 		s.Equal(l2CheckPoint, c.L2CheckPoint)
+		s.Equal([]string{l2CheckPoint}, c.L2CheckPointFallbacks)
+		s.Equal(uint64(0), c.CheckPointBlockID)
 		s.Nil(new(Driver).InitFromCli(context.Background(), ctx))
 
 		return err
@@ -54,9 +56,20 @@ func (s *DriverTestSuite) TestNewConfigFromCliContext() {
 		"--" + flags.RPCTimeout.Name, "5s",
 		"--" + flags.P2PSync.Name,
 		"--" + flags.CheckPointSyncURL.Name, l2CheckPoint,
+		"--" + flags.CheckPointSyncFallbackURLs.Name, l2CheckPoint,
 	}))
 }
 
+func (s *DriverTestSuite) TestNewConfigFromCliContextCheckPointBlockIDWithoutP2PSync() {
+	app := s.SetupApp()
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContext",
+		"--" + flags.JWTSecret.Name, os.Getenv("JWT_SECRET"),
+		"--" + flags.L1BeaconEndpoint.Name, l1BeaconEndpoint,
+		"--" + flags.CheckPointSyncBlockID.Name, "1",
+	}), "check point sync block ID is set, but P2P sync is disabled")
+}
+
 func (s *DriverTestSuite) TestNewConfigFromCliContextJWTError() {
 	app := s.SetupApp()
 	s.ErrorContains(app.Run([]string{
@@ -89,6 +102,8 @@ func (s *DriverTestSuite) SetupApp() *cli.App {
 		&cli.DurationFlag{Name: flags.P2PSyncTimeout.Name},
 		&cli.DurationFlag{Name: flags.RPCTimeout.Name},
 		&cli.StringFlag{Name: flags.CheckPointSyncURL.Name},
+		&cli.StringSliceFlag{Name: flags.CheckPointSyncFallbackURLs.Name},
+		&cli.Uint64Flag{Name: flags.CheckPointSyncBlockID.Name},
 	}
 	app.Action = func(ctx *cli.Context) error {
 		_, err := NewConfigFromCliContext(ctx)
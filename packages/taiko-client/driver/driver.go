@@ -23,6 +23,7 @@ import (
 
 	chainSyncer "github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/chain_syncer"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/state"
+	eventStream "github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/chain_iterator/event_stream"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
 )
 
@@ -38,6 +39,7 @@ type Driver struct {
 	rpc           *rpc.Client
 	l2ChainSyncer *chainSyncer.L2ChainSyncer
 	state         *state.State
+	eventStream   *eventStream.EventStream
 
 	l1HeadCh  chan *types.Header
 	l1HeadSub event.Subscription
@@ -66,7 +68,18 @@ func (d *Driver) InitFromConfig(ctx context.Context, cfg *Config) (err error) {
 		return err
 	}
 
-	if d.state, err = state.New(d.ctx, d.rpc); err != nil {
+	if cfg.L1EventStream {
+		if d.eventStream, err = eventStream.Shared(&eventStream.Config{
+			Client:      d.rpc.L1,
+			ChainID:     d.rpc.L1.ChainID,
+			Addresses:   []common.Address{cfg.TaikoL1Address},
+			CacheBlocks: cfg.L1LogCacheBlocks,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if d.state, err = state.New(d.ctx, d.rpc, d.eventStream); err != nil {
 		return err
 	}
 
@@ -85,6 +98,7 @@ func (d *Driver) InitFromConfig(ctx context.Context, cfg *Config) (err error) {
 		d.state,
 		cfg.P2PSync,
 		cfg.P2PSyncTimeout,
+		cfg.CheckPointBlockID,
 		cfg.MaxExponent,
 		cfg.BlobServerEndpoint,
 		cfg.SocialScanEndpoint,
@@ -112,6 +126,9 @@ func (d *Driver) Close(_ context.Context) {
 	d.l1HeadSub.Unsubscribe()
 	d.state.Close()
 	d.wg.Wait()
+	if d.eventStream != nil {
+		d.eventStream.Close()
+	}
 }
 
 // eventLoop starts the main loop of a L2 execution engine's driver.
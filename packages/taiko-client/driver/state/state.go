@@ -14,6 +14,7 @@ import (
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
+	eventStream "github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/chain_iterator/event_stream"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
 )
 
@@ -33,12 +34,16 @@ type State struct {
 	// RPC clients
 	rpc *rpc.Client
 
+	// Shared L1 event stream, nil if the protocol events are fetched through subscriptions.
+	eventStream *eventStream.EventStream
+
 	wg sync.WaitGroup
 }
 
-// New creates a new driver state instance.
-func New(ctx context.Context, rpc *rpc.Client) (*State, error) {
-	s := &State{rpc: rpc}
+// New creates a new driver state instance, the protocol events will be fetched from the given L1 event stream
+// if it's not nil.
+func New(ctx context.Context, rpc *rpc.Client, stream *eventStream.EventStream) (*State, error) {
+	s := &State{rpc: rpc, eventStream: stream}
 
 	if err := s.init(ctx); err != nil {
 		return nil, err
@@ -54,6 +59,11 @@ func (s *State) Close() {
 	s.wg.Wait()
 }
 
+// EventStream returns the shared L1 event stream, nil if it's not enabled.
+func (s *State) EventStream() *eventStream.EventStream {
+	return s.eventStream
+}
+
 // init fetches the latest status and initializes the state instance.
 func (s *State) init(ctx context.Context) error {
 	stateVars, err := s.rpc.GetProtocolStateVariables(&bind.CallOpts{Context: ctx})
@@ -106,13 +116,24 @@ func (s *State) eventLoop(ctx context.Context) {
 		blockVerifiedCh    = make(chan *bindings.TaikoL1ClientBlockVerified, 10)
 
 		// Subscriptions.
-		l1HeadSub             = rpc.SubscribeChainHead(s.rpc.L1, l1HeadCh)
-		l2HeadSub             = rpc.SubscribeChainHead(s.rpc.L2, l2HeadCh)
-		l2BlockVerifiedSub    = rpc.SubscribeBlockVerified(s.rpc.TaikoL1, blockVerifiedCh)
-		l2BlockProposedSub    = rpc.SubscribeBlockProposed(s.rpc.TaikoL1, blockProposedCh)
-		l2TransitionProvedSub = rpc.SubscribeTransitionProved(s.rpc.TaikoL1, transitionProvedCh)
+		l1HeadSub = rpc.SubscribeChainHead(s.rpc.L1, l1HeadCh)
+		l2HeadSub = rpc.SubscribeChainHead(s.rpc.L2, l2HeadCh)
+
+		l2BlockVerifiedSub    event.Subscription
+		l2BlockProposedSub    event.Subscription
+		l2TransitionProvedSub event.Subscription
 	)
 
+	if s.eventStream != nil {
+		l2BlockVerifiedSub = eventStream.SubscribeBlockVerified(s.eventStream, s.rpc.TaikoL1, blockVerifiedCh)
+		l2BlockProposedSub = eventStream.SubscribeBlockProposed(s.eventStream, s.rpc.TaikoL1, blockProposedCh)
+		l2TransitionProvedSub = eventStream.SubscribeTransitionProved(s.eventStream, s.rpc.TaikoL1, transitionProvedCh)
+	} else {
+		l2BlockVerifiedSub = rpc.SubscribeBlockVerified(s.rpc.TaikoL1, blockVerifiedCh)
+		l2BlockProposedSub = rpc.SubscribeBlockProposed(s.rpc.TaikoL1, blockProposedCh)
+		l2TransitionProvedSub = rpc.SubscribeTransitionProved(s.rpc.TaikoL1, transitionProvedCh)
+	}
+
 	defer func() {
 		l1HeadSub.Unsubscribe()
 		l2HeadSub.Unsubscribe()
@@ -22,7 +22,7 @@ type DriverStateTestSuite struct {
 func (s *DriverStateTestSuite) SetupTest() {
 	s.ClientTestSuite.SetupTest()
 	s.ctx, s.cancel = context.WithCancel(context.Background())
-	state, err := New(s.ctx, s.RPCClient)
+	state, err := New(s.ctx, s.RPCClient, nil)
 	s.Nil(err)
 	s.s = state
 }
@@ -63,7 +63,7 @@ func (s *DriverStateTestSuite) TestSubL1HeadsFeed() {
 func (s *DriverStateTestSuite) TestNewDriverContextErr() {
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
-	state, err := New(ctx, s.RPCClient)
+	state, err := New(ctx, s.RPCClient, nil)
 	s.Nil(state)
 	s.ErrorContains(err, "context canceled")
 }
@@ -26,9 +26,13 @@ var (
 	DriverL2VerifiedHeightGauge = factory.NewGauge(prometheus.GaugeOpts{Name: "driver_l2Verified_id"})
 
 	// Proposer
-	ProposerProposeEpochCounter    = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_epoch"})
-	ProposerProposedTxListsCounter = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_proposed_txLists"})
-	ProposerProposedTxsCounter     = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_proposed_txs"})
+	ProposerProposeEpochCounter        = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_epoch"})
+	ProposerProposedTxListsCounter     = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_proposed_txLists"})
+	ProposerProposedTxsCounter         = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_proposed_txs"})
+	ProposerExpectedMarginGauge        = factory.NewGauge(prometheus.GaugeOpts{Name: "proposer_expected_margin"})
+	ProposerUnprofitableSkippedCounter = factory.NewCounter(prometheus.CounterOpts{
+		Name: "proposer_unprofitable_skipped",
+	})
 
 	// Prover
 	ProverLatestVerifiedIDGauge      = factory.NewGauge(prometheus.GaugeOpts{Name: "prover_latestVerified_id"})
@@ -40,6 +44,11 @@ var (
 	ProverReceivedProposedBlockGauge = factory.NewGauge(prometheus.GaugeOpts{Name: "prover_proposed_received"})
 	ProverReceivedProvenBlockGauge   = factory.NewGauge(prometheus.GaugeOpts{Name: "prover_proven_received"})
 	ProverProvenByGuardianGauge      = factory.NewGauge(prometheus.GaugeOpts{Name: "prover_proven_by_guardian"})
+	ProverCatchUpQueuedGauge         = factory.NewGauge(prometheus.GaugeOpts{Name: "prover_catchup_queued"})
+	ProverCatchUpInFlightGauge       = factory.NewGauge(prometheus.GaugeOpts{Name: "prover_catchup_inflight"})
+	ProverBondBalanceGauge           = factory.NewGauge(prometheus.GaugeOpts{Name: "prover_bond_balance"})
+	ProverBondAlertGauge             = factory.NewGauge(prometheus.GaugeOpts{Name: "prover_bond_alerts"})
+	ProverBondTopUpCounter           = factory.NewCounter(prometheus.CounterOpts{Name: "prover_bond_topup"})
 	ProverSubmissionAcceptedCounter  = factory.NewCounter(prometheus.CounterOpts{
 		Name: "prover_proof_submission_accepted",
 	})
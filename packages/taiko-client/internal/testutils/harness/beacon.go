@@ -0,0 +1,110 @@
+package harness
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/blob"
+)
+
+// Beacon is a mock L1 beacon node, which only serves the blob sidecars added by tests, and the
+// genesis and config spec endpoints required by the beacon client.
+type Beacon struct {
+	genesisTime    uint64
+	secondsPerSlot uint64
+
+	mu       sync.RWMutex
+	sidecars map[uint64][]*blob.Sidecar
+
+	server *httptest.Server
+}
+
+// NewBeacon creates and starts a new mock beacon node.
+func NewBeacon(genesisTime uint64, secondsPerSlot uint64) *Beacon {
+	b := &Beacon{
+		genesisTime:    genesisTime,
+		secondsPerSlot: secondsPerSlot,
+		sidecars:       make(map[uint64][]*blob.Sidecar),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/genesis", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, map[string]interface{}{
+			"data": map[string]string{"genesis_time": strconv.FormatUint(b.genesisTime, 10)},
+		})
+	})
+	mux.HandleFunc("/eth/v1/config/spec", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, map[string]interface{}{
+			"data": map[string]string{"SECONDS_PER_SLOT": strconv.FormatUint(b.secondsPerSlot, 10)},
+		})
+	})
+	mux.HandleFunc("/eth/v1/beacon/blob_sidecars/", func(w http.ResponseWriter, r *http.Request) {
+		slot, err := strconv.ParseUint(r.URL.Path[len("/eth/v1/beacon/blob_sidecars/"):], 10, 64)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		b.mu.RLock()
+		defer b.mu.RUnlock()
+		sidecars := b.sidecars[slot]
+		if sidecars == nil {
+			sidecars = []*blob.Sidecar{}
+		}
+		writeJSON(w, &blob.SidecarsResponse{Data: sidecars})
+	})
+	b.server = httptest.NewServer(mux)
+
+	return b
+}
+
+// Endpoint returns the HTTP endpoint of the beacon node.
+func (b *Beacon) Endpoint() string {
+	return b.server.URL
+}
+
+// AddBlobs adds the sidecars of the given blobs to the slot of the given L1 block timestamp.
+func (b *Beacon) AddBlobs(timestamp uint64, blobs []kzg4844.Blob) error {
+	slot := (timestamp - b.genesisTime) / b.secondsPerSlot
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for i := range blobs {
+		commitment, err := kzg4844.BlobToCommitment(blobs[i])
+		if err != nil {
+			return err
+		}
+		proof, err := kzg4844.ComputeBlobProof(blobs[i], commitment)
+		if err != nil {
+			return err
+		}
+
+		b.sidecars[slot] = append(b.sidecars[slot], &blob.Sidecar{
+			Index:         strconv.Itoa(len(b.sidecars[slot])),
+			Blob:          hexutil.Encode(blobs[i][:]),
+			KzgCommitment: hexutil.Encode(commitment[:]),
+			KzgProof:      hexutil.Encode(proof[:]),
+		})
+	}
+
+	return nil
+}
+
+// Close stops the server.
+func (b *Beacon) Close() {
+	b.server.Close()
+}
+
+// writeJSON writes the given value as a JSON response.
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
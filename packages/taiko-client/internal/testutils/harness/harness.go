@@ -0,0 +1,134 @@
+// Package harness provides in-process stand-ins for the L1 node, the L1 beacon node and the L2 execution
+// engine, so that the RPC clients of the package rpc can be tested hermetically with `go test`, without the
+// docker-compose nodes used by the testutils package. The protocol contracts are only stubbed, and the L2
+// engine doesn't execute transactions, so it can not run the driver, proposer or prover end to end: proposing,
+// syncing and proving a block need the compiled TaikoL1 / TaikoL2 contracts, which are not available here.
+package harness
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+)
+
+// Default values of the harness configs.
+const (
+	DefaultSecondsPerSlot = 12
+	DefaultL2GasLimit     = 240_000_000
+)
+
+var (
+	// Addresses the protocol contract stubs are deployed at.
+	TaikoL1Address = common.HexToAddress("0x0000000000000000000000000000000000010001")
+	TaikoL2Address = common.HexToAddress("0x0000000000000000000000000000000000010002")
+
+	DefaultL2ChainID = big.NewInt(167001)
+)
+
+// Config contains the configurations of a harness.
+type Config struct {
+	// L1Alloc is the genesis allocation of the L1 node, e.g. the balances of the test accounts.
+	L1Alloc types.GenesisAlloc
+	// TaikoL1 is the stub contract deployed at TaikoL1Address on L1, nil means no contract.
+	TaikoL1 *Stub
+	// TaikoL2 is the stub contract serving the calls to TaikoL2Address on L2, nil means no contract.
+	TaikoL2 *Stub
+	// L2ChainID is the chain ID of the L2 execution engine, DefaultL2ChainID will be used if it's nil.
+	L2ChainID *big.Int
+	// SecondsPerSlot is the seconds per slot of the beacon node.
+	SecondsPerSlot uint64
+}
+
+// Harness bundles the in-process L1 node, L1 beacon node and L2 execution engine.
+type Harness struct {
+	L1     *L1
+	Beacon *Beacon
+	L2     *L2
+}
+
+// New creates and starts a new harness with the given configurations.
+func New(cfg *Config) (*Harness, error) {
+	alloc := types.GenesisAlloc{}
+	for address, account := range cfg.L1Alloc {
+		alloc[address] = account
+	}
+	if cfg.TaikoL1 != nil {
+		alloc[TaikoL1Address] = types.Account{Code: cfg.TaikoL1.Code(), Balance: common.Big0}
+	}
+
+	l1, err := NewL1(alloc)
+	if err != nil {
+		return nil, err
+	}
+
+	secondsPerSlot := cfg.SecondsPerSlot
+	if secondsPerSlot == 0 {
+		secondsPerSlot = DefaultSecondsPerSlot
+	}
+	beacon := NewBeacon(l1.Head().Time, secondsPerSlot)
+
+	l2ChainID := cfg.L2ChainID
+	if l2ChainID == nil {
+		l2ChainID = DefaultL2ChainID
+	}
+	l2, err := NewL2(l2ChainID, &types.Header{
+		UncleHash:       types.EmptyUncleHash,
+		Root:            types.EmptyRootHash,
+		TxHash:          types.EmptyTxsHash,
+		ReceiptHash:     types.EmptyReceiptsHash,
+		Difficulty:      common.Big0,
+		Number:          common.Big0,
+		GasLimit:        DefaultL2GasLimit,
+		BaseFee:         big.NewInt(10_000_000),
+		WithdrawalsHash: &types.EmptyWithdrawalsHash,
+	})
+	if err != nil {
+		beacon.Close()
+		return nil, errors.Join(err, l1.Close())
+	}
+	if cfg.TaikoL2 != nil {
+		l2.SetStub(TaikoL2Address, cfg.TaikoL2)
+	}
+
+	return &Harness{L1: l1, Beacon: beacon, L2: l2}, nil
+}
+
+// ClientConfig returns the configurations to connect the RPC clients to the harness.
+func (h *Harness) ClientConfig() *rpc.ClientConfig {
+	return &rpc.ClientConfig{
+		L1Endpoint:       h.L1.Endpoint(),
+		L2Endpoint:       h.L2.Endpoint(),
+		L1BeaconEndpoint: h.Beacon.Endpoint(),
+		TaikoL1Address:   TaikoL1Address,
+		TaikoL2Address:   TaikoL2Address,
+		L2EngineEndpoint: h.L2.AuthEndpoint(),
+		JwtSecret:        h.L2.JWTSecret(),
+	}
+}
+
+// Close stops all nodes in the harness.
+func (h *Harness) Close() error {
+	h.L2.Close()
+	h.Beacon.Close()
+	return h.L1.Close()
+}
+
+// NewTaikoL1Stub creates a TaikoL1 stub contract, which returns the given protocol configs and state variables.
+func NewTaikoL1Stub(
+	config bindings.TaikoDataConfig,
+	slotA bindings.TaikoDataSlotA,
+	slotB bindings.TaikoDataSlotB,
+) (*Stub, error) {
+	stub, err := NewStub().Returns(encoding.TaikoL1ABI.Methods["getConfig"], config)
+	if err != nil {
+		return nil, err
+	}
+
+	return stub.Returns(encoding.TaikoL1ABI.Methods["getStateVariables"], slotA, slotB)
+}
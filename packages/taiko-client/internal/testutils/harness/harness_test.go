@@ -0,0 +1,204 @@
+package harness
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+)
+
+func newTestHarness(t *testing.T) (*Harness, *rpc.Client, *ecdsa.PrivateKey) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	taikoL1, err := NewTaikoL1Stub(
+		bindings.TaikoDataConfig{ChainId: DefaultL2ChainID.Uint64(), BlockMaxProposals: 100, LivenessBond: common.Big1},
+		bindings.TaikoDataSlotA{GenesisHeight: 0},
+		bindings.TaikoDataSlotB{NumBlocks: 1},
+	)
+	require.Nil(t, err)
+
+	h, err := New(&Config{
+		L1Alloc: types.GenesisAlloc{crypto.PubkeyToAddress(key.PublicKey): {Balance: big.NewInt(1e18)}},
+		TaikoL1: taikoL1,
+	})
+	require.Nil(t, err)
+	t.Cleanup(func() { require.Nil(t, h.Close()) })
+
+	cli, err := rpc.NewClient(context.Background(), h.ClientConfig())
+	require.Nil(t, err)
+
+	return h, cli, key
+}
+
+func TestHarnessProtocolStub(t *testing.T) {
+	_, cli, _ := newTestHarness(t)
+
+	config, err := cli.TaikoL1.GetConfig(nil)
+	require.Nil(t, err)
+	require.Equal(t, uint64(100), config.BlockMaxProposals)
+
+	stateVars, err := cli.GetProtocolStateVariables(nil)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), stateVars.B.NumBlocks)
+
+	// Unknown methods revert.
+	_, err = cli.TaikoL1.GetBlock(nil, 1)
+	require.NotNil(t, err)
+}
+
+func TestHarnessProtocolEvents(t *testing.T) {
+	h, cli, key := newTestHarness(t)
+
+	ch := make(chan *bindings.TaikoL1ClientBlockProposed, 1)
+	sub := rpc.SubscribeBlockProposed(cli.TaikoL1, ch)
+	defer sub.Unsubscribe()
+	// Wait for the subscription to be established.
+	time.Sleep(100 * time.Millisecond)
+
+	prover := common.HexToAddress("0x1234")
+	data, err := EmitEventCalldata(
+		encoding.TaikoL1ABI.Events["BlockProposed"],
+		big.NewInt(10),
+		prover,
+		big.NewInt(1),
+		bindings.TaikoDataBlockMetadata{Id: 10, Coinbase: prover},
+		[]bindings.TaikoDataEthDeposit{},
+	)
+	require.Nil(t, err)
+
+	receipt, err := h.L1.SendTx(context.Background(), key, TaikoL1Address, common.Big0, data)
+	require.Nil(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	require.Len(t, receipt.Logs, 1)
+
+	select {
+	case e := <-ch:
+		require.Equal(t, uint64(10), e.BlockId.Uint64())
+		require.Equal(t, prover, e.AssignedProver)
+		require.Equal(t, uint64(10), e.Meta.Id)
+	case <-time.After(5 * time.Second):
+		t.Fatal("BlockProposed event not received")
+	}
+
+	end := receipt.BlockNumber.Uint64()
+	iter, err := cli.TaikoL1.FilterBlockProposed(&bind.FilterOpts{Start: 0, End: &end}, nil, nil)
+	require.Nil(t, err)
+	require.True(t, iter.Next())
+	require.Equal(t, uint64(10), iter.Event.BlockId.Uint64())
+	require.False(t, iter.Next())
+}
+
+func TestHarnessEngine(t *testing.T) {
+	h, cli, _ := newTestHarness(t)
+	ctx := context.Background()
+
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(DefaultL2ChainID), &types.DynamicFeeTx{
+		ChainID:   DefaultL2ChainID,
+		Gas:       21_000,
+		GasFeeCap: big.NewInt(1e9),
+		To:        &common.Address{},
+	})
+	require.Nil(t, err)
+	require.Nil(t, cli.L2.SendTransaction(ctx, tx))
+
+	txLists, err := cli.L2Engine.TxPoolContent(ctx, common.Address{}, common.Big1, 1e6, 1e5, nil, 1)
+	require.Nil(t, err)
+	require.Len(t, txLists, 1)
+	txList, err := rlp.EncodeToBytes(txLists[0].TxList)
+	require.Nil(t, err)
+
+	parent, err := cli.L2.HeaderByNumber(ctx, nil)
+	require.Nil(t, err)
+	l1Head := h.L1.Head()
+
+	fc := &engine.ForkchoiceStateV1{HeadBlockHash: parent.Hash()}
+	resp, err := cli.L2Engine.ForkchoiceUpdate(ctx, fc, &engine.PayloadAttributes{
+		Timestamp:     parent.Time + 12,
+		BaseFeePerGas: parent.BaseFee,
+		BlockMetadata: &engine.BlockMetadata{
+			Beneficiary:    common.HexToAddress("0x01"),
+			GasLimit:       DefaultL2GasLimit,
+			Timestamp:      parent.Time + 12,
+			TxList:         txList,
+			HighestBlockID: common.Big1,
+			ExtraData:      []byte{},
+		},
+		L1Origin: &rawdb.L1Origin{
+			BlockID:       common.Big1,
+			L1BlockHeight: l1Head.Number,
+			L1BlockHash:   l1Head.Hash(),
+		},
+	})
+	require.Nil(t, err)
+	require.Equal(t, engine.VALID, resp.PayloadStatus.Status)
+	require.NotNil(t, resp.PayloadID)
+
+	payload, err := cli.L2Engine.GetPayload(ctx, resp.PayloadID)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), payload.Number)
+
+	status, err := cli.L2Engine.NewPayload(ctx, payload)
+	require.Nil(t, err)
+	require.Equal(t, engine.VALID, status.Status)
+
+	fc.HeadBlockHash = payload.BlockHash
+	resp, err = cli.L2Engine.ForkchoiceUpdate(ctx, fc, nil)
+	require.Nil(t, err)
+	require.Equal(t, engine.VALID, resp.PayloadStatus.Status)
+
+	head, err := cli.L2.BlockByNumber(ctx, nil)
+	require.Nil(t, err)
+	require.Equal(t, payload.BlockHash, head.Hash())
+	require.Equal(t, tx.Hash(), head.Transactions()[0].Hash())
+
+	l1Origin, err := cli.L2.HeadL1Origin(ctx)
+	require.Nil(t, err)
+	require.Equal(t, payload.BlockHash, l1Origin.L2BlockHash)
+	require.Equal(t, l1Head.Hash(), l1Origin.L1BlockHash)
+
+	nonce, err := cli.L2.NonceAt(ctx, crypto.PubkeyToAddress(key.PublicKey), nil)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), nonce)
+
+	txLists, err = cli.L2Engine.TxPoolContent(ctx, common.Address{}, common.Big1, 1e6, 1e5, nil, 1)
+	require.Nil(t, err)
+	require.Empty(t, txLists)
+}
+
+func TestHarnessBeacon(t *testing.T) {
+	h, _, _ := newTestHarness(t)
+
+	var blob kzg4844.Blob
+	copy(blob[:], []byte("harness"))
+	timestamp := h.L1.Head().Time + 24
+	require.Nil(t, h.Beacon.AddBlobs(timestamp, []kzg4844.Blob{blob}))
+
+	cli, err := rpc.NewBeaconClient(h.Beacon.Endpoint(), time.Second)
+	require.Nil(t, err)
+
+	sidecars, err := cli.GetBlobs(context.Background(), timestamp)
+	require.Nil(t, err)
+	require.Len(t, sidecars, 1)
+
+	sidecars, err = cli.GetBlobs(context.Background(), timestamp+DefaultSecondsPerSlot)
+	require.Nil(t, err)
+	require.Empty(t, sidecars)
+}
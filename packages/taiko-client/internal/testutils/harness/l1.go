@@ -0,0 +1,165 @@
+package harness
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth"
+	"github.com/ethereum/go-ethereum/eth/catalyst"
+	"github.com/ethereum/go-ethereum/eth/downloader"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// L1 is an in-process L1 node, which runs a real EVM on a dev chain, whose blocks are only sealed
+// on demand, and serves the standard JSON-RPC APIs on a local WebSocket endpoint.
+type L1 struct {
+	stack  *node.Node
+	eth    *eth.Ethereum
+	beacon *catalyst.SimulatedBeacon
+}
+
+// NewL1 creates and starts a new in-process L1 node with the given genesis allocation.
+func NewL1(alloc types.GenesisAlloc) (*L1, error) {
+	stack, err := node.New(&node.Config{
+		P2P:       p2p.Config{NoDiscovery: true, MaxPeers: 0},
+		WSHost:    "127.0.0.1",
+		WSPort:    0,
+		WSModules: []string{"eth", "net", "web3", "debug", "txpool"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ethConf := ethconfig.Defaults
+	ethConf.Genesis = &core.Genesis{
+		Config:   params.AllDevChainProtocolChanges,
+		GasLimit: ethconfig.Defaults.Miner.GasCeil,
+		Alloc:    alloc,
+	}
+	ethConf.SyncMode = downloader.FullSync
+	ethConf.TxPool.NoLocals = true
+
+	backend, err := eth.New(stack, &ethConf)
+	if err != nil {
+		return nil, err
+	}
+	stack.RegisterAPIs([]rpc.API{{
+		Namespace: "eth",
+		Service:   filters.NewFilterAPI(filters.NewFilterSystem(backend.APIBackend, filters.Config{}), false),
+	}})
+	if err := stack.Start(); err != nil {
+		return nil, err
+	}
+
+	beacon, err := catalyst.NewSimulatedBeacon(0, backend)
+	if err != nil {
+		stack.Close()
+		return nil, err
+	}
+
+	return &L1{stack: stack, eth: backend, beacon: beacon}, nil
+}
+
+// Endpoint returns the WebSocket endpoint of the node.
+func (l *L1) Endpoint() string {
+	return l.stack.WSEndpoint()
+}
+
+// ChainID returns the chain ID of the node.
+func (l *L1) ChainID() *big.Int {
+	return l.eth.BlockChain().Config().ChainID
+}
+
+// Head returns the header of the current L1 head.
+func (l *L1) Head() *types.Header {
+	return l.eth.BlockChain().CurrentBlock()
+}
+
+// Commit seals all pending transactions into a new block, and returns its hash.
+func (l *L1) Commit() common.Hash {
+	return l.beacon.Commit()
+}
+
+// Mine seals the given number of new blocks.
+func (l *L1) Mine(blocks int) {
+	for i := 0; i < blocks; i++ {
+		l.Commit()
+	}
+}
+
+// Fork rewinds the chain to the given block, the blocks sealed afterward will build a new canonical
+// chain on top of it, which can be used to simulate L1 reorgs.
+func (l *L1) Fork(parentHash common.Hash) error {
+	return l.beacon.Fork(parentHash)
+}
+
+// AdjustTime seals a new empty block, whose timestamp is the given duration after its parent.
+func (l *L1) AdjustTime(adjustment time.Duration) error {
+	return l.beacon.AdjustTime(adjustment)
+}
+
+// SendTx signs and sends a dynamic fee transaction calling the given address, and seals it into a
+// new block, returns the receipt.
+func (l *L1) SendTx(
+	ctx context.Context,
+	key *ecdsa.PrivateKey,
+	to common.Address,
+	value *big.Int,
+	data []byte,
+) (*types.Receipt, error) {
+	var (
+		from    = crypto.PubkeyToAddress(key.PublicKey)
+		config  = l.eth.BlockChain().Config()
+		head    = l.Head()
+		nonce   = l.eth.TxPool().Nonce(from)
+		tipCap  = big.NewInt(params.GWei)
+		feeCap  = new(big.Int).Add(new(big.Int).Mul(head.BaseFee, common.Big2), tipCap)
+		gasCeil = ethconfig.Defaults.Miner.GasCeil
+	)
+
+	tx, err := types.SignNewTx(key, types.LatestSigner(config), &types.DynamicFeeTx{
+		ChainID:   config.ChainID,
+		Nonce:     nonce,
+		GasTipCap: tipCap,
+		GasFeeCap: feeCap,
+		Gas:       gasCeil / 2,
+		To:        &to,
+		Value:     value,
+		Data:      data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := l.eth.APIBackend.SendTx(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	blockHash := l.Commit()
+	for _, receipt := range l.eth.BlockChain().GetReceiptsByHash(blockHash) {
+		if receipt.TxHash == tx.Hash() {
+			return receipt, nil
+		}
+	}
+
+	return nil, fmt.Errorf("transaction %s not sealed", tx.Hash())
+}
+
+// Close stops the node.
+func (l *L1) Close() error {
+	if err := l.beacon.Stop(); err != nil {
+		return err
+	}
+	return l.stack.Close()
+}
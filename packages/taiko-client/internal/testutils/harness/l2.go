@@ -0,0 +1,580 @@
+package harness
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/miner"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// L2 is a mock L2 execution engine. It doesn't execute any transaction, the blocks are assembled from the
+// payload attributes directly, with the parent state root. It serves the Engine API and the taikoAuth
+// namespace on a JWT authenticated HTTP endpoint, and a subset of the eth, net and taiko namespaces on
+// a public endpoint, which are used by the rpc clients.
+type L2 struct {
+	chainID   *big.Int
+	jwtSecret [32]byte
+	signer    types.Signer
+
+	mu           sync.RWMutex
+	canonical    []*types.Block
+	blocks       map[common.Hash]*types.Block
+	payloads     map[engine.PayloadID]*payload
+	l1Origins    map[uint64]*rawdb.L1Origin
+	headL1Origin *rawdb.L1Origin
+	txPool       []*types.Transaction
+	stubs        map[common.Address]*Stub
+	headsFeed    event.Feed
+
+	server     *httptest.Server
+	authServer *httptest.Server
+}
+
+// payload is a block built by the engine, which has not been inserted yet.
+type payload struct {
+	block    *types.Block
+	l1Origin *rawdb.L1Origin
+}
+
+// NewL2 creates and starts a new mock L2 execution engine, with the given chain ID and genesis block.
+func NewL2(chainID *big.Int, genesis *types.Header) (*L2, error) {
+	l2 := &L2{
+		chainID:   chainID,
+		signer:    types.LatestSignerForChainID(chainID),
+		blocks:    make(map[common.Hash]*types.Block),
+		payloads:  make(map[engine.PayloadID]*payload),
+		l1Origins: make(map[uint64]*rawdb.L1Origin),
+		stubs:     make(map[common.Address]*Stub),
+	}
+	if _, err := rand.Read(l2.jwtSecret[:]); err != nil {
+		return nil, err
+	}
+
+	block := types.NewBlockWithHeader(genesis).WithWithdrawals([]*types.Withdrawal{})
+	l2.canonical = []*types.Block{block}
+	l2.blocks[block.Hash()] = block
+
+	server := rpc.NewServer()
+	for namespace, service := range map[string]interface{}{
+		"eth":   &l2EthAPI{l2},
+		"net":   &l2NetAPI{},
+		"taiko": &l2TaikoAPI{l2},
+	} {
+		if err := server.RegisterName(namespace, service); err != nil {
+			return nil, err
+		}
+	}
+	authServer := rpc.NewServer()
+	for namespace, service := range map[string]interface{}{
+		"engine":    &l2EngineAPI{l2},
+		"taikoAuth": &l2TaikoAuthAPI{l2},
+	} {
+		if err := authServer.RegisterName(namespace, service); err != nil {
+			return nil, err
+		}
+	}
+
+	wsHandler := server.WebsocketHandler([]string{"*"})
+	l2.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			wsHandler.ServeHTTP(w, r)
+			return
+		}
+		server.ServeHTTP(w, r)
+	}))
+	l2.authServer = httptest.NewServer(node.NewHTTPHandlerStack(authServer, nil, []string{"*"}, l2.jwtSecret[:]))
+
+	return l2, nil
+}
+
+// Endpoint returns the public WebSocket endpoint.
+func (l *L2) Endpoint() string {
+	return "ws" + strings.TrimPrefix(l.server.URL, "http")
+}
+
+// AuthEndpoint returns the JWT authenticated HTTP endpoint.
+func (l *L2) AuthEndpoint() string {
+	return l.authServer.URL
+}
+
+// JWTSecret returns the raw JWT secret of the authenticated endpoint, in the format of
+// rpc.ClientConfig.JwtSecret.
+func (l *L2) JWTSecret() string {
+	return string(l.jwtSecret[:])
+}
+
+// SetStub makes the eth_call requests to the given address served by the given stub contract.
+func (l *L2) SetStub(address common.Address, stub *Stub) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.stubs[address] = stub
+}
+
+// Head returns the current canonical head block.
+func (l *L2) Head() *types.Block {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.canonical[len(l.canonical)-1]
+}
+
+// Close stops the servers.
+func (l *L2) Close() {
+	l.server.Close()
+	l.authServer.Close()
+}
+
+// blockByNumber returns the canonical block of the given number, nil if not found.
+func (l *L2) blockByNumber(number rpc.BlockNumber) *types.Block {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if number < 0 {
+		return l.canonical[len(l.canonical)-1]
+	}
+	if int64(number) >= int64(len(l.canonical)) {
+		return nil
+	}
+	return l.canonical[number]
+}
+
+// blockByHash returns the block of the given hash, nil if not found.
+func (l *L2) blockByHash(hash common.Hash) *types.Block {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.blocks[hash]
+}
+
+// buildPayload assembles a new block on top of the given parent, with the given attributes.
+func (l *L2) buildPayload(parent *types.Block, attrs *engine.PayloadAttributes) (engine.PayloadID, error) {
+	if attrs.BlockMetadata == nil {
+		return engine.PayloadID{}, errors.New("missing block metadata")
+	}
+
+	var txs types.Transactions
+	if len(attrs.BlockMetadata.TxList) != 0 {
+		if err := rlp.DecodeBytes(attrs.BlockMetadata.TxList, &txs); err != nil {
+			return engine.PayloadID{}, fmt.Errorf("invalid transactions list: %w", err)
+		}
+	}
+
+	header := &types.Header{
+		ParentHash:      parent.Hash(),
+		UncleHash:       types.EmptyUncleHash,
+		Coinbase:        attrs.BlockMetadata.Beneficiary,
+		Root:            parent.Root(),
+		TxHash:          types.DeriveSha(txs, trie.NewStackTrie(nil)),
+		ReceiptHash:     types.EmptyReceiptsHash,
+		Difficulty:      common.Big0,
+		Number:          new(big.Int).Add(parent.Number(), common.Big1),
+		GasLimit:        attrs.BlockMetadata.GasLimit,
+		Time:            attrs.Timestamp,
+		Extra:           attrs.BlockMetadata.ExtraData,
+		MixDigest:       attrs.Random,
+		BaseFee:         attrs.BaseFeePerGas,
+		WithdrawalsHash: &types.EmptyWithdrawalsHash,
+	}
+	block := types.NewBlockWithHeader(header).WithBody(txs, nil).WithWithdrawals([]*types.Withdrawal{})
+
+	var id engine.PayloadID
+	copy(id[:], block.Hash().Bytes())
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.payloads[id] = &payload{block: block, l1Origin: attrs.L1Origin}
+
+	return id, nil
+}
+
+// setHead makes the block of the given hash the canonical head, and writes its L1 origin if it was
+// built by the engine.
+func (l *L2) setHead(hash common.Hash) error {
+	l.mu.Lock()
+
+	block, ok := l.blocks[hash]
+	if !ok {
+		l.mu.Unlock()
+		return fmt.Errorf("unknown block %s", hash)
+	}
+	if l.canonical[len(l.canonical)-1].Hash() == hash {
+		l.mu.Unlock()
+		return nil
+	}
+
+	// Rebuild the canonical chain back from the new head.
+	chain := []*types.Block{block}
+	for parent := block; parent.NumberU64() > 0; {
+		if parent = l.blocks[parent.ParentHash()]; parent == nil {
+			l.mu.Unlock()
+			return fmt.Errorf("missing ancestor of block %s", hash)
+		}
+		chain = append(chain, parent)
+	}
+	l.canonical = l.canonical[:0]
+	for i := len(chain) - 1; i >= 0; i-- {
+		l.canonical = append(l.canonical, chain[i])
+	}
+
+	for _, p := range l.payloads {
+		if p.block.Hash() == hash && p.l1Origin != nil {
+			l1Origin := *p.l1Origin
+			l1Origin.L2BlockHash = hash
+			l.l1Origins[block.NumberU64()] = &l1Origin
+			l.headL1Origin = &l1Origin
+		}
+	}
+
+	// Drop the pool transactions included in the new head.
+	pool := l.txPool[:0]
+	for _, tx := range l.txPool {
+		if block.Transaction(tx.Hash()) == nil {
+			pool = append(pool, tx)
+		}
+	}
+	l.txPool = pool
+	l.mu.Unlock()
+
+	l.headsFeed.Send(block.Header())
+
+	return nil
+}
+
+// l2EngineAPI serves the engine namespace.
+type l2EngineAPI struct{ l2 *L2 }
+
+// ForkchoiceUpdatedV2 sets the canonical head, and starts building a new payload if the attributes are given.
+func (api *l2EngineAPI) ForkchoiceUpdatedV2(
+	fc engine.ForkchoiceStateV1,
+	attrs *engine.PayloadAttributes,
+) (engine.ForkChoiceResponse, error) {
+	head := api.l2.blockByHash(fc.HeadBlockHash)
+	if head == nil {
+		return engine.ForkChoiceResponse{PayloadStatus: engine.PayloadStatusV1{Status: engine.SYNCING}}, nil
+	}
+	if err := api.l2.setHead(head.Hash()); err != nil {
+		return engine.STATUS_INVALID, err
+	}
+
+	hash := head.Hash()
+	resp := engine.ForkChoiceResponse{
+		PayloadStatus: engine.PayloadStatusV1{Status: engine.VALID, LatestValidHash: &hash},
+	}
+	if attrs != nil {
+		id, err := api.l2.buildPayload(head, attrs)
+		if err != nil {
+			return engine.STATUS_INVALID, engine.InvalidPayloadAttributes.With(err)
+		}
+		resp.PayloadID = &id
+	}
+
+	return resp, nil
+}
+
+// GetPayloadV2 returns the payload of the given ID.
+func (api *l2EngineAPI) GetPayloadV2(id engine.PayloadID) (*engine.ExecutionPayloadEnvelope, error) {
+	api.l2.mu.RLock()
+	defer api.l2.mu.RUnlock()
+
+	p, ok := api.l2.payloads[id]
+	if !ok {
+		return nil, engine.UnknownPayload
+	}
+
+	return engine.BlockToExecutableData(p.block, common.Big0, nil), nil
+}
+
+// NewPayloadV2 inserts the given payload, without changing the canonical head.
+func (api *l2EngineAPI) NewPayloadV2(params engine.ExecutableData) (engine.PayloadStatusV1, error) {
+	block, err := engine.ExecutableDataToBlock(params, nil, nil)
+	if err != nil {
+		msg := err.Error()
+		return engine.PayloadStatusV1{Status: engine.INVALID, ValidationError: &msg}, nil
+	}
+
+	api.l2.mu.Lock()
+	defer api.l2.mu.Unlock()
+
+	if _, ok := api.l2.blocks[block.ParentHash()]; !ok {
+		return engine.PayloadStatusV1{Status: engine.SYNCING}, nil
+	}
+	api.l2.blocks[block.Hash()] = block
+
+	hash := block.Hash()
+	return engine.PayloadStatusV1{Status: engine.VALID, LatestValidHash: &hash}, nil
+}
+
+// ExchangeTransitionConfigurationV1 echoes the given transition configurations.
+func (api *l2EngineAPI) ExchangeTransitionConfigurationV1(
+	config engine.TransitionConfigurationV1,
+) (*engine.TransitionConfigurationV1, error) {
+	return &config, nil
+}
+
+// l2TaikoAuthAPI serves the taikoAuth namespace.
+type l2TaikoAuthAPI struct{ l2 *L2 }
+
+// TxPoolContent returns all the pending transactions in a single list.
+func (api *l2TaikoAuthAPI) TxPoolContent(
+	_ common.Address,
+	_ *big.Int,
+	_ uint64,
+	_ uint64,
+	_ []string,
+	_ uint64,
+) ([]*miner.PreBuiltTxList, error) {
+	api.l2.mu.RLock()
+	defer api.l2.mu.RUnlock()
+
+	if len(api.l2.txPool) == 0 {
+		return []*miner.PreBuiltTxList{}, nil
+	}
+
+	var gasUsed uint64
+	for _, tx := range api.l2.txPool {
+		gasUsed += tx.Gas()
+	}
+	txList := make(types.Transactions, len(api.l2.txPool))
+	copy(txList, api.l2.txPool)
+	b, err := rlp.EncodeToBytes(txList)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*miner.PreBuiltTxList{{TxList: txList, EstimatedGasUsed: gasUsed, BytesLength: uint64(len(b))}}, nil
+}
+
+// l2EthAPI serves the eth namespace.
+type l2EthAPI struct{ l2 *L2 }
+
+// ChainId returns the chain ID.
+func (api *l2EthAPI) ChainId() *hexutil.Big { // nolint: revive,stylecheck
+	return (*hexutil.Big)(api.l2.chainID)
+}
+
+// BlockNumber returns the number of the canonical head.
+func (api *l2EthAPI) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(api.l2.Head().NumberU64())
+}
+
+// Syncing always returns false, since the mock engine never syncs.
+func (api *l2EthAPI) Syncing() (interface{}, error) {
+	return false, nil
+}
+
+// GetBlockByNumber returns the canonical block of the given number.
+func (api *l2EthAPI) GetBlockByNumber(number rpc.BlockNumber, fullTx bool) (map[string]interface{}, error) {
+	block := api.l2.blockByNumber(number)
+	if block == nil {
+		return nil, nil
+	}
+	return marshalBlock(block, fullTx, api.l2.signer)
+}
+
+// GetBlockByHash returns the block of the given hash.
+func (api *l2EthAPI) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]interface{}, error) {
+	block := api.l2.blockByHash(hash)
+	if block == nil {
+		return nil, nil
+	}
+	return marshalBlock(block, fullTx, api.l2.signer)
+}
+
+// GetTransactionCount returns the number of transactions sent by the given account in the canonical chain.
+func (api *l2EthAPI) GetTransactionCount(address common.Address, _ rpc.BlockNumberOrHash) (hexutil.Uint64, error) {
+	api.l2.mu.RLock()
+	defer api.l2.mu.RUnlock()
+
+	var nonce uint64
+	for _, block := range api.l2.canonical {
+		for _, tx := range block.Transactions() {
+			if from, err := types.Sender(api.l2.signer, tx); err == nil && from == address {
+				nonce++
+			}
+		}
+	}
+
+	return hexutil.Uint64(nonce), nil
+}
+
+// SendRawTransaction adds the given signed transaction to the pool.
+func (api *l2EthAPI) SendRawTransaction(input hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return common.Hash{}, err
+	}
+	if _, err := types.Sender(api.l2.signer, tx); err != nil {
+		return common.Hash{}, err
+	}
+
+	api.l2.mu.Lock()
+	defer api.l2.mu.Unlock()
+	api.l2.txPool = append(api.l2.txPool, tx)
+
+	return tx.Hash(), nil
+}
+
+// callArgs are the arguments of eth_call used by the mock engine.
+type callArgs struct {
+	To    *common.Address `json:"to"`
+	Data  *hexutil.Bytes  `json:"data"`
+	Input *hexutil.Bytes  `json:"input"`
+}
+
+// Call serves the call with the stub contract of the target address.
+func (api *l2EthAPI) Call(args callArgs, _ *rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	if args.To == nil {
+		return nil, errors.New("contract creation is not supported")
+	}
+
+	api.l2.mu.RLock()
+	stub, ok := api.l2.stubs[*args.To]
+	api.l2.mu.RUnlock()
+	if !ok {
+		return hexutil.Bytes{}, nil
+	}
+
+	data := args.Input
+	if data == nil {
+		data = args.Data
+	}
+	if data == nil {
+		data = &hexutil.Bytes{}
+	}
+
+	return stub.Call(*data)
+}
+
+// NewHeads sends a notification each time a new block becomes the canonical head.
+func (api *l2EthAPI) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+
+	sub := notifier.CreateSubscription()
+	go func() {
+		headers := make(chan *types.Header, 16)
+		headersSub := api.l2.headsFeed.Subscribe(headers)
+		defer headersSub.Unsubscribe()
+
+		for {
+			select {
+			case h := <-headers:
+				if err := notifier.Notify(sub.ID, h); err != nil {
+					return
+				}
+			case <-sub.Err():
+				return
+			}
+		}
+	}()
+
+	return sub, nil
+}
+
+// l2NetAPI serves the net namespace.
+type l2NetAPI struct{}
+
+// PeerCount always returns zero, since the mock engine has no peer.
+func (api *l2NetAPI) PeerCount() hexutil.Uint {
+	return 0
+}
+
+// l2TaikoAPI serves the taiko namespace.
+type l2TaikoAPI struct{ l2 *L2 }
+
+// HeadL1Origin returns the L1 origin of the latest L2 block built by the engine.
+func (api *l2TaikoAPI) HeadL1Origin() (*rawdb.L1Origin, error) {
+	api.l2.mu.RLock()
+	defer api.l2.mu.RUnlock()
+
+	if api.l2.headL1Origin == nil {
+		return nil, ethereum.NotFound
+	}
+	return api.l2.headL1Origin, nil
+}
+
+// L1OriginByID returns the L1 origin of the given L2 block.
+func (api *l2TaikoAPI) L1OriginByID(blockID *hexutil.Big) (*rawdb.L1Origin, error) {
+	api.l2.mu.RLock()
+	defer api.l2.mu.RUnlock()
+
+	l1Origin, ok := api.l2.l1Origins[blockID.ToInt().Uint64()]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+	return l1Origin, nil
+}
+
+// GetSyncMode always returns full.
+func (api *l2TaikoAPI) GetSyncMode() string {
+	return "full"
+}
+
+// marshalBlock converts the given block to the JSON-RPC representation.
+func marshalBlock(block *types.Block, fullTx bool, signer types.Signer) (map[string]interface{}, error) {
+	fields, err := toJSONMap(block.Header())
+	if err != nil {
+		return nil, err
+	}
+	fields["size"] = hexutil.Uint64(block.Size())
+	fields["uncles"] = []common.Hash{}
+	fields["withdrawals"] = block.Withdrawals()
+
+	txs := make([]interface{}, len(block.Transactions()))
+	for i, tx := range block.Transactions() {
+		if !fullTx {
+			txs[i] = tx.Hash()
+			continue
+		}
+		txFields, err := toJSONMap(tx)
+		if err != nil {
+			return nil, err
+		}
+		from, _ := types.Sender(signer, tx)
+		txFields["from"] = from
+		txFields["blockHash"] = block.Hash()
+		txFields["blockNumber"] = (*hexutil.Big)(block.Number())
+		txFields["transactionIndex"] = hexutil.Uint64(i)
+		txs[i] = txFields
+	}
+	fields["transactions"] = txs
+
+	return fields, nil
+}
+
+// toJSONMap converts the given value to a JSON object.
+func toJSONMap(v interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,206 @@
+package harness
+
+import (
+	"encoding/binary"
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// maxLogTopics is the maximum number of topics of an EVM log.
+const maxLogTopics = 4
+
+// emitSelector returns the selector, which makes a stub contract emit a log with the given number of topics.
+func emitSelector(topics int) [4]byte {
+	return [4]byte{0xff, 0xff, 0xff, 0xf0 + byte(topics)}
+}
+
+// Stub assembles a stub contract, which stands in for a protocol contract in tests. It returns fixed
+// results for the configured methods, reverts on any other call, and can emit arbitrary logs from its
+// own address, see EmitCalldata.
+type Stub struct {
+	returns map[[4]byte][]byte
+}
+
+// NewStub creates a new empty stub contract.
+func NewStub() *Stub {
+	return &Stub{returns: make(map[[4]byte][]byte)}
+}
+
+// Returns makes the stub return the given outputs when the given method is called, with any arguments.
+func (s *Stub) Returns(method abi.Method, outputs ...interface{}) (*Stub, error) {
+	data, err := method.Outputs.Pack(outputs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack outputs of %s: %w", method.Name, err)
+	}
+
+	return s.ReturnsRaw(method.ID, data), nil
+}
+
+// ReturnsRaw makes the stub return the given raw data when the method with the given selector is called.
+func (s *Stub) ReturnsRaw(selector []byte, data []byte) *Stub {
+	s.returns[[4]byte(selector)] = common.CopyBytes(data)
+	return s
+}
+
+// Call returns the result of calling the stub with the given calldata, it can be used to serve
+// the calls in a mock node without EVM.
+func (s *Stub) Call(data []byte) ([]byte, error) {
+	if len(data) < 4 {
+		return nil, vm.ErrExecutionReverted
+	}
+	result, ok := s.returns[[4]byte(data[:4])]
+	if !ok {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	return result, nil
+}
+
+// Code assembles the runtime bytecode of the stub.
+func (s *Stub) Code() []byte {
+	selectors := make([][4]byte, 0, len(s.returns))
+	for selector := range s.returns {
+		selectors = append(selectors, selector)
+	}
+	slices.SortFunc(selectors, func(a, b [4]byte) int { return slices.Compare(a[:], b[:]) })
+
+	// The dispatcher compares the selector with every entry, and jumps to the body of the matched one.
+	// Each entry takes 11 bytes: DUP1 PUSH4 <selector> EQ PUSH2 <dest> JUMPI.
+	const (
+		headerSize   = 6
+		entrySize    = 11
+		fallbackSize = 4
+		returnSize   = 16
+	)
+	var (
+		entries    = len(selectors) + maxLogTopics + 1
+		bodyOffset = headerSize + entries*entrySize + fallbackSize
+		dests      = make([]int, 0, entries)
+		bodies     []byte
+		returnData []byte
+	)
+
+	// Bodies returning the fixed results, the results are appended after the code.
+	for range selectors {
+		dests = append(dests, bodyOffset+len(bodies))
+		bodies = append(bodies, make([]byte, returnSize)...)
+	}
+	// Bodies emitting logs.
+	for topics := 0; topics <= maxLogTopics; topics++ {
+		dests = append(dests, bodyOffset+len(bodies))
+		bodies = append(bodies, emitBody(topics)...)
+	}
+
+	codeSize := bodyOffset + len(bodies)
+	for i, selector := range selectors {
+		data := s.returns[selector]
+		bodies = slices.Replace(
+			bodies,
+			dests[i]-bodyOffset,
+			dests[i]-bodyOffset+returnSize,
+			returnBody(len(data), codeSize+len(returnData))...,
+		)
+		returnData = append(returnData, data...)
+	}
+
+	// Header: load the selector.
+	code := []byte{byte(vm.PUSH1), 0, byte(vm.CALLDATALOAD), byte(vm.PUSH1), 0xe0, byte(vm.SHR)}
+	// Dispatcher.
+	for i := 0; i < entries; i++ {
+		var selector [4]byte
+		if i < len(selectors) {
+			selector = selectors[i]
+		} else {
+			selector = emitSelector(i - len(selectors))
+		}
+		code = append(code, byte(vm.DUP1), byte(vm.PUSH4))
+		code = append(code, selector[:]...)
+		code = append(code, byte(vm.EQ), byte(vm.PUSH2))
+		code = binary.BigEndian.AppendUint16(code, uint16(dests[i]))
+		code = append(code, byte(vm.JUMPI))
+	}
+	// Fallback: revert.
+	code = append(code, byte(vm.PUSH1), 0, byte(vm.DUP1), byte(vm.REVERT))
+
+	code = append(code, bodies...)
+	return append(code, returnData...)
+}
+
+// returnBody assembles the body returning the code slice [offset, offset+size).
+func returnBody(size int, offset int) []byte {
+	body := []byte{byte(vm.JUMPDEST), byte(vm.PUSH2)}
+	body = binary.BigEndian.AppendUint16(body, uint16(size))
+	body = append(body, byte(vm.PUSH2))
+	body = binary.BigEndian.AppendUint16(body, uint16(offset))
+	body = append(body, byte(vm.PUSH1), 0, byte(vm.CODECOPY), byte(vm.PUSH2))
+	body = binary.BigEndian.AppendUint16(body, uint16(size))
+	return append(body, byte(vm.PUSH1), 0, byte(vm.RETURN))
+}
+
+// emitBody assembles the body emitting a log with the given number of topics, the topics are read from
+// calldata[4:4+32*topics], and the data is the rest of calldata.
+func emitBody(topics int) []byte {
+	header := byte(4 + 32*topics)
+	body := []byte{
+		byte(vm.JUMPDEST),
+		// dataSize = CALLDATASIZE - header
+		byte(vm.CALLDATASIZE), byte(vm.PUSH1), header, byte(vm.SWAP1), byte(vm.SUB),
+		// memory[0:dataSize] = calldata[header:]
+		byte(vm.DUP1), byte(vm.PUSH1), header, byte(vm.PUSH1), 0, byte(vm.CALLDATACOPY),
+	}
+	for i := topics - 1; i >= 0; i-- {
+		body = append(body, byte(vm.PUSH1), byte(4+32*i), byte(vm.CALLDATALOAD))
+	}
+
+	return append(body, byte(vm.DUP1)+byte(topics), byte(vm.PUSH1), 0, byte(vm.LOG0)+byte(topics), byte(vm.STOP))
+}
+
+// EmitCalldata returns the calldata, which makes a stub contract emit a log with the given topics and data.
+func EmitCalldata(topics []common.Hash, data []byte) ([]byte, error) {
+	if len(topics) > maxLogTopics {
+		return nil, fmt.Errorf("too many topics: %d", len(topics))
+	}
+
+	selector := emitSelector(len(topics))
+	calldata := selector[:]
+	for _, topic := range topics {
+		calldata = append(calldata, topic.Bytes()...)
+	}
+
+	return append(calldata, data...), nil
+}
+
+// EmitEventCalldata returns the calldata, which makes a stub contract emit the given event with the given
+// arguments, in the order of the event inputs.
+func EmitEventCalldata(event abi.Event, args ...interface{}) ([]byte, error) {
+	if len(args) != len(event.Inputs) {
+		return nil, fmt.Errorf("invalid number of %s arguments: %d", event.Name, len(args))
+	}
+
+	var (
+		topics     = []common.Hash{event.ID}
+		nonIndexed []interface{}
+	)
+	for i, input := range event.Inputs {
+		if !input.Indexed {
+			nonIndexed = append(nonIndexed, args[i])
+			continue
+		}
+		topic, err := abi.MakeTopics([]interface{}{args[i]})
+		if err != nil {
+			return nil, err
+		}
+		topics = append(topics, topic[0][0])
+	}
+
+	data, err := event.Inputs.NonIndexed().Pack(nonIndexed...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack %s arguments: %w", event.Name, err)
+	}
+
+	return EmitCalldata(topics, data)
+}
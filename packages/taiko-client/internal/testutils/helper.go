@@ -25,6 +25,7 @@ import (
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/signer"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/server"
 )
 
@@ -229,7 +230,7 @@ func (s *ClientTestSuite) NewTestProverServer(
 	s.Nil(err)
 
 	srv, err := server.New(&server.NewProverServerOpts{
-		ProverPrivateKey:      proverPrivKey,
+		ProverSigner:          signer.NewLocalSigner(proverPrivKey),
 		MinOptimisticTierFee:  common.Big1,
 		MinSgxTierFee:         common.Big1,
 		MinSgxAndZkVMTierFee:  common.Big1,
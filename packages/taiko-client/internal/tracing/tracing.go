@@ -0,0 +1,115 @@
+// Package tracing sets up OpenTelemetry tracing for the client software, and provides the helpers used
+// to instrument the driver, proposer and prover pipelines. Until Init is called with tracing enabled, all
+// spans are no-ops. It doesn't depend on the other packages of the client, so that any of them can be
+// instrumented.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName is the name of the tracer used by all client software.
+const instrumentationName = "github.com/taikoxyz/taiko-mono/packages/taiko-client"
+
+// Span attribute keys.
+const (
+	BlockIDKey = attribute.Key("taiko.block_id")
+	TierKey    = attribute.Key("taiko.tier")
+)
+
+// propagator injects the trace context into the outgoing HTTP requests, and it is always the W3C trace
+// context propagator, so that the trace context can be passed to Raiko even if the global propagator
+// is not set.
+var propagator = propagation.TraceContext{}
+
+// Config contains the configurations of the tracer provider.
+type Config struct {
+	Enabled     bool
+	ServiceName string
+	Endpoint    string
+	Insecure    bool
+	SampleRatio float64
+}
+
+// Init initializes the global tracer provider, which exports the spans to the configured OTLP/HTTP
+// collector endpoint, and returns a function to flush and stop it. If tracing is not enabled, it does nothing.
+func Init(ctx context.Context, cfg *Config) (func(context.Context) error, error) {
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+	if cfg.SampleRatio < 0 || cfg.SampleRatio > 1 {
+		return nil, fmt.Errorf("invalid tracing sample ratio: %f", cfg.SampleRatio)
+	}
+
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	exporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(cfg.ServiceName),
+		)),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagator)
+
+	log.Info(
+		"OpenTelemetry tracing enabled",
+		"service", cfg.ServiceName,
+		"endpoint", cfg.Endpoint,
+		"sampleRatio", cfg.SampleRatio,
+	)
+
+	return provider.Shutdown, nil
+}
+
+// Start creates a span with the given name and attributes, as a child of the span in the given context.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records the given error, if any, in the span and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// InjectHeaders injects the trace context in the given context into the given HTTP headers.
+func InjectHeaders(ctx context.Context, header http.Header) {
+	propagator.Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// BlockID returns the span attribute of the given L2 block ID.
+func BlockID(blockID *big.Int) attribute.KeyValue {
+	return BlockIDKey.Int64(blockID.Int64())
+}
+
+// Tier returns the span attribute of the given proof tier.
+func Tier(tier uint16) attribute.KeyValue {
+	return TierKey.Int(int(tier))
+}
@@ -0,0 +1,138 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// testCollector is a local stand-in of an OTLP/HTTP collector, which records all received spans.
+type testCollector struct {
+	mu       sync.Mutex
+	services []string
+	spans    []*tracepb.Span
+	server   *httptest.Server
+}
+
+func newTestCollector(t *testing.T) *testCollector {
+	c := new(testCollector)
+	c.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/traces" {
+			http.NotFound(w, r)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var req coltracepb.ExportTraceServiceRequest
+		if err := proto.Unmarshal(body, &req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		c.mu.Lock()
+		for _, resourceSpans := range req.ResourceSpans {
+			for _, attr := range resourceSpans.Resource.Attributes {
+				if attr.Key == "service.name" {
+					c.services = append(c.services, attr.Value.GetStringValue())
+				}
+			}
+			for _, scopeSpans := range resourceSpans.ScopeSpans {
+				c.spans = append(c.spans, scopeSpans.Spans...)
+			}
+		}
+		c.mu.Unlock()
+
+		resBytes, err := proto.Marshal(&coltracepb.ExportTraceServiceResponse{})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		_, _ = w.Write(resBytes)
+	}))
+	t.Cleanup(c.server.Close)
+
+	return c
+}
+
+func (c *testCollector) endpoint() string {
+	return strings.TrimPrefix(c.server.URL, "http://")
+}
+
+func TestInitExportsSpans(t *testing.T) {
+	collector := newTestCollector(t)
+
+	shutdown, err := Init(context.Background(), &Config{
+		Enabled:     true,
+		ServiceName: "driver",
+		Endpoint:    collector.endpoint(),
+		Insecure:    true,
+		SampleRatio: 1,
+	})
+	require.Nil(t, err)
+
+	ctx, parent := Start(context.Background(), "Syncer.onBlockProposed", BlockID(big.NewInt(10)))
+	_, child := Start(ctx, "Syncer.insertNewHead")
+	End(child, errors.New("test error"))
+	End(parent, nil)
+
+	// Shutting down the provider flushes all pending spans.
+	require.Nil(t, shutdown(context.Background()))
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+
+	require.Contains(t, collector.services, "driver")
+	require.Len(t, collector.spans, 2)
+
+	spans := make(map[string]*tracepb.Span)
+	for _, span := range collector.spans {
+		spans[span.Name] = span
+	}
+	require.Equal(t, spans["Syncer.onBlockProposed"].TraceId, spans["Syncer.insertNewHead"].TraceId)
+	require.Equal(t, spans["Syncer.onBlockProposed"].SpanId, spans["Syncer.insertNewHead"].ParentSpanId)
+	require.Equal(t, tracepb.Status_STATUS_CODE_ERROR, spans["Syncer.insertNewHead"].Status.Code)
+	require.Equal(t, string(BlockIDKey), spans["Syncer.onBlockProposed"].Attributes[0].Key)
+	require.Equal(t, int64(10), spans["Syncer.onBlockProposed"].Attributes[0].Value.GetIntValue())
+}
+
+func TestInitDisabled(t *testing.T) {
+	shutdown, err := Init(context.Background(), &Config{Enabled: false})
+	require.Nil(t, err)
+	require.Nil(t, shutdown(context.Background()))
+}
+
+func TestInitInvalidSampleRatio(t *testing.T) {
+	_, err := Init(context.Background(), &Config{Enabled: true, SampleRatio: 2})
+	require.NotNil(t, err)
+}
+
+func TestInjectHeaders(t *testing.T) {
+	ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "test")
+	defer span.End()
+
+	header := make(http.Header)
+	InjectHeaders(ctx, header)
+	require.Contains(t, header.Get("traceparent"), span.SpanContext().TraceID().String())
+
+	// Nothing is injected without a span.
+	header = make(http.Header)
+	InjectHeaders(context.Background(), header)
+	require.Empty(t, header.Get("traceparent"))
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"math/big"
+	"slices"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
 	chainIterator "github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/chain_iterator"
+	eventStream "github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/chain_iterator/event_stream"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
 )
 
@@ -44,6 +47,8 @@ type BlockProposedIteratorConfig struct {
 	FilterQuery           []*big.Int
 	OnBlockProposedEvent  OnBlockProposedEvent
 	BlockConfirmations    *uint64
+	// EventStream is an optional shared L1 event stream, whose local log cache will be used if possible.
+	EventStream *eventStream.EventStream
 }
 
 // NewBlockProposedIterator creates a new instance of BlockProposed event iterator.
@@ -68,6 +73,7 @@ func NewBlockProposedIterator(ctx context.Context, cfg *BlockProposedIteratorCon
 		OnBlocks: assembleBlockProposedIteratorCallback(
 			cfg.Client,
 			cfg.TaikoL1,
+			cfg.EventStream,
 			cfg.FilterQuery,
 			cfg.OnBlockProposedEvent,
 			iterator,
@@ -98,6 +104,7 @@ func (i *BlockProposedIterator) end() {
 func assembleBlockProposedIteratorCallback(
 	client *rpc.EthClient,
 	taikoL1Client *bindings.TaikoL1Client,
+	stream *eventStream.EventStream,
 	filterQuery []*big.Int,
 	callback OnBlockProposedEvent,
 	eventIter *BlockProposedIterator,
@@ -108,20 +115,12 @@ func assembleBlockProposedIteratorCallback(
 		updateCurrentFunc chainIterator.UpdateCurrentFunc,
 		endFunc chainIterator.EndIterFunc,
 	) error {
-		endHeight := end.Number.Uint64()
-		iter, err := taikoL1Client.FilterBlockProposed(
-			&bind.FilterOpts{Start: start.Number.Uint64(), End: &endHeight, Context: ctx},
-			filterQuery,
-			nil,
-		)
+		events, err := filterBlockProposed(ctx, taikoL1Client, stream, start, end, filterQuery)
 		if err != nil {
 			return err
 		}
-		defer iter.Close()
-
-		for iter.Next() {
-			event := iter.Event
 
+		for _, event := range events {
 			if err := callback(ctx, event, eventIter.end); err != nil {
 				return err
 			}
@@ -142,3 +141,58 @@ func assembleBlockProposedIteratorCallback(
 		return nil
 	}
 }
+
+// filterBlockProposed fetches the BlockProposed events in the given range, from the event stream
+// if it's given, otherwise from L1 directly.
+func filterBlockProposed(
+	ctx context.Context,
+	taikoL1Client *bindings.TaikoL1Client,
+	stream *eventStream.EventStream,
+	start, end *types.Header,
+	filterQuery []*big.Int,
+) ([]*bindings.TaikoL1ClientBlockProposed, error) {
+	var events []*bindings.TaikoL1ClientBlockProposed
+
+	if stream != nil {
+		logs, err := stream.Logs(
+			ctx,
+			start.Number.Uint64(),
+			end,
+			encoding.TaikoL1ABI.Events["BlockProposed"].ID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range logs {
+			event, err := taikoL1Client.ParseBlockProposed(l)
+			if err != nil {
+				return nil, err
+			}
+			if len(filterQuery) != 0 && !slices.ContainsFunc(filterQuery, func(id *big.Int) bool {
+				return id.Cmp(event.BlockId) == 0
+			}) {
+				continue
+			}
+			events = append(events, event)
+		}
+
+		return events, nil
+	}
+
+	endHeight := end.Number.Uint64()
+	iter, err := taikoL1Client.FilterBlockProposed(
+		&bind.FilterOpts{Start: start.Number.Uint64(), End: &endHeight, Context: ctx},
+		filterQuery,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	for iter.Next() {
+		events = append(events, iter.Event)
+	}
+
+	return events, iter.Error()
+}
@@ -0,0 +1,124 @@
+package eventstream
+
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// logWindow keeps the logs emitted in the recent L1 blocks (floor, head], which are used to notify the
+// consumers about the removed logs when a reorg happens, and to serve the log queries locally. The hashes
+// of the blocks with logs and of the polled batches' last blocks are kept too, so that the queries of
+// another chain than the cached one can be detected.
+type logWindow struct {
+	floor  uint64
+	head   uint64
+	size   uint64
+	logs   map[uint64][]types.Log
+	hashes map[uint64]common.Hash
+}
+
+// newLogWindow creates a new empty log window, which starts after the given height and keeps the logs
+// of the given number of blocks at most.
+func newLogWindow(start uint64, size uint64) *logWindow {
+	return &logWindow{
+		floor:  start,
+		head:   start,
+		size:   size,
+		logs:   make(map[uint64][]types.Log),
+		hashes: make(map[uint64]common.Hash),
+	}
+}
+
+// append appends the logs of the blocks (head, to] to the window, the given logs should be sorted
+// and all in that range, and the given hash is the hash of the block at height to.
+func (w *logWindow) append(to uint64, toHash common.Hash, logs []types.Log) {
+	for _, l := range logs {
+		w.logs[l.BlockNumber] = append(w.logs[l.BlockNumber], l)
+		w.hashes[l.BlockNumber] = l.BlockHash
+	}
+	w.hashes[to] = toHash
+	w.head = to
+
+	// Evict the oldest blocks.
+	for w.head-w.floor > w.size {
+		w.floor++
+		delete(w.logs, w.floor)
+		delete(w.hashes, w.floor)
+	}
+}
+
+// rewind removes the blocks after the given height from the window, and returns the removed logs
+// in reverse order.
+func (w *logWindow) rewind(height uint64) []types.Log {
+	var removed []types.Log
+	for n := w.head; n > height && n > w.floor; n-- {
+		logs := w.logs[n]
+		for i := len(logs) - 1; i >= 0; i-- {
+			removed = append(removed, logs[i])
+		}
+		delete(w.logs, n)
+		delete(w.hashes, n)
+	}
+
+	w.head = height
+	if w.floor > height {
+		w.floor = height
+	}
+
+	return removed
+}
+
+// covers returns true if all the blocks in the range [from, to] are in the window.
+func (w *logWindow) covers(from, to uint64) bool {
+	return from > w.floor && to <= w.head && from <= to
+}
+
+// contains returns true if the block with the given height and hash is in the window, false if the
+// window has another block at that height, or the hash of the block at that height is unknown.
+func (w *logWindow) contains(height uint64, hash common.Hash) bool {
+	h, ok := w.hashes[height]
+	return ok && h == hash
+}
+
+// filter returns the logs in the range [from, to] with the given topics as the first topic,
+// or all logs if no topic is given.
+func (w *logWindow) filter(from, to uint64, topics []common.Hash) []types.Log {
+	var heights []uint64
+	for n := range w.logs {
+		if n >= from && n <= to {
+			heights = append(heights, n)
+		}
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+
+	var logs []types.Log
+	for _, n := range heights {
+		for _, l := range w.logs[n] {
+			if matchTopics(l, topics) {
+				logs = append(logs, l)
+			}
+		}
+	}
+
+	return logs
+}
+
+// matchTopics returns true if the first topic of the given log is one of the given topics,
+// or no topic is given.
+func matchTopics(l types.Log, topics []common.Hash) bool {
+	if len(topics) == 0 {
+		return true
+	}
+	if len(l.Topics) == 0 {
+		return false
+	}
+	for _, topic := range topics {
+		if l.Topics[0] == topic {
+			return true
+		}
+	}
+
+	return false
+}
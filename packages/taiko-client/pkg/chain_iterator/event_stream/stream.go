@@ -0,0 +1,346 @@
+package eventstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	DefaultPollInterval       = 4 * time.Second
+	DefaultBlocksReadPerEpoch = 1000
+	DefaultReorgRewindDepth   = 64
+)
+
+var (
+	// sharedStreams are the event streams shared in the current process, keyed by chain ID and addresses.
+	sharedStreams   = make(map[string]*EventStream)
+	sharedStreamsMu sync.Mutex
+)
+
+// Client is the L1 client used by an event stream, which is implemented by rpc.EthClient.
+type Client interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error)
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+}
+
+// Config represents the configs of an event stream.
+type Config struct {
+	Client                Client
+	ChainID               *big.Int
+	Addresses             []common.Address
+	BlockConfirmations    uint64
+	MaxBlocksReadPerEpoch uint64
+	PollInterval          time.Duration
+	ReorgRewindDepth      uint64
+	// CacheBlocks is the number of recent blocks whose logs are cached locally to serve the log queries,
+	// the logs in the last ReorgRewindDepth blocks are always kept.
+	CacheBlocks uint64
+}
+
+// EventStream polls the logs emitted by the given contracts in L1 once, and fans them out to multiple
+// consumers, so that several roles running in the same process don't need to fetch the same logs through
+// their own subscriptions. It is reorg-aware: when a reorg is detected, the logs in the reorged blocks
+// are sent again with the Removed flag set, before the logs in the new canonical blocks.
+type EventStream struct {
+	client             Client
+	addresses          []common.Address
+	blockConfirmations uint64
+	blocksReadPerEpoch uint64
+	pollInterval       time.Duration
+	reorgRewindDepth   uint64
+
+	mu      sync.RWMutex
+	current *types.Header
+	window  *logWindow
+	feed    event.Feed
+
+	key    string
+	refs   int
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New creates a new event stream, which starts polling from the current L1 head.
+func New(ctx context.Context, cfg *Config) (*EventStream, error) {
+	if cfg.Client == nil {
+		return nil, errors.New("invalid RPC client")
+	}
+	if len(cfg.Addresses) == 0 {
+		return nil, errors.New("empty contract addresses")
+	}
+
+	s := &EventStream{
+		client:             cfg.Client,
+		addresses:          cfg.Addresses,
+		blockConfirmations: cfg.BlockConfirmations,
+		blocksReadPerEpoch: cfg.MaxBlocksReadPerEpoch,
+		pollInterval:       cfg.PollInterval,
+		reorgRewindDepth:   cfg.ReorgRewindDepth,
+		refs:               1,
+	}
+	if s.blocksReadPerEpoch == 0 {
+		s.blocksReadPerEpoch = DefaultBlocksReadPerEpoch
+	}
+	if s.pollInterval == 0 {
+		s.pollInterval = DefaultPollInterval
+	}
+	if s.reorgRewindDepth == 0 {
+		s.reorgRewindDepth = DefaultReorgRewindDepth
+	}
+
+	head, err := s.client.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get L1 head: %w", err)
+	}
+	if head > s.blockConfirmations {
+		head -= s.blockConfirmations
+	} else {
+		head = 0
+	}
+	if s.current, err = s.client.HeaderByNumber(ctx, new(big.Int).SetUint64(head)); err != nil {
+		return nil, fmt.Errorf("failed to get start header, height: %d, error: %w", head, err)
+	}
+	s.window = newLogWindow(head, max(cfg.CacheBlocks, s.reorgRewindDepth))
+
+	loopCtx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+	s.wg.Add(1)
+	go s.loop(loopCtx)
+
+	return s, nil
+}
+
+// Shared returns the event stream of the given chain and contracts shared in the current process, a new one
+// will be created if there is no such stream yet. Each call should be paired with a Close call.
+func Shared(cfg *Config) (*EventStream, error) {
+	if cfg.ChainID == nil {
+		return nil, errors.New("invalid chain ID")
+	}
+
+	sharedStreamsMu.Lock()
+	defer sharedStreamsMu.Unlock()
+
+	key := fmt.Sprintf("%s-%v", cfg.ChainID, cfg.Addresses)
+	if s, ok := sharedStreams[key]; ok {
+		s.refs++
+		return s, nil
+	}
+
+	// The shared stream outlives the context of any single role, it's stopped by the last Close call.
+	s, err := New(context.Background(), cfg)
+	if err != nil {
+		return nil, err
+	}
+	s.key = key
+	sharedStreams[key] = s
+
+	log.Info("Shared L1 event stream started", "chainID", cfg.ChainID, "addresses", cfg.Addresses)
+
+	return s, nil
+}
+
+// Close releases the stream, the polling loop will be stopped once it's not used anymore.
+func (s *EventStream) Close() {
+	sharedStreamsMu.Lock()
+	s.refs--
+	if s.refs > 0 {
+		sharedStreamsMu.Unlock()
+		return
+	}
+	if s.key != "" {
+		delete(sharedStreams, s.key)
+	}
+	sharedStreamsMu.Unlock()
+
+	s.cancel()
+	s.wg.Wait()
+}
+
+// Subscribe subscribes all logs in the stream, including the removed ones. Note that the stream waits for
+// every consumer to receive a log before sending the next one, so the consumers should keep reading.
+func (s *EventStream) Subscribe(ch chan<- types.Log) event.Subscription {
+	return s.feed.Subscribe(ch)
+}
+
+// Current returns the header of the latest L1 block which has been polled.
+func (s *EventStream) Current() *types.Header {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.current
+}
+
+// Logs returns the logs emitted by the stream contracts in the L1 blocks [from, end], with one of the given
+// topics as the first topic, or any topic if no topic is given. The logs are served from the local cache
+// if possible, i.e. the cached blocks are on the same chain as the given end block, otherwise they will be
+// fetched from L1.
+func (s *EventStream) Logs(
+	ctx context.Context,
+	from uint64,
+	end *types.Header,
+	topics ...common.Hash,
+) ([]types.Log, error) {
+	to := end.Number.Uint64()
+
+	s.mu.RLock()
+	if s.window.covers(from, to) && s.window.contains(to, end.Hash()) {
+		logs := s.window.filter(from, to, topics)
+		s.mu.RUnlock()
+		return logs, nil
+	}
+	s.mu.RUnlock()
+
+	return s.client.FilterLogs(ctx, s.filterQuery(from, to, topics))
+}
+
+// loop keeps polling the new logs on an interval.
+func (s *EventStream) loop(ctx context.Context) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.poll(ctx); err != nil && ctx.Err() == nil {
+			log.Warn("Failed to poll L1 event stream", "current", s.Current().Number, "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll polls the new logs in batches, until the stream catches up with the L1 head.
+func (s *EventStream) poll(ctx context.Context) error {
+	for ctx.Err() == nil {
+		done, err := s.step(ctx)
+		if err != nil || done {
+			return err
+		}
+	}
+
+	return ctx.Err()
+}
+
+// step polls the logs in the next batch of blocks, returns true if there is no new block.
+func (s *EventStream) step(ctx context.Context) (bool, error) {
+	if err := s.handleReorg(ctx); err != nil {
+		return false, fmt.Errorf("failed to check whether the event stream has been reorged: %w", err)
+	}
+
+	head, err := s.client.BlockNumber(ctx)
+	if err != nil {
+		return false, err
+	}
+	if head < s.blockConfirmations {
+		return true, nil
+	}
+	dest := head - s.blockConfirmations
+
+	current := s.Current().Number.Uint64()
+	if current >= dest {
+		return true, nil
+	}
+
+	to := min(current+s.blocksReadPerEpoch, dest)
+	end, err := s.client.HeaderByNumber(ctx, new(big.Int).SetUint64(to))
+	if err != nil {
+		return false, err
+	}
+	logs, err := s.client.FilterLogs(ctx, s.filterQuery(current+1, to, nil))
+	if err != nil {
+		return false, err
+	}
+	// Make sure the batch was not reorged while querying the logs.
+	if endAfter, err := s.client.HeaderByNumber(ctx, end.Number); err != nil {
+		return false, err
+	} else if endAfter.Hash() != end.Hash() {
+		return false, fmt.Errorf("L1 block %d reorged while polling logs", to)
+	}
+
+	s.mu.Lock()
+	s.window.append(to, end.Hash(), logs)
+	s.current = end
+	s.mu.Unlock()
+
+	for _, l := range logs {
+		s.feed.Send(l)
+	}
+
+	log.Debug("L1 event stream polled", "from", current+1, "to", to, "logs", len(logs))
+
+	return to == dest, nil
+}
+
+// handleReorg checks if the current cursor was reorged, if was, it rewinds back ReorgRewindDepth blocks
+// and sends the logs in the rewound blocks with the Removed flag set.
+func (s *EventStream) handleReorg(ctx context.Context) error {
+	current := s.Current()
+	header, err := s.client.HeaderByHash(ctx, current.Hash())
+	if err != nil && err.Error() != ethereum.NotFound.Error() {
+		return err
+	}
+	// Not reorged
+	if header != nil {
+		return nil
+	}
+
+	var height uint64
+	if current.Number.Uint64() > s.reorgRewindDepth {
+		height = current.Number.Uint64() - s.reorgRewindDepth
+	}
+	newCurrent, err := s.client.HeaderByNumber(ctx, new(big.Int).SetUint64(height))
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	removed := s.window.rewind(height)
+	s.current = newCurrent
+	s.mu.Unlock()
+
+	log.Warn(
+		"L1 reorg detected in event stream",
+		"oldCurrentHeight", current.Number,
+		"oldCurrentHash", current.Hash(),
+		"newCurrentHeight", newCurrent.Number,
+		"newCurrentHash", newCurrent.Hash(),
+		"removedLogs", len(removed),
+	)
+
+	for _, l := range removed {
+		l.Removed = true
+		s.feed.Send(l)
+	}
+
+	return nil
+}
+
+// filterQuery assembles the query of the logs in the given range.
+func (s *EventStream) filterQuery(from, to uint64, topics []common.Hash) ethereum.FilterQuery {
+	q := ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: s.addresses,
+	}
+	if len(topics) != 0 {
+		q.Topics = [][]common.Hash{topics}
+	}
+
+	return q
+}
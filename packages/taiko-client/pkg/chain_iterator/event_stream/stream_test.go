@@ -0,0 +1,219 @@
+package eventstream
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testTopicA = common.HexToHash("0xa")
+	testTopicB = common.HexToHash("0xb")
+)
+
+// testChain is an in-memory L1 chain, whose blocks after a fork height can be replaced to simulate reorgs.
+type testChain struct {
+	headers []*types.Header
+	logs    map[uint64][]types.Log
+}
+
+func newTestChain(height uint64) *testChain {
+	c := &testChain{logs: make(map[uint64][]types.Log)}
+	c.extend(0, height, 0)
+	return c
+}
+
+// extend replaces the blocks after the given height with new blocks up to the given head.
+func (c *testChain) extend(from, head uint64, fork byte) {
+	c.headers = c.headers[:min(uint64(len(c.headers)), from)]
+	for n := from; n <= head; n++ {
+		delete(c.logs, n)
+		c.headers = append(c.headers, &types.Header{Number: new(big.Int).SetUint64(n), Extra: []byte{fork}})
+	}
+}
+
+func (c *testChain) addLog(height uint64, topic common.Hash) {
+	c.logs[height] = append(c.logs[height], types.Log{
+		BlockNumber: height,
+		BlockHash:   c.headers[height].Hash(),
+		Topics:      []common.Hash{topic},
+	})
+}
+
+func (c *testChain) BlockNumber(_ context.Context) (uint64, error) {
+	return uint64(len(c.headers) - 1), nil
+}
+
+func (c *testChain) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	if number.Uint64() >= uint64(len(c.headers)) {
+		return nil, ethereum.NotFound
+	}
+	return c.headers[number.Uint64()], nil
+}
+
+func (c *testChain) HeaderByHash(_ context.Context, hash common.Hash) (*types.Header, error) {
+	for _, h := range c.headers {
+		if h.Hash() == hash {
+			return h, nil
+		}
+	}
+	return nil, ethereum.NotFound
+}
+
+func (c *testChain) FilterLogs(_ context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	var logs []types.Log
+	for n := q.FromBlock.Uint64(); n <= q.ToBlock.Uint64(); n++ {
+		for _, l := range c.logs[n] {
+			if len(q.Topics) == 0 || matchTopics(l, q.Topics[0]) {
+				logs = append(logs, l)
+			}
+		}
+	}
+	return logs, nil
+}
+
+// newTestStream creates an event stream starting from the given height, without starting the polling loop.
+func newTestStream(t *testing.T, chain *testChain, start uint64) *EventStream {
+	current, err := chain.HeaderByNumber(context.Background(), new(big.Int).SetUint64(start))
+	require.Nil(t, err)
+
+	return &EventStream{
+		client:             chain,
+		addresses:          []common.Address{{}},
+		blocksReadPerEpoch: 3,
+		reorgRewindDepth:   4,
+		current:            current,
+		window:             newLogWindow(start, 4),
+	}
+}
+
+func TestEventStreamFanOut(t *testing.T) {
+	chain := newTestChain(10)
+	chain.addLog(2, testTopicA)
+	chain.addLog(5, testTopicB)
+	chain.addLog(9, testTopicA)
+
+	s := newTestStream(t, chain, 0)
+	ch1, ch2 := make(chan types.Log, 10), make(chan types.Log, 10)
+	defer s.Subscribe(ch1).Unsubscribe()
+	defer s.Subscribe(ch2).Unsubscribe()
+
+	require.Nil(t, s.poll(context.Background()))
+	require.Equal(t, uint64(10), s.Current().Number.Uint64())
+
+	for _, ch := range []chan types.Log{ch1, ch2} {
+		require.Len(t, ch, 3)
+		for _, height := range []uint64{2, 5, 9} {
+			require.Equal(t, height, (<-ch).BlockNumber)
+		}
+	}
+
+	// Only the logs in the last blocks are cached, the older ones are fetched from L1.
+	require.True(t, s.window.covers(7, 10))
+	require.False(t, s.window.covers(6, 10))
+	logs, err := s.Logs(context.Background(), 7, chain.headers[10], testTopicA)
+	require.Nil(t, err)
+	require.Len(t, logs, 1)
+	logs, err = s.Logs(context.Background(), 1, chain.headers[10], testTopicA)
+	require.Nil(t, err)
+	require.Len(t, logs, 2)
+}
+
+func TestEventStreamReorg(t *testing.T) {
+	chain := newTestChain(10)
+	chain.addLog(8, testTopicA)
+	chain.addLog(9, testTopicB)
+
+	s := newTestStream(t, chain, 0)
+	ch := make(chan types.Log, 10)
+	defer s.Subscribe(ch).Unsubscribe()
+
+	require.Nil(t, s.poll(context.Background()))
+	require.Len(t, ch, 2)
+	<-ch
+	<-ch
+
+	// Reorg the blocks after height 7.
+	chain.extend(8, 11, 1)
+	chain.addLog(10, testTopicA)
+
+	require.Nil(t, s.poll(context.Background()))
+	require.Equal(t, chain.headers[11].Hash(), s.Current().Hash())
+
+	// The removed logs are sent in reverse order, before the new ones.
+	removed := <-ch
+	require.True(t, removed.Removed)
+	require.Equal(t, uint64(9), removed.BlockNumber)
+	removed = <-ch
+	require.True(t, removed.Removed)
+	require.Equal(t, uint64(8), removed.BlockNumber)
+
+	added := <-ch
+	require.False(t, added.Removed)
+	require.Equal(t, chain.headers[10].Hash(), added.BlockHash)
+	require.Empty(t, ch)
+}
+
+func TestEventStreamLogsReorgWithoutPoll(t *testing.T) {
+	chain := newTestChain(10)
+	chain.addLog(8, testTopicA)
+	chain.addLog(9, testTopicA)
+
+	s := newTestStream(t, chain, 0)
+	require.Nil(t, s.poll(context.Background()))
+
+	// Reorg the blocks after height 8, before the stream polls again.
+	chain.extend(9, 10, 1)
+	chain.addLog(10, testTopicA)
+
+	// The cached logs are on another chain than the queried end block, so they are fetched from L1.
+	logs, err := s.Logs(context.Background(), 7, chain.headers[10], testTopicA)
+	require.Nil(t, err)
+	require.Len(t, logs, 2)
+	require.Equal(t, chain.headers[8].Hash(), logs[0].BlockHash)
+	require.Equal(t, chain.headers[10].Hash(), logs[1].BlockHash)
+
+	// Same for an end block replacing a cached block with logs.
+	logs, err = s.Logs(context.Background(), 7, chain.headers[9], testTopicA)
+	require.Nil(t, err)
+	require.Len(t, logs, 1)
+	require.Equal(t, chain.headers[8].Hash(), logs[0].BlockHash)
+
+	// The blocks before the reorg are still served from the cache.
+	require.True(t, s.window.contains(8, chain.headers[8].Hash()))
+	logs, err = s.Logs(context.Background(), 7, chain.headers[8], testTopicA)
+	require.Nil(t, err)
+	require.Len(t, logs, 1)
+}
+
+func TestLogWindow(t *testing.T) {
+	w := newLogWindow(10, 3)
+
+	w.append(12, common.HexToHash("0x12"), []types.Log{
+		{BlockNumber: 11, BlockHash: common.HexToHash("0x11")},
+		{BlockNumber: 12, BlockHash: common.HexToHash("0x12"), Topics: []common.Hash{testTopicA}},
+	})
+	require.True(t, w.covers(11, 12))
+	require.True(t, w.contains(11, common.HexToHash("0x11")))
+	require.False(t, w.contains(12, common.HexToHash("0x11")))
+	require.Len(t, w.filter(11, 12, nil), 2)
+	require.Len(t, w.filter(11, 12, []common.Hash{testTopicA}), 1)
+
+	// The oldest block is evicted.
+	w.append(14, common.HexToHash("0x14"), []types.Log{{BlockNumber: 14, BlockHash: common.HexToHash("0x14")}})
+	require.False(t, w.covers(11, 14))
+	require.False(t, w.contains(11, common.HexToHash("0x11")))
+	require.False(t, w.contains(13, common.Hash{}))
+	require.True(t, w.covers(12, 14))
+
+	removed := w.rewind(12)
+	require.Len(t, removed, 1)
+	require.Equal(t, uint64(14), removed[0].BlockNumber)
+	require.False(t, w.covers(12, 13))
+	require.False(t, w.contains(14, common.HexToHash("0x14")))
+}
@@ -0,0 +1,87 @@
+package eventstream
+
+import (
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+)
+
+// logsBufferSize is the buffer size of the logs channel of each typed subscription.
+const logsBufferSize = 64
+
+// SubscribeBlockProposed subscribes the protocol's BlockProposed events in the given stream.
+func SubscribeBlockProposed(
+	s *EventStream,
+	taikoL1 *bindings.TaikoL1Client,
+	ch chan *bindings.TaikoL1ClientBlockProposed,
+) event.Subscription {
+	return subscribe(s, "BlockProposed", taikoL1.ParseBlockProposed, ch)
+}
+
+// SubscribeBlockVerified subscribes the protocol's BlockVerified events in the given stream.
+func SubscribeBlockVerified(
+	s *EventStream,
+	taikoL1 *bindings.TaikoL1Client,
+	ch chan *bindings.TaikoL1ClientBlockVerified,
+) event.Subscription {
+	return subscribe(s, "BlockVerified", taikoL1.ParseBlockVerified, ch)
+}
+
+// SubscribeTransitionProved subscribes the protocol's TransitionProved events in the given stream.
+func SubscribeTransitionProved(
+	s *EventStream,
+	taikoL1 *bindings.TaikoL1Client,
+	ch chan *bindings.TaikoL1ClientTransitionProved,
+) event.Subscription {
+	return subscribe(s, "TransitionProved", taikoL1.ParseTransitionProved, ch)
+}
+
+// SubscribeTransitionContested subscribes the protocol's TransitionContested events in the given stream.
+func SubscribeTransitionContested(
+	s *EventStream,
+	taikoL1 *bindings.TaikoL1Client,
+	ch chan *bindings.TaikoL1ClientTransitionContested,
+) event.Subscription {
+	return subscribe(s, "TransitionContested", taikoL1.ParseTransitionContested, ch)
+}
+
+// subscribe subscribes the logs of the given TaikoL1 event in the stream, parses and sends them
+// to the given channel.
+func subscribe[T any](
+	s *EventStream,
+	eventName string,
+	parse func(types.Log) (T, error),
+	ch chan T,
+) event.Subscription {
+	topic := encoding.TaikoL1ABI.Events[eventName].ID
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		logsCh := make(chan types.Log, logsBufferSize)
+		sub := s.Subscribe(logsCh)
+		defer sub.Unsubscribe()
+
+		for {
+			select {
+			case <-quit:
+				return nil
+			case err := <-sub.Err():
+				return err
+			case l := <-logsCh:
+				if len(l.Topics) == 0 || l.Topics[0] != topic {
+					continue
+				}
+				e, err := parse(l)
+				if err != nil {
+					return err
+				}
+				select {
+				case ch <- e:
+				case <-quit:
+					return nil
+				}
+			}
+		}
+	})
+}
@@ -2,7 +2,9 @@ package flags
 
 import (
 	"crypto/ecdsa"
+	"fmt"
 
+	opsigner "github.com/ethereum-optimism/optimism/op-service/signer"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -11,11 +13,23 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
 )
 
-// InitTxmgrConfigsFromCli initializes the transaction manager configs from the command line flags.
+// InitTxmgrConfigsFromCli initializes the transaction manager configs from the command line flags,
+// if the given private key is nil, the remote signer will be used to sign the transactions.
 func InitTxmgrConfigsFromCli(l1Endpoint string, privateKey *ecdsa.PrivateKey, c *cli.Context) *txmgr.CLIConfig {
+	// The --signer.* flags share their names with the op-service signer flags, and the TLS ones have
+	// no default values, so the TLS files are only loaded when they are set explicitly.
+	var signerConfigs opsigner.CLIConfig
+	var privateKeyHex string
+	if privateKey != nil {
+		privateKeyHex = common.Bytes2Hex(crypto.FromECDSA(privateKey))
+	} else {
+		signerConfigs = opsigner.ReadCLIConfig(c)
+	}
+
 	return &txmgr.CLIConfig{
 		L1RPCURL:                  l1Endpoint,
-		PrivateKey:                common.Bytes2Hex(crypto.FromECDSA(privateKey)),
+		PrivateKey:                privateKeyHex,
+		SignerCLIConfig:           signerConfigs,
 		NumConfirmations:          c.Uint64(flags.NumConfirmations.Name),
 		SafeAbortNonceTooLowCount: c.Uint64(flags.SafeAbortNonceTooLowCount.Name),
 		FeeLimitMultiplier:        c.Uint64(flags.FeeLimitMultiplier.Name),
@@ -29,3 +43,36 @@ func InitTxmgrConfigsFromCli(l1Endpoint string, privateKey *ecdsa.PrivateKey, c
 		TxNotInMempoolTimeout:     c.Duration(flags.TxNotInMempoolTimeout.Name),
 	}
 }
+
+// ParsePrivKeyOrRemoteSigner parses the private key from the given flag, or the remote signer
+// configs from the command line flags, exactly one of them should be set.
+func ParsePrivKeyOrRemoteSigner(
+	c *cli.Context,
+	privKeyFlag *cli.StringFlag,
+	keyName string,
+) (*ecdsa.PrivateKey, string, common.Address, error) {
+	remoteEndpoint := c.String(flags.RemoteSignerEndpoint.Name)
+
+	if c.IsSet(privKeyFlag.Name) == (remoteEndpoint != "") {
+		return nil, "", common.Address{}, fmt.Errorf(
+			"exactly one of --%s and --%s should be set",
+			privKeyFlag.Name,
+			flags.RemoteSignerEndpoint.Name,
+		)
+	}
+
+	if remoteEndpoint == "" {
+		privKey, err := crypto.ToECDSA(common.FromHex(c.String(privKeyFlag.Name)))
+		if err != nil {
+			return nil, "", common.Address{}, fmt.Errorf("invalid %s: %w", keyName, err)
+		}
+		return privKey, "", common.Address{}, nil
+	}
+
+	remoteAddress := c.String(flags.RemoteSignerAddress.Name)
+	if !common.IsHexAddress(remoteAddress) {
+		return nil, "", common.Address{}, fmt.Errorf("invalid remote signer address: %s", remoteAddress)
+	}
+
+	return nil, remoteEndpoint, common.HexToAddress(remoteAddress), nil
+}
@@ -0,0 +1,87 @@
+package flags
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
+)
+
+func TestInitTxmgrConfigsFromCliRemoteSigner(t *testing.T) {
+	var (
+		l1Endpoint = "ws://localhost:8546"
+		address    = common.HexToAddress("0x01").Hex()
+		cfg        *txmgr.CLIConfig
+	)
+
+	app := cli.NewApp()
+	app.Flags = flags.TxmgrFlags
+	app.Action = func(c *cli.Context) error {
+		cfg = InitTxmgrConfigsFromCli(l1Endpoint, nil, c)
+		return nil
+	}
+
+	require.Nil(t, app.Run([]string{
+		"TestInitTxmgrConfigsFromCliRemoteSigner",
+		"--" + flags.RemoteSignerEndpoint.Name, "http://localhost:9000",
+		"--" + flags.RemoteSignerAddress.Name, address,
+	}))
+
+	require.Empty(t, cfg.PrivateKey)
+	require.Equal(t, "http://localhost:9000", cfg.SignerCLIConfig.Endpoint)
+	require.Equal(t, address, cfg.SignerCLIConfig.Address)
+	require.True(t, cfg.SignerCLIConfig.Enabled())
+	require.False(t, cfg.SignerCLIConfig.TLSConfig.TLSEnabled())
+	require.Nil(t, cfg.SignerCLIConfig.Check())
+}
+
+func TestInitTxmgrConfigsFromCliRemoteSignerTLS(t *testing.T) {
+	var cfg *txmgr.CLIConfig
+
+	app := cli.NewApp()
+	app.Flags = flags.TxmgrFlags
+	app.Action = func(c *cli.Context) error {
+		cfg = InitTxmgrConfigsFromCli("ws://localhost:8546", nil, c)
+		return nil
+	}
+
+	require.Nil(t, app.Run([]string{
+		"TestInitTxmgrConfigsFromCliRemoteSignerTLS",
+		"--" + flags.RemoteSignerEndpoint.Name, "https://localhost:9000",
+		"--" + flags.RemoteSignerAddress.Name, common.HexToAddress("0x01").Hex(),
+		"--" + flags.RemoteSignerTLSCaCert.Name, "ca.crt",
+		"--" + flags.RemoteSignerTLSCert.Name, "tls.crt",
+		"--" + flags.RemoteSignerTLSKey.Name, "tls.key",
+	}))
+
+	require.True(t, cfg.SignerCLIConfig.TLSConfig.TLSEnabled())
+	require.Equal(t, "ca.crt", cfg.SignerCLIConfig.TLSConfig.TLSCaCert)
+	require.Equal(t, "tls.crt", cfg.SignerCLIConfig.TLSConfig.TLSCert)
+	require.Equal(t, "tls.key", cfg.SignerCLIConfig.TLSConfig.TLSKey)
+	require.Nil(t, cfg.SignerCLIConfig.Check())
+}
+
+func TestInitTxmgrConfigsFromCliPrivateKey(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	var cfg *txmgr.CLIConfig
+
+	app := cli.NewApp()
+	app.Flags = flags.TxmgrFlags
+	app.Action = func(c *cli.Context) error {
+		cfg = InitTxmgrConfigsFromCli("ws://localhost:8546", privateKey, c)
+		return nil
+	}
+
+	require.Nil(t, app.Run([]string{"TestInitTxmgrConfigsFromCliPrivateKey"}))
+
+	require.Equal(t, common.Bytes2Hex(crypto.FromECDSA(privateKey)), cfg.PrivateKey)
+	require.False(t, cfg.SignerCLIConfig.Enabled())
+	require.False(t, cfg.SignerCLIConfig.TLSConfig.TLSEnabled())
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/api/client/beacon"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/blob"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/config"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/tracing"
 )
 
 var (
@@ -86,7 +88,10 @@ func NewBeaconClient(endpoint string, timeout time.Duration) (*BeaconClient, err
 }
 
 // GetBlobs returns the sidecars for a given slot.
-func (c *BeaconClient) GetBlobs(ctx context.Context, time uint64) ([]*blob.Sidecar, error) {
+func (c *BeaconClient) GetBlobs(ctx context.Context, time uint64) (_ []*blob.Sidecar, err error) {
+	ctx, span := tracing.Start(ctx, "BeaconClient.GetBlobs")
+	defer func() { tracing.End(span, err) }()
+
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
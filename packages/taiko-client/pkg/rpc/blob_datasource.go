@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"net/url"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -12,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/blob"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/tracing"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg"
 )
 
@@ -76,15 +78,15 @@ func (p *BlobServerResponse) UnmarshalJSON(data []byte) error {
 func (ds *BlobDataSource) GetBlobs(
 	ctx context.Context,
 	meta *bindings.TaikoDataBlockMetadata,
-) ([]*blob.Sidecar, error) {
+) (_ []*blob.Sidecar, err error) {
+	ctx, span := tracing.Start(ctx, "BlobDataSource.GetBlobs", tracing.BlockID(new(big.Int).SetUint64(meta.Id)))
+	defer func() { tracing.End(span, err) }()
+
 	if !meta.BlobUsed {
 		return nil, pkg.ErrBlobUnused
 	}
 
-	var (
-		sidecars []*blob.Sidecar
-		err      error
-	)
+	var sidecars []*blob.Sidecar
 	if ds.client.L1Beacon == nil {
 		sidecars, err = nil, pkg.ErrBeaconNotFound
 	} else {
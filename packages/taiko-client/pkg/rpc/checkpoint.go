@@ -0,0 +1,109 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// CheckPoints contains the L2 check point clients, the primary L2CheckPoint first, followed by the
+// fallback ones. The fallback endpoints which could not be reached are dialed again each time the
+// clients are used, so a check point which is down at startup can still be used later.
+type CheckPoints struct {
+	mu        sync.Mutex
+	primary   *EthClient
+	endpoints []string
+	// clients[i] is the client of endpoints[i], nil means it's not connected yet.
+	clients []*EthClient
+	timeout time.Duration
+}
+
+// NewCheckPoints creates the L2 check point clients, and tries to connect to all the given fallback
+// endpoints once.
+func NewCheckPoints(ctx context.Context, primary *EthClient, endpoints []string, timeout time.Duration) *CheckPoints {
+	c := &CheckPoints{
+		primary:   primary,
+		endpoints: endpoints,
+		clients:   make([]*EthClient, len(endpoints)),
+		timeout:   timeout,
+	}
+	c.dial(ctx)
+
+	return c
+}
+
+// Clients returns the clients of all reachable L2 check points, the primary one first. The fallback
+// endpoints not connected yet are dialed again.
+func (c *CheckPoints) Clients(ctx context.Context) []*EthClient {
+	if c == nil || c.primary == nil {
+		return nil
+	}
+
+	c.dial(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	clients := []*EthClient{c.primary}
+	for _, client := range c.clients {
+		if client != nil {
+			clients = append(clients, client)
+		}
+	}
+
+	return clients
+}
+
+// BlockNumber returns the head block number of the first L2 check point which responds.
+func (c *CheckPoints) BlockNumber(ctx context.Context) (uint64, error) {
+	lastErr := errors.New("no L2 check point available")
+	for i, client := range c.Clients(ctx) {
+		blockNumber, err := client.BlockNumber(ctx)
+		if err != nil {
+			log.Warn("Failed to fetch head block number from L2 check point", "index", i, "error", err)
+			lastErr = err
+			continue
+		}
+
+		return blockNumber, nil
+	}
+
+	return 0, fmt.Errorf("failed to get head block number from all L2 check points: %w", lastErr)
+}
+
+// dial connects to the fallback endpoints which are not connected yet, the lock is not held while
+// dialing, so a slow endpoint doesn't block the readers.
+func (c *CheckPoints) dial(ctx context.Context) {
+	if c.primary == nil {
+		return
+	}
+
+	c.mu.Lock()
+	var pending []int
+	for i, client := range c.clients {
+		if client == nil {
+			pending = append(pending, i)
+		}
+	}
+	c.mu.Unlock()
+
+	for _, i := range pending {
+		client, err := NewEthClient(ctx, c.endpoints[i], c.timeout)
+		if err != nil {
+			log.Warn("Failed to connect to L2 checkpoint fallback endpoint", "endpoint", c.endpoints[i], "err", err)
+			continue
+		}
+
+		c.mu.Lock()
+		if c.clients[i] == nil {
+			c.clients[i] = client
+		} else {
+			client.Close()
+		}
+		c.mu.Unlock()
+	}
+}
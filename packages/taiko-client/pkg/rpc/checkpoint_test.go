@@ -0,0 +1,87 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestCheckPointServer starts a JSON-RPC server which returns the given head block number,
+// or fails all requests while it's down.
+func newTestCheckPointServer(t *testing.T, blockNumber string, up *atomic.Bool) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !up.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&req))
+
+		result := "0x28c61"
+		if req.Method == "eth_blockNumber" {
+			result = blockNumber
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		require.Nil(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"result":  result,
+		}))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestCheckPointsBlockNumberFallback(t *testing.T) {
+	var primaryUp, fallbackUp atomic.Bool
+	primaryUp.Store(true)
+
+	primarySrv := newTestCheckPointServer(t, "0x1", &primaryUp)
+	fallbackSrv := newTestCheckPointServer(t, "0x2", &fallbackUp)
+
+	primary, err := NewEthClient(context.Background(), primarySrv.URL, time.Second)
+	require.Nil(t, err)
+
+	// The fallback endpoint is down at startup.
+	checkPoints := NewCheckPoints(context.Background(), primary, []string{fallbackSrv.URL}, time.Second)
+	require.Len(t, checkPoints.Clients(context.Background()), 1)
+
+	blockNumber, err := checkPoints.BlockNumber(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), blockNumber)
+
+	// Once it's up, it's dialed again, and used when the primary one fails.
+	fallbackUp.Store(true)
+	primaryUp.Store(false)
+
+	require.Len(t, checkPoints.Clients(context.Background()), 2)
+
+	blockNumber, err = checkPoints.BlockNumber(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), blockNumber)
+
+	fallbackUp.Store(false)
+
+	_, err = checkPoints.BlockNumber(context.Background())
+	require.ErrorContains(t, err, "failed to get head block number from all L2 check points")
+}
+
+func TestCheckPointsNoPrimary(t *testing.T) {
+	checkPoints := NewCheckPoints(context.Background(), nil, []string{"http://localhost:1"}, time.Second)
+	require.Empty(t, checkPoints.Clients(context.Background()))
+
+	_, err := checkPoints.BlockNumber(context.Background())
+	require.ErrorContains(t, err, "no L2 check point available")
+}
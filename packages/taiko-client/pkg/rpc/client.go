@@ -22,6 +22,8 @@ type Client struct {
 	L1           *EthClient
 	L2           *EthClient
 	L2CheckPoint *EthClient
+	// All L2 check point clients, the first one is always L2CheckPoint
+	L2CheckPoints *CheckPoints
 	// Geth Engine API clients
 	L2Engine *EngineClient
 	// Beacon clients
@@ -43,6 +45,7 @@ type ClientConfig struct {
 	L2Endpoint                    string
 	L1BeaconEndpoint              string
 	L2CheckPoint                  string
+	L2CheckPointFallbacks         []string
 	TaikoL1Address                common.Address
 	TaikoL2Address                common.Address
 	TaikoTokenAddress             common.Address
@@ -155,6 +158,7 @@ func NewClient(ctx context.Context, cfg *ClientConfig) (*Client, error) {
 		L1Beacon:               l1BeaconClient,
 		L2:                     l2Client,
 		L2CheckPoint:           l2CheckPoint,
+		L2CheckPoints:          NewCheckPoints(ctxWithTimeout, l2CheckPoint, cfg.L2CheckPointFallbacks, cfg.Timeout),
 		L2Engine:               l2AuthClient,
 		TaikoL1:                taikoL1,
 		TaikoL2:                taikoL2,
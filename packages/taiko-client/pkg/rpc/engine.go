@@ -10,6 +10,8 @@ import (
 	"github.com/ethereum/go-ethereum/miner"
 	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/tracing"
 )
 
 // EngineClient represents a RPC client connecting to an Ethereum Engine API
@@ -39,7 +41,10 @@ func (c *EngineClient) ForkchoiceUpdate(
 	ctx context.Context,
 	fc *engine.ForkchoiceStateV1,
 	attributes *engine.PayloadAttributes,
-) (*engine.ForkChoiceResponse, error) {
+) (_ *engine.ForkChoiceResponse, err error) {
+	ctx, span := tracing.Start(ctx, "EngineClient.ForkchoiceUpdate")
+	defer func() { tracing.End(span, err) }()
+
 	timeoutCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
 	defer cancel()
 
@@ -55,7 +60,10 @@ func (c *EngineClient) ForkchoiceUpdate(
 func (c *EngineClient) NewPayload(
 	ctx context.Context,
 	payload *engine.ExecutableData,
-) (*engine.PayloadStatusV1, error) {
+) (_ *engine.PayloadStatusV1, err error) {
+	ctx, span := tracing.Start(ctx, "EngineClient.NewPayload")
+	defer func() { tracing.End(span, err) }()
+
 	timeoutCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
 	defer cancel()
 
@@ -71,7 +79,10 @@ func (c *EngineClient) NewPayload(
 func (c *EngineClient) GetPayload(
 	ctx context.Context,
 	payloadID *engine.PayloadID,
-) (*engine.ExecutableData, error) {
+) (_ *engine.ExecutableData, err error) {
+	ctx, span := tracing.Start(ctx, "EngineClient.GetPayload")
+	defer func() { tracing.End(span, err) }()
+
 	timeoutCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
 	defer cancel()
 
@@ -108,7 +119,10 @@ func (c *EngineClient) TxPoolContent(
 	maxBytesPerTxList uint64,
 	locals []string,
 	maxTransactionsLists uint64,
-) ([]*miner.PreBuiltTxList, error) {
+) (_ []*miner.PreBuiltTxList, err error) {
+	ctx, span := tracing.Start(ctx, "EngineClient.TxPoolContent")
+	defer func() { tracing.End(span, err) }()
+
 	timeoutCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
 	defer cancel()
 
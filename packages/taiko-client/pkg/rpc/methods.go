@@ -19,6 +19,7 @@ import (
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/tracing"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/utils"
 )
 
@@ -41,10 +42,11 @@ func (c *Client) ensureGenesisMatched(ctx context.Context) error {
 		return err
 	}
 
-	// Fetch the genesis `BlockVerified` event.
+	// Fetch the genesis `BlockVerified` event, note that the topics encoding modifies the given
+	// block IDs in place, so the shared common.Big0 can't be used here.
 	iter, err := c.TaikoL1.FilterBlockVerified(
 		&bind.FilterOpts{Start: stateVars.A.GenesisHeight, End: &stateVars.A.GenesisHeight, Context: ctxWithTimeout},
-		[]*big.Int{common.Big0},
+		[]*big.Int{new(big.Int)},
 		nil,
 	)
 	if err != nil {
@@ -93,7 +95,7 @@ func (c *Client) WaitTillL2ExecutionEngineSynced(ctx context.Context) error {
 				return err
 			}
 
-			if progress.isSyncing() {
+			if progress.IsSyncing() {
 				log.Info(
 					"L2 execution engine is syncing",
 					"currentBlockID", progress.CurrentBlockID,
@@ -163,7 +165,10 @@ func (c *Client) GetGenesisL1Header(ctx context.Context) (*types.Header, error)
 
 // L2ParentByBlockID fetches the block header from L2 execution engine with the largest block id that
 // smaller than the given `blockId`.
-func (c *Client) L2ParentByBlockID(ctx context.Context, blockID *big.Int) (*types.Header, error) {
+func (c *Client) L2ParentByBlockID(ctx context.Context, blockID *big.Int) (_ *types.Header, err error) {
+	ctx, span := tracing.Start(ctx, "Client.L2ParentByBlockID", tracing.BlockID(blockID))
+	defer func() { tracing.End(span, err) }()
+
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, defaultTimeout)
 	defer cancel()
 
@@ -203,12 +208,14 @@ func (c *Client) L2ParentByBlockID(ctx context.Context, blockID *big.Int) (*type
 	return c.L2.HeaderByHash(ctxWithTimeout, parentHash)
 }
 
-func (c *Client) WaitL2Header(ctx context.Context, blockID *big.Int) (*types.Header, error) {
+func (c *Client) WaitL2Header(ctx context.Context, blockID *big.Int) (_ *types.Header, err error) {
+	ctx, span := tracing.Start(ctx, "Client.WaitL2Header", tracing.BlockID(blockID))
+	defer func() { tracing.End(span, err) }()
+
 	var (
 		ctxWithTimeout = ctx
 		cancel         context.CancelFunc
 		header         *types.Header
-		err            error
 	)
 
 	ticker := time.NewTicker(rpcPollingInterval)
@@ -255,7 +262,10 @@ func (c *Client) GetPoolContent(
 	maxBytesPerTxList uint64,
 	locals []common.Address,
 	maxTransactionsLists uint64,
-) ([]*miner.PreBuiltTxList, error) {
+) (_ []*miner.PreBuiltTxList, err error) {
+	ctx, span := tracing.Start(ctx, "Client.GetPoolContent")
+	defer func() { tracing.End(span, err) }()
+
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, defaultTimeout)
 	defer cancel()
 
@@ -318,8 +328,8 @@ type L2SyncProgress struct {
 	HighestBlockID *big.Int
 }
 
-// isSyncing returns true if the L2 execution engine is syncing with L1.
-func (p *L2SyncProgress) isSyncing() bool {
+// IsSyncing returns true if the L2 execution engine is syncing with L1.
+func (p *L2SyncProgress) IsSyncing() bool {
 	if p.SyncProgress == nil {
 		return false
 	}
@@ -399,7 +409,10 @@ func (c *Client) GetProtocolStateVariables(opts *bind.CallOpts) (*struct {
 }
 
 // GetL2BlockInfo fetches the L2 block information from the protocol.
-func (c *Client) GetL2BlockInfo(ctx context.Context, blockID *big.Int) (bindings.TaikoDataBlock, error) {
+func (c *Client) GetL2BlockInfo(ctx context.Context, blockID *big.Int) (_ bindings.TaikoDataBlock, err error) {
+	ctx, span := tracing.Start(ctx, "Client.GetL2BlockInfo", tracing.BlockID(blockID))
+	defer func() { tracing.End(span, err) }()
+
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, defaultTimeout)
 	defer cancel()
 
@@ -0,0 +1,305 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
+	"github.com/ethereum-optimism/optimism/op-service/tls/certman"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid signature returned by the remote signer")
+	ErrNoSigner         = errors.New("either a private key or a remote signer endpoint must be set")
+	ErrMultipleSigners  = errors.New("a private key and a remote signer endpoint can not be set at the same time")
+
+	pingTimeout = 3 * time.Second
+)
+
+// Signer signs the keccak256 hashes of the given messages on behalf of an L1 account, without any
+// prefix, the returned signatures are in the [R || S || V] format, where V is 0 or 1, same as crypto.Sign.
+type Signer interface {
+	Address() common.Address
+	Sign(ctx context.Context, data []byte) ([]byte, error)
+}
+
+// New creates a new Signer instance, if a remote signer endpoint is given, a RemoteSigner will be
+// created, otherwise a LocalSigner of the given private key will be used.
+func New(
+	ctx context.Context,
+	privateKey *ecdsa.PrivateKey,
+	remoteEndpoint string,
+	remoteAddress common.Address,
+	remoteTLSConfig optls.CLIConfig,
+) (Signer, error) {
+	if privateKey != nil && remoteEndpoint != "" {
+		return nil, ErrMultipleSigners
+	}
+
+	if remoteEndpoint != "" {
+		httpClient, err := NewHTTPClient(remoteTLSConfig)
+		if err != nil {
+			return nil, err
+		}
+		return NewRemoteSigner(ctx, remoteEndpoint, remoteAddress, httpClient)
+	}
+
+	if privateKey == nil {
+		return nil, ErrNoSigner
+	}
+
+	return NewLocalSigner(privateKey), nil
+}
+
+// LocalSigner signs the messages with a private key held in memory.
+type LocalSigner struct {
+	privateKey *ecdsa.PrivateKey
+	address    common.Address
+}
+
+// NewLocalSigner creates a new LocalSigner instance.
+func NewLocalSigner(privateKey *ecdsa.PrivateKey) *LocalSigner {
+	return &LocalSigner{privateKey: privateKey, address: crypto.PubkeyToAddress(privateKey.PublicKey)}
+}
+
+// Address implements the Signer interface.
+func (s *LocalSigner) Address() common.Address {
+	return s.address
+}
+
+// Sign implements the Signer interface.
+func (s *LocalSigner) Sign(_ context.Context, data []byte) ([]byte, error) {
+	return crypto.Sign(crypto.Keccak256(data), s.privateKey)
+}
+
+// NewHTTPClient creates the HTTP client used to reach the remote signer, if the TLS configs are set,
+// the client authenticates itself with the given certificate, which is reloaded when it's rotated,
+// and only trusts the signers with a certificate issued by the given CA.
+func NewHTTPClient(tlsConfig optls.CLIConfig) (*http.Client, error) {
+	if !tlsConfig.TLSEnabled() {
+		return http.DefaultClient, nil
+	}
+	if err := tlsConfig.Check(); err != nil {
+		return nil, err
+	}
+
+	caCert, err := os.ReadFile(tlsConfig.TLSCaCert)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read remote signer TLS CA certificate: %w", err)
+	}
+	caCertPool := x509.NewCertPool()
+	if !caCertPool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("invalid remote signer TLS CA certificate: %s", tlsConfig.TLSCaCert)
+	}
+
+	cm, err := certman.New(log.Root(), tlsConfig.TLSCert, tlsConfig.TLSKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read remote signer TLS certificate: %w", err)
+	}
+	if err := cm.Watch(); err != nil {
+		return nil, fmt.Errorf("failed to watch remote signer TLS certificate: %w", err)
+	}
+
+	return &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				MinVersion:           tls.VersionTLS13,
+				RootCAs:              caCertPool,
+				GetClientCertificate: cm.GetClientCertificate,
+			},
+		},
+	}, nil
+}
+
+// RemoteSigner signs the messages through a remote Web3Signer, so that the private key never needs
+// to be present on the host. The remote signer is expected to serve the following APIs:
+//   - health_status JSON-RPC method, used to check whether the signer is reachable.
+//   - eth_signTransaction JSON-RPC method, used by the transaction manager to sign the L1 transactions.
+//   - GET /api/v1/eth1/publicKeys, which lists the public keys of the managed accounts.
+//   - POST /api/v1/eth1/sign/{publicKey}, which signs the keccak256 hash of the given data, without
+//     any prefix, so the signatures can still be verified by the protocol contracts, while the signer
+//     always sees the message it signs.
+type RemoteSigner struct {
+	client     *rpc.Client
+	httpClient *http.Client
+	endpoint   string
+	address    common.Address
+	publicKey  string
+}
+
+// NewRemoteSigner creates a new RemoteSigner instance, checks whether the remote signer is reachable,
+// and whether it manages the given account.
+func NewRemoteSigner(
+	ctx context.Context,
+	endpoint string,
+	address common.Address,
+	httpClient *http.Client,
+) (*RemoteSigner, error) {
+	if address == (common.Address{}) {
+		return nil, errors.New("empty remote signer address")
+	}
+
+	client, err := rpc.DialOptions(ctx, endpoint, rpc.WithHTTPClient(httpClient))
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial remote signer: %w", err)
+	}
+
+	s := &RemoteSigner{
+		client:     client,
+		httpClient: httpClient,
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		address:    address,
+	}
+
+	version, err := s.ping(ctx)
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("remote signer is not reachable: %w", err)
+	}
+
+	if s.publicKey, err = s.findPublicKey(ctx); err != nil {
+		client.Close()
+		return nil, err
+	}
+
+	log.Info("Remote signer connected", "endpoint", endpoint, "address", address, "version", version)
+
+	return s, nil
+}
+
+// ping checks the status of the remote signer.
+func (s *RemoteSigner) ping(ctx context.Context) (string, error) {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, pingTimeout)
+	defer cancel()
+
+	var version string
+	if err := s.client.CallContext(ctxWithTimeout, &version, "health_status"); err != nil {
+		return "", err
+	}
+
+	return version, nil
+}
+
+// findPublicKey returns the public key managed by the remote signer of the expected account.
+func (s *RemoteSigner) findPublicKey(ctx context.Context) (string, error) {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, pingTimeout)
+	defer cancel()
+
+	body, err := s.do(ctxWithTimeout, http.MethodGet, "/api/v1/eth1/publicKeys", nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to list remote signer public keys: %w", err)
+	}
+
+	var publicKeys []string
+	if err := json.Unmarshal(body, &publicKeys); err != nil {
+		return "", fmt.Errorf("invalid remote signer public keys: %w", err)
+	}
+
+	for _, publicKey := range publicKeys {
+		raw, err := hexutil.Decode(publicKey)
+		if err != nil {
+			continue
+		}
+		// The uncompressed public keys may be returned without the 0x04 prefix.
+		if len(raw) == 64 {
+			raw = append([]byte{0x04}, raw...)
+		}
+		pubKey, err := crypto.UnmarshalPubkey(raw)
+		if err != nil {
+			continue
+		}
+		if crypto.PubkeyToAddress(*pubKey) == s.address {
+			return publicKey, nil
+		}
+	}
+
+	return "", fmt.Errorf("account %s is not managed by the remote signer", s.address)
+}
+
+// Address implements the Signer interface.
+func (s *RemoteSigner) Address() common.Address {
+	return s.address
+}
+
+// Sign implements the Signer interface, it also makes sure the returned signature is
+// signed by the expected account.
+func (s *RemoteSigner) Sign(ctx context.Context, data []byte) ([]byte, error) {
+	payload, err := json.Marshal(map[string]string{"data": hexutil.Encode(data)})
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := s.do(ctx, http.MethodPost, "/api/v1/eth1/sign/"+s.publicKey, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign with remote signer: %w", err)
+	}
+
+	result, err := hexutil.Decode(strings.Trim(strings.TrimSpace(string(body)), `"`))
+	if err != nil || len(result) != crypto.SignatureLength {
+		return nil, ErrInvalidSignature
+	}
+
+	// Some signers return the recovery ID in the legacy Ethereum format (27 / 28).
+	sig := common.CopyBytes(result)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(crypto.Keccak256(data), sig)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSignature, err)
+	}
+	if signer := crypto.PubkeyToAddress(*pubKey); signer != s.address {
+		return nil, fmt.Errorf("%w: signed by %s, expected %s", ErrInvalidSignature, signer, s.address)
+	}
+
+	return sig, nil
+}
+
+// do sends a request to the REST API of the remote signer, and returns the response body.
+func (s *RemoteSigner) do(ctx context.Context, method string, path string, payload []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.endpoint+path, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d: %s", res.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	return body, nil
+}
+
+// Close closes the underlying RPC client.
+func (s *RemoteSigner) Close() {
+	s.client.Close()
+}
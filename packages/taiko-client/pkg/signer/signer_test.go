@@ -0,0 +1,233 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	opcrypto "github.com/ethereum-optimism/optimism/op-service/crypto"
+	opsigner "github.com/ethereum-optimism/optimism/op-service/signer"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeHealthAPI serves the health_* namespace of the fake remote signer.
+type fakeHealthAPI struct{}
+
+func (api *fakeHealthAPI) Status() string { return "fake" }
+
+// fakeEth1API serves the Web3Signer eth1 REST API of the fake remote signer.
+type fakeEth1API struct {
+	key         *ecdsa.PrivateKey
+	legacyV     bool
+	wrongSigner *ecdsa.PrivateKey
+}
+
+func (api *fakeEth1API) publicKey() string {
+	return hexutil.Encode(crypto.FromECDSAPub(&api.key.PublicKey)[1:])
+}
+
+func (api *fakeEth1API) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodGet && r.URL.Path == "/api/v1/eth1/publicKeys" {
+		_ = json.NewEncoder(w).Encode([]string{api.publicKey()})
+		return
+	}
+	if r.Method != http.MethodPost || r.URL.Path != "/api/v1/eth1/sign/"+api.publicKey() {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	var req struct {
+		Data hexutil.Bytes `json:"data"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	key := api.key
+	if api.wrongSigner != nil {
+		key = api.wrongSigner
+	}
+	sig, err := crypto.Sign(crypto.Keccak256(req.Data), key)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if api.legacyV {
+		sig[crypto.RecoveryIDOffset] += 27
+	}
+	_, _ = w.Write([]byte(hexutil.Encode(sig)))
+}
+
+// fakeEthAPI serves the eth_* namespace of the fake remote signer.
+type fakeEthAPI struct {
+	key *ecdsa.PrivateKey
+}
+
+func (api *fakeEthAPI) SignTransaction(args opsigner.TransactionArgs) (hexutil.Bytes, error) {
+	data, err := args.ToTransactionData()
+	if err != nil {
+		return nil, err
+	}
+	tx, err := types.SignNewTx(api.key, types.LatestSignerForChainID(args.ChainID.ToInt()), data)
+	if err != nil {
+		return nil, err
+	}
+	return tx.MarshalBinary()
+}
+
+func newFakeRemoteSigner(t *testing.T, eth1 *fakeEth1API) string {
+	server := rpc.NewServer()
+	require.Nil(t, server.RegisterName("health", new(fakeHealthAPI)))
+	require.Nil(t, server.RegisterName("eth", &fakeEthAPI{key: eth1.key}))
+
+	mux := http.NewServeMux()
+	mux.Handle("/", server)
+	mux.Handle("/api/v1/eth1/", eth1)
+
+	httpServer := httptest.NewServer(mux)
+	t.Cleanup(func() {
+		httpServer.Close()
+		server.Stop()
+	})
+
+	return httpServer.URL
+}
+
+func TestNew(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	_, err = New(context.Background(), nil, "", common.Address{}, optls.CLIConfig{})
+	require.ErrorIs(t, err, ErrNoSigner)
+
+	_, err = New(context.Background(), key, "http://localhost:1", crypto.PubkeyToAddress(key.PublicKey), optls.CLIConfig{})
+	require.ErrorIs(t, err, ErrMultipleSigners)
+
+	s, err := New(context.Background(), key, "", common.Address{}, optls.CLIConfig{})
+	require.Nil(t, err)
+	require.IsType(t, &LocalSigner{}, s)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), s.Address())
+
+	endpoint := newFakeRemoteSigner(t, &fakeEth1API{key: key})
+	s, err = New(context.Background(), nil, endpoint, crypto.PubkeyToAddress(key.PublicKey), optls.CLIConfig{})
+	require.Nil(t, err)
+	require.IsType(t, &RemoteSigner{}, s)
+}
+
+func TestRemoteSignerSign(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+	data := []byte("HEART_BEAT")
+
+	expected, err := NewLocalSigner(key).Sign(context.Background(), data)
+	require.Nil(t, err)
+
+	for _, legacyV := range []bool{false, true} {
+		endpoint := newFakeRemoteSigner(t, &fakeEth1API{key: key, legacyV: legacyV})
+
+		s, err := NewRemoteSigner(context.Background(), endpoint, crypto.PubkeyToAddress(key.PublicKey), http.DefaultClient)
+		require.Nil(t, err)
+
+		sig, err := s.Sign(context.Background(), data)
+		require.Nil(t, err)
+		require.Equal(t, expected, sig)
+		s.Close()
+	}
+}
+
+func TestRemoteSignerWrongSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+	anotherKey, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	endpoint := newFakeRemoteSigner(t, &fakeEth1API{key: key, wrongSigner: anotherKey})
+
+	s, err := NewRemoteSigner(context.Background(), endpoint, crypto.PubkeyToAddress(key.PublicKey), http.DefaultClient)
+	require.Nil(t, err)
+	defer s.Close()
+
+	_, err = s.Sign(context.Background(), []byte("HEART_BEAT"))
+	require.ErrorIs(t, err, ErrInvalidSignature)
+}
+
+func TestRemoteSignerUnknownAccount(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	endpoint := newFakeRemoteSigner(t, &fakeEth1API{key: key})
+
+	_, err = NewRemoteSigner(context.Background(), endpoint, common.HexToAddress("0x01"), http.DefaultClient)
+	require.ErrorContains(t, err, "is not managed by the remote signer")
+}
+
+func TestNewHTTPClient(t *testing.T) {
+	client, err := NewHTTPClient(optls.CLIConfig{})
+	require.Nil(t, err)
+	require.Equal(t, http.DefaultClient, client)
+
+	_, err = NewHTTPClient(optls.CLIConfig{TLSCaCert: "ca.crt"})
+	require.NotNil(t, err)
+
+	_, err = NewHTTPClient(optls.CLIConfig{TLSCaCert: "ca.crt", TLSCert: "tls.crt", TLSKey: "tls.key"})
+	require.ErrorContains(t, err, "failed to read remote signer TLS CA certificate")
+}
+
+func TestRemoteSignerUnreachable(t *testing.T) {
+	_, err := NewRemoteSigner(context.Background(), "http://localhost:1", common.HexToAddress("0x01"), http.DefaultClient)
+	require.NotNil(t, err)
+
+	_, err = NewRemoteSigner(context.Background(), "http://localhost:1", common.Address{}, http.DefaultClient)
+	require.NotNil(t, err)
+}
+
+// TestRemoteSignerTransaction makes sure the same remote signer endpoint can be used by
+// the transaction manager to sign L1 transactions.
+func TestRemoteSignerTransaction(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+
+	endpoint := newFakeRemoteSigner(t, &fakeEth1API{key: key})
+
+	factory, from, err := opcrypto.SignerFactoryFromConfig(
+		log.Root(),
+		"",
+		"",
+		"",
+		opsigner.CLIConfig{Endpoint: endpoint, Address: address.Hex()},
+	)
+	require.Nil(t, err)
+	require.Equal(t, address, from)
+
+	chainID := big.NewInt(167)
+	to := common.HexToAddress("0x02")
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     1,
+		GasTipCap: common.Big1,
+		GasFeeCap: common.Big2,
+		Gas:       21_000,
+		To:        &to,
+		Value:     common.Big0,
+	})
+
+	signed, err := factory(chainID)(context.Background(), address, tx)
+	require.Nil(t, err)
+
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+	require.Nil(t, err)
+	require.Equal(t, address, sender)
+}
@@ -10,7 +10,6 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/urfave/cli/v2"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
@@ -21,11 +20,19 @@ import (
 	pkgFlags "github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/flags"
 )
 
+// Transactions list orderings.
+const (
+	TxListOrderingPool       = "pool"
+	TxListOrderingTipPerByte = "tipPerByte"
+)
+
 // Config contains all configurations to initialize a Taiko proposer.
 type Config struct {
 	*rpc.ClientConfig
 	AssignmentHookAddress      common.Address
 	L1ProposerPrivKey          *ecdsa.PrivateKey
+	RemoteSignerEndpoint       string
+	RemoteSignerAddress        common.Address
 	L2SuggestedFeeRecipient    common.Address
 	ExtraData                  string
 	ProposeInterval            time.Duration
@@ -35,16 +42,27 @@ type Config struct {
 	MinTxListBytes             uint64
 	MinProposingInternal       time.Duration
 	MaxProposedTxListsPerEpoch uint64
+	MaxTxsPerSender            uint64
+	DenyList                   []common.Address
+	TxListOrdering             string
+	BundlesFilePath            string
 	ProposeBlockTxGasLimit     uint64
 	ProverEndpoints            []*url.URL
 	OptimisticTierFee          *big.Int
 	SgxTierFee                 *big.Int
 	TierFeePriceBump           *big.Int
 	MaxTierFeePriceBumps       uint64
+	ProverMarketplace          bool
+	ProverReputationFile       string
 	IncludeParentMetaHash      bool
 	BlobAllowed                bool
+	AdaptiveBlob               bool
 	TxmgrConfigs               *txmgr.CLIConfig
 	L1BlockBuilderTip          *big.Int
+	ProfitabilityCheck         bool
+	ProfitabilityTolerance     uint64
+	ProfitabilityMaxDelay      time.Duration
+	ProfitabilityUtilization   uint64
 }
 
 // NewConfigFromCliContext initializes a Config instance from
@@ -55,9 +73,13 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		return nil, fmt.Errorf("invalid JWT secret file: %w", err)
 	}
 
-	l1ProposerPrivKey, err := crypto.ToECDSA(common.FromHex(c.String(flags.L1ProposerPrivKey.Name)))
+	l1ProposerPrivKey, remoteSignerEndpoint, remoteSignerAddress, err := pkgFlags.ParsePrivKeyOrRemoteSigner(
+		c,
+		flags.L1ProposerPrivKey,
+		"L1 proposer private key",
+	)
 	if err != nil {
-		return nil, fmt.Errorf("invalid L1 proposer private key: %w", err)
+		return nil, err
 	}
 
 	l2SuggestedFeeRecipient := c.String(flags.L2SuggestedFeeRecipient.Name)
@@ -68,13 +90,31 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 	var localAddresses []common.Address
 	if c.IsSet(flags.TxPoolLocals.Name) {
 		for _, account := range strings.Split(c.String(flags.TxPoolLocals.Name), ",") {
-			if trimmed := strings.TrimSpace(account); !common.IsHexAddress(trimmed) {
+			trimmed := strings.TrimSpace(account)
+			if !common.IsHexAddress(trimmed) {
 				return nil, fmt.Errorf("invalid account in --txpool.locals: %s", trimmed)
 			}
-			localAddresses = append(localAddresses, common.HexToAddress(account))
+			localAddresses = append(localAddresses, common.HexToAddress(trimmed))
 		}
 	}
 
+	var denyList []common.Address
+	for _, account := range c.StringSlice(flags.TxPoolDenyList.Name) {
+		trimmed := strings.TrimSpace(account)
+		if !common.IsHexAddress(trimmed) {
+			return nil, fmt.Errorf("invalid account in --txPool.denyList: %s", trimmed)
+		}
+		denyList = append(denyList, common.HexToAddress(trimmed))
+	}
+
+	txListOrdering := c.String(flags.TxPoolOrdering.Name)
+	if txListOrdering == "" {
+		txListOrdering = TxListOrderingPool
+	}
+	if txListOrdering != TxListOrderingPool && txListOrdering != TxListOrderingTipPerByte {
+		return nil, fmt.Errorf("invalid transactions list ordering: %s", txListOrdering)
+	}
+
 	var proverEndpoints []*url.URL
 	for _, e := range strings.Split(c.String(flags.ProverEndpoints.Name), ",") {
 		endpoint, err := url.Parse(e)
@@ -84,6 +124,16 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		proverEndpoints = append(proverEndpoints, endpoint)
 	}
 
+	if c.Bool(flags.AdaptiveBlob.Name) && !c.Bool(flags.BlobAllowed.Name) {
+		return nil, fmt.Errorf("--%s requires --%s to be set", flags.AdaptiveBlob.Name, flags.BlobAllowed.Name)
+	}
+
+	if c.Bool(flags.ProfitabilityCheck.Name) {
+		if gasUtilization := c.Uint64(flags.ProfitabilityGasUtilization.Name); gasUtilization == 0 || gasUtilization > 100 {
+			return nil, fmt.Errorf("invalid --%s: %d", flags.ProfitabilityGasUtilization.Name, gasUtilization)
+		}
+	}
+
 	optimisticTierFee, err := utils.GWeiToWei(c.Float64(flags.OptimisticTierFee.Name))
 	if err != nil {
 		return nil, err
@@ -107,6 +157,8 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		},
 		AssignmentHookAddress:      common.HexToAddress(c.String(flags.AssignmentHookAddress.Name)),
 		L1ProposerPrivKey:          l1ProposerPrivKey,
+		RemoteSignerEndpoint:       remoteSignerEndpoint,
+		RemoteSignerAddress:        remoteSignerAddress,
 		L2SuggestedFeeRecipient:    common.HexToAddress(l2SuggestedFeeRecipient),
 		ExtraData:                  c.String(flags.ExtraData.Name),
 		ProposeInterval:            c.Duration(flags.ProposeInterval.Name),
@@ -116,15 +168,26 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		MinTxListBytes:             c.Uint64(flags.MinTxListBytes.Name),
 		MinProposingInternal:       c.Duration(flags.MinProposingInternal.Name),
 		MaxProposedTxListsPerEpoch: c.Uint64(flags.MaxProposedTxListsPerEpoch.Name),
+		MaxTxsPerSender:            c.Uint64(flags.MaxTxsPerSender.Name),
+		DenyList:                   denyList,
+		TxListOrdering:             txListOrdering,
+		BundlesFilePath:            c.String(flags.TxPoolBundles.Name),
 		ProposeBlockTxGasLimit:     c.Uint64(flags.TxGasLimit.Name),
 		ProverEndpoints:            proverEndpoints,
 		OptimisticTierFee:          optimisticTierFee,
 		SgxTierFee:                 sgxTierFee,
 		TierFeePriceBump:           new(big.Int).SetUint64(c.Uint64(flags.TierFeePriceBump.Name)),
 		MaxTierFeePriceBumps:       c.Uint64(flags.MaxTierFeePriceBumps.Name),
+		ProverMarketplace:          c.Bool(flags.ProverMarketplace.Name),
+		ProverReputationFile:       c.String(flags.ProverReputationFile.Name),
 		IncludeParentMetaHash:      c.Bool(flags.ProposeBlockIncludeParentMetaHash.Name),
 		BlobAllowed:                c.Bool(flags.BlobAllowed.Name),
+		AdaptiveBlob:               c.Bool(flags.AdaptiveBlob.Name),
 		L1BlockBuilderTip:          new(big.Int).SetUint64(c.Uint64(flags.L1BlockBuilderTip.Name)),
+		ProfitabilityCheck:         c.Bool(flags.ProfitabilityCheck.Name),
+		ProfitabilityTolerance:     c.Uint64(flags.ProfitabilityTolerance.Name),
+		ProfitabilityMaxDelay:      c.Duration(flags.ProfitabilityMaxDelay.Name),
+		ProfitabilityUtilization:   c.Uint64(flags.ProfitabilityGasUtilization.Name),
 		TxmgrConfigs: pkgFlags.InitTxmgrConfigsFromCli(
 			c.String(flags.L1WSEndpoint.Name),
 			l1ProposerPrivKey,
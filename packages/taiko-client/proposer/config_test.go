@@ -123,6 +123,111 @@ func (s *ProposerTestSuite) TestNewConfigFromCliContextTxPoolLocalsErr() {
 	}), "invalid account in --txpool.locals")
 }
 
+func (s *ProposerTestSuite) TestNewConfigFromCliContextTxPoolAccountsTrimmed() {
+	goldenTouchAddress, err := s.RPCClient.TaikoL2.GOLDENTOUCHADDRESS(nil)
+	s.Nil(err)
+
+	app := s.SetupApp()
+
+	app.Action = func(cliCtx *cli.Context) error {
+		c, err := NewConfigFromCliContext(cliCtx)
+		s.Nil(err)
+		s.Equal([]common.Address{goldenTouchAddress}, c.LocalAddresses)
+		s.Equal([]common.Address{goldenTouchAddress}, c.DenyList)
+		return nil
+	}
+
+	s.Nil(app.Run([]string{
+		"TestNewConfigFromCliContextTxPoolAccountsTrimmed",
+		"--" + flags.L1ProposerPrivKey.Name, encoding.GoldenTouchPrivKey,
+		"--" + flags.L2SuggestedFeeRecipient.Name, goldenTouchAddress.Hex(),
+		"--" + flags.TxPoolLocals.Name, " " + goldenTouchAddress.Hex() + " ",
+		"--" + flags.TxPoolDenyList.Name, " " + goldenTouchAddress.Hex() + " ",
+	}))
+}
+
+func (s *ProposerTestSuite) TestNewConfigFromCliContextTxPoolDenyListErr() {
+	goldenTouchAddress, err := s.RPCClient.TaikoL2.GOLDENTOUCHADDRESS(nil)
+	s.Nil(err)
+
+	app := s.SetupApp()
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextTxPoolDenyListErr",
+		"--" + flags.L1ProposerPrivKey.Name, encoding.GoldenTouchPrivKey,
+		"--" + flags.L2SuggestedFeeRecipient.Name, goldenTouchAddress.Hex(),
+		"--" + flags.TxPoolDenyList.Name, "notAnAddress",
+	}), "invalid account in --txPool.denyList")
+}
+
+func (s *ProposerTestSuite) TestNewConfigFromCliContextTxPoolOrderingErr() {
+	goldenTouchAddress, err := s.RPCClient.TaikoL2.GOLDENTOUCHADDRESS(nil)
+	s.Nil(err)
+
+	app := s.SetupApp()
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextTxPoolOrderingErr",
+		"--" + flags.L1ProposerPrivKey.Name, encoding.GoldenTouchPrivKey,
+		"--" + flags.L2SuggestedFeeRecipient.Name, goldenTouchAddress.Hex(),
+		"--" + flags.TxPoolOrdering.Name, "random",
+	}), "invalid transactions list ordering")
+}
+
+func (s *ProposerTestSuite) TestNewConfigFromCliContextSignerErr() {
+	app := s.SetupApp()
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextSignerErr",
+		"--" + flags.L1ProposerPrivKey.Name, encoding.GoldenTouchPrivKey,
+		"--" + flags.RemoteSignerEndpoint.Name, "http://localhost:9000",
+		"--" + flags.RemoteSignerAddress.Name, common.HexToAddress("0x01").Hex(),
+	}), "exactly one of")
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextSignerErr",
+		"--" + flags.RemoteSignerEndpoint.Name, "http://localhost:9000",
+		"--" + flags.RemoteSignerAddress.Name, "invalid",
+	}), "invalid remote signer address")
+}
+
+func (s *ProposerTestSuite) TestNewConfigFromCliContextProfitabilityGasUtilizationErr() {
+	goldenTouchAddress, err := s.RPCClient.TaikoL2.GOLDENTOUCHADDRESS(nil)
+	s.Nil(err)
+
+	app := s.SetupApp()
+
+	// The gas utilization is only validated when the profitability check is enabled.
+	s.Nil(app.Run([]string{
+		"TestNewConfigFromCliContextProfitabilityGasUtilizationErr",
+		"--" + flags.L1ProposerPrivKey.Name, encoding.GoldenTouchPrivKey,
+		"--" + flags.L2SuggestedFeeRecipient.Name, goldenTouchAddress.Hex(),
+		"--" + flags.ProfitabilityGasUtilization.Name, "0",
+	}))
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextProfitabilityGasUtilizationErr",
+		"--" + flags.L1ProposerPrivKey.Name, encoding.GoldenTouchPrivKey,
+		"--" + flags.L2SuggestedFeeRecipient.Name, goldenTouchAddress.Hex(),
+		"--" + flags.ProfitabilityCheck.Name,
+		"--" + flags.ProfitabilityGasUtilization.Name, "101",
+	}), "invalid --"+flags.ProfitabilityGasUtilization.Name)
+}
+
+func (s *ProposerTestSuite) TestNewConfigFromCliContextAdaptiveBlobErr() {
+	goldenTouchAddress, err := s.RPCClient.TaikoL2.GOLDENTOUCHADDRESS(nil)
+	s.Nil(err)
+
+	app := s.SetupApp()
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextAdaptiveBlobErr",
+		"--" + flags.L1ProposerPrivKey.Name, encoding.GoldenTouchPrivKey,
+		"--" + flags.L2SuggestedFeeRecipient.Name, goldenTouchAddress.Hex(),
+		"--" + flags.AdaptiveBlob.Name,
+	}), "requires --"+flags.BlobAllowed.Name)
+}
+
 func (s *ProposerTestSuite) SetupApp() *cli.App {
 	app := cli.NewApp()
 	app.Flags = []cli.Flag{
@@ -144,6 +249,12 @@ func (s *ProposerTestSuite) SetupApp() *cli.App {
 		&cli.Uint64Flag{Name: flags.MaxTierFeePriceBumps.Name},
 		&cli.BoolFlag{Name: flags.ProposeBlockIncludeParentMetaHash.Name},
 		&cli.StringFlag{Name: flags.AssignmentHookAddress.Name},
+		&cli.StringSliceFlag{Name: flags.TxPoolDenyList.Name},
+		&cli.StringFlag{Name: flags.TxPoolOrdering.Name},
+		&cli.BoolFlag{Name: flags.ProfitabilityCheck.Name},
+		&cli.Uint64Flag{Name: flags.ProfitabilityGasUtilization.Name, Value: flags.ProfitabilityGasUtilization.Value},
+		&cli.BoolFlag{Name: flags.BlobAllowed.Name},
+		&cli.BoolFlag{Name: flags.AdaptiveBlob.Name},
 	}
 	app.Flags = append(app.Flags, flags.TxmgrFlags...)
 	app.Action = func(ctx *cli.Context) error {
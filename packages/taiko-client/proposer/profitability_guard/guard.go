@@ -0,0 +1,237 @@
+package guard
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/utils"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	builder "github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer/transaction_builder"
+)
+
+var (
+	// defaultProposeBlockGasUsed is the estimated gas used by a TaikoL1.proposeBlock transaction without the
+	// calldata cost of its txList bytes, it will be used until a proposal has been sent.
+	defaultProposeBlockGasUsed uint64 = 300_000
+)
+
+// Proposal contains the parameters of a proposal, which is estimated before its TaikoL1.proposeBlock
+// transaction is built, so that no prover is assigned to an unprofitable proposal.
+type Proposal struct {
+	Txs         types.Transactions // L2 transactions to propose
+	TxListBytes []byte             // Compressed txList bytes saved on L1
+	ProverFee   *big.Int           // Maximum fee offered to the provers, after all price bumps
+}
+
+// Estimation contains the estimated revenue and costs of proposing a transactions list.
+type Estimation struct {
+	L2Revenue  *big.Int // Priority fees paid by the L2 transactions, L2 base fee is sent to the treasury
+	L1GasCost  *big.Int // L1 execution cost of the TaikoL1.proposeBlock transaction
+	L1BlobCost *big.Int // L1 blob gas cost of the TaikoL1.proposeBlock transaction
+	ProverFee  *big.Int // Fee paid to the assigned prover
+	Margin     *big.Int // L2Revenue - (L1GasCost + L1BlobCost + ProverFee)
+}
+
+// Cost returns the total cost of proposing the transactions list.
+func (e *Estimation) Cost() *big.Int {
+	return new(big.Int).Add(new(big.Int).Add(e.L1GasCost, e.L1BlobCost), e.ProverFee)
+}
+
+// Config contains the configurations of a ProfitabilityGuard.
+type Config struct {
+	// Percentage of the total cost which is allowed to be not covered by the L2 revenue
+	Tolerance uint64
+	// Maximum time span to delay the unprofitable proposals, after which they will be proposed anyway,
+	// zero means always skipping the unprofitable proposals
+	MaxDelay time.Duration
+	// Percentage of the L2 transactions' gas limits expected to be used by them
+	GasUtilization uint64
+	// Whether the txList bytes are saved in a blob, or in whichever of blob and calldata is cheaper
+	BlobAllowed  bool
+	AdaptiveBlob bool
+}
+
+// ProfitabilityGuard is responsible for checking whether proposing a transactions list is profitable,
+// comparing the L2 revenue with the L1 costs and the prover fee.
+type ProfitabilityGuard struct {
+	rpc *rpc.Client
+	cfg *Config
+	// Gas used by the last TaikoL1.proposeBlock transaction, without the calldata cost of its txList bytes
+	baseGasUsed atomic.Uint64
+}
+
+// New creates a new ProfitabilityGuard instance.
+func New(rpc *rpc.Client, cfg *Config) (*ProfitabilityGuard, error) {
+	if cfg.GasUtilization == 0 || cfg.GasUtilization > 100 {
+		return nil, fmt.Errorf("invalid gas utilization percentage: %d", cfg.GasUtilization)
+	}
+
+	g := &ProfitabilityGuard{rpc: rpc, cfg: cfg}
+	g.baseGasUsed.Store(defaultProposeBlockGasUsed)
+
+	return g, nil
+}
+
+// UpdateGasUsed records the gas used by a sent TaikoL1.proposeBlock transaction, which the gas used by the
+// next proposals is estimated with, the calldata cost of its txList bytes is excluded if they were not
+// saved in a blob.
+func (g *ProfitabilityGuard) UpdateGasUsed(gasUsed uint64, txListBytes []byte, blob bool) {
+	if !blob {
+		calldataGas := builder.CalcCalldataCost(txListBytes, common.Big1).Uint64()
+		if gasUsed <= calldataGas {
+			return
+		}
+		gasUsed -= calldataGas
+	}
+
+	g.baseGasUsed.Store(gasUsed)
+}
+
+// Check estimates the margin of the given proposal, and returns whether it should be proposed now.
+func (g *ProfitabilityGuard) Check(
+	ctx context.Context,
+	proposal *Proposal,
+	lastProposedAt time.Time,
+) (bool, *Estimation, error) {
+	estimation, err := g.Estimate(ctx, proposal)
+	if err != nil {
+		return false, nil, err
+	}
+
+	margin, _ := utils.WeiToEther(estimation.Margin).Float64()
+	metrics.ProposerExpectedMarginGauge.Set(margin)
+
+	if g.acceptable(estimation) {
+		return true, estimation, nil
+	}
+
+	if g.cfg.MaxDelay != 0 && time.Since(lastProposedAt) >= g.cfg.MaxDelay {
+		log.Info(
+			"Unprofitable proposal delayed for too long, proposing anyway",
+			"lastProposedAt", lastProposedAt,
+			"maxDelay", g.cfg.MaxDelay,
+			"margin", utils.WeiToEther(estimation.Margin),
+		)
+		return true, estimation, nil
+	}
+
+	metrics.ProposerUnprofitableSkippedCounter.Add(1)
+
+	return false, estimation, nil
+}
+
+// Estimate estimates the L2 revenue and the costs of the given proposal.
+func (g *ProfitabilityGuard) Estimate(ctx context.Context, proposal *Proposal) (*Estimation, error) {
+	l1Head, err := g.rpc.L1.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch L1 head: %w", err)
+	}
+	if l1Head.BaseFee == nil {
+		return nil, fmt.Errorf("L1 head %d has no base fee", l1Head.Number)
+	}
+
+	l1Tip, err := g.rpc.L1.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch L1 gas tip cap: %w", err)
+	}
+
+	l2Head, err := g.rpc.L2.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch L2 head: %w", err)
+	}
+
+	// A nil blob base fee means the txList bytes are saved in calldata.
+	var blobBaseFee *big.Int
+	if g.cfg.BlobAllowed {
+		if l1Head.ExcessBlobGas == nil {
+			return nil, fmt.Errorf("L1 head %d has no excess blob gas", l1Head.Number)
+		}
+		blobBaseFee = eip4844.CalcBlobFee(*l1Head.ExcessBlobGas)
+	}
+
+	return estimate(
+		proposal,
+		l2Head.BaseFee,
+		new(big.Int).Add(l1Head.BaseFee, l1Tip),
+		blobBaseFee,
+		g.cfg.AdaptiveBlob,
+		g.cfg.GasUtilization,
+		g.baseGasUsed.Load(),
+	), nil
+}
+
+// acceptable checks whether the estimated margin is within the configured tolerance.
+func (g *ProfitabilityGuard) acceptable(estimation *Estimation) bool {
+	if estimation.Margin.Sign() >= 0 {
+		return true
+	}
+
+	allowedLoss := new(big.Int).Div(
+		new(big.Int).Mul(estimation.Cost(), new(big.Int).SetUint64(g.cfg.Tolerance)),
+		big.NewInt(100),
+	)
+
+	return new(big.Int).Neg(estimation.Margin).Cmp(allowedLoss) <= 0
+}
+
+// estimate calculates the estimation based on the given fee market parameters. The L2 transactions are
+// expected to use the given percentage of their gas limits, since their gas used is only known once
+// they are executed, and the TaikoL1.proposeBlock transaction is expected to use the given base gas, plus
+// the calldata cost of the txList bytes if they are not saved in a blob. The txList bytes are saved in a
+// blob if a blob base fee is given, or in whichever of blob and calldata is cheaper if adaptive.
+func estimate(
+	proposal *Proposal,
+	l2BaseFee *big.Int,
+	l1GasPrice *big.Int,
+	blobBaseFee *big.Int,
+	adaptive bool,
+	gasUtilization uint64,
+	baseGasUsed uint64,
+) *Estimation {
+	l2Revenue := new(big.Int)
+	for _, tx := range proposal.Txs {
+		tip, err := tx.EffectiveGasTip(l2BaseFee)
+		if err != nil || tip.Sign() <= 0 {
+			continue
+		}
+		l2Revenue.Add(l2Revenue, new(big.Int).Mul(tip, new(big.Int).SetUint64(tx.Gas())))
+	}
+	l2Revenue.Div(new(big.Int).Mul(l2Revenue, new(big.Int).SetUint64(gasUtilization)), big.NewInt(100))
+
+	proverFee := new(big.Int)
+	if proposal.ProverFee != nil {
+		proverFee.Set(proposal.ProverFee)
+	}
+
+	var (
+		baseGasCost  = new(big.Int).Mul(l1GasPrice, new(big.Int).SetUint64(baseGasUsed))
+		calldataCost = builder.CalcCalldataCost(proposal.TxListBytes, l1GasPrice)
+		estimation   = &Estimation{
+			L2Revenue:  l2Revenue,
+			L1GasCost:  new(big.Int).Add(baseGasCost, calldataCost),
+			L1BlobCost: new(big.Int),
+			ProverFee:  proverFee,
+		}
+	)
+
+	if blobBaseFee != nil && len(proposal.TxListBytes) <= eth.MaxBlobDataSize {
+		blobCost := builder.CalcBlobCost(blobBaseFee)
+		if !adaptive || builder.IsBlobCheaper(proposal.TxListBytes, calldataCost, blobCost) {
+			estimation.L1GasCost = baseGasCost
+			estimation.L1BlobCost = blobCost
+		}
+	}
+	estimation.Margin = new(big.Int).Sub(l2Revenue, estimation.Cost())
+
+	return estimation
+}
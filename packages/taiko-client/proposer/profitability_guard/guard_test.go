@@ -0,0 +1,136 @@
+package guard
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimate(t *testing.T) {
+	txs := types.Transactions{
+		types.NewTx(&types.DynamicFeeTx{GasTipCap: big.NewInt(2), GasFeeCap: big.NewInt(10), Gas: 100}),
+		// Tip is capped by the fee cap.
+		types.NewTx(&types.DynamicFeeTx{GasTipCap: big.NewInt(5), GasFeeCap: big.NewInt(9), Gas: 100}),
+		// Fee cap is lower than the base fee.
+		types.NewTx(&types.DynamicFeeTx{GasTipCap: big.NewInt(5), GasFeeCap: big.NewInt(1), Gas: 100}),
+	}
+
+	estimation := estimate(
+		&Proposal{Txs: txs, TxListBytes: []byte{1}, ProverFee: big.NewInt(1000)},
+		big.NewInt(6),
+		big.NewInt(1),
+		big.NewInt(1),
+		false,
+		100,
+		500,
+	)
+	require.Equal(t, big.NewInt(500), estimation.L2Revenue)
+	require.Equal(t, big.NewInt(500), estimation.L1GasCost)
+	require.Equal(t, new(big.Int).SetUint64(params.BlobTxBlobGasPerBlob), estimation.L1BlobCost)
+	require.Equal(t, big.NewInt(1000), estimation.ProverFee)
+	require.Equal(
+		t,
+		new(big.Int).Sub(big.NewInt(500), new(big.Int).SetUint64(1500+params.BlobTxBlobGasPerBlob)),
+		estimation.Margin,
+	)
+
+	// Only the expected part of the gas limits is used.
+	estimation = estimate(&Proposal{Txs: txs}, big.NewInt(6), common.Big1, nil, false, 40, 500)
+	require.Equal(t, big.NewInt(200), estimation.L2Revenue)
+
+	// The calldata cost of the txList bytes is added to the base gas used.
+	estimation = estimate(&Proposal{TxListBytes: []byte{0, 1}}, common.Big1, big.NewInt(2), nil, false, 100, 500)
+	require.Equal(
+		t,
+		new(big.Int).SetUint64(2*(500+params.TxDataZeroGas+params.TxDataNonZeroGasEIP2028)),
+		estimation.L1GasCost,
+	)
+	require.Equal(t, common.Big0.Int64(), estimation.L1BlobCost.Int64())
+	require.Equal(t, common.Big0.Int64(), estimation.ProverFee.Int64())
+}
+
+func TestEstimateAdaptive(t *testing.T) {
+	proposal := &Proposal{TxListBytes: bytes.Repeat([]byte{1}, 10_000)}
+	calldataCost := defaultProposeBlockGasUsed + 10_000*params.TxDataNonZeroGasEIP2028
+
+	// Calldata is cheaper.
+	estimation := estimate(proposal, common.Big1, common.Big1, big.NewInt(10), true, 100, defaultProposeBlockGasUsed)
+	require.Equal(t, new(big.Int).SetUint64(calldataCost), estimation.L1GasCost)
+	require.Equal(t, common.Big0.Int64(), estimation.L1BlobCost.Int64())
+
+	// Blob is cheaper.
+	estimation = estimate(proposal, common.Big1, common.Big1, common.Big1, true, 100, defaultProposeBlockGasUsed)
+	require.Equal(t, new(big.Int).SetUint64(defaultProposeBlockGasUsed), estimation.L1GasCost)
+	require.Equal(t, new(big.Int).SetUint64(params.BlobTxBlobGasPerBlob), estimation.L1BlobCost)
+
+	// Blob is always used when not adaptive.
+	estimation = estimate(proposal, common.Big1, common.Big1, big.NewInt(10), false, 100, defaultProposeBlockGasUsed)
+	require.Equal(t, new(big.Int).SetUint64(10*params.BlobTxBlobGasPerBlob), estimation.L1BlobCost)
+
+	// The txList bytes don't fit in a blob.
+	estimation = estimate(
+		&Proposal{TxListBytes: make([]byte, eth.MaxBlobDataSize+1)},
+		common.Big1,
+		common.Big1,
+		common.Big1,
+		true,
+		100,
+		defaultProposeBlockGasUsed,
+	)
+	require.Equal(t, common.Big0.Int64(), estimation.L1BlobCost.Int64())
+}
+
+func TestUpdateGasUsed(t *testing.T) {
+	g, err := New(nil, &Config{GasUtilization: 100})
+	require.NoError(t, err)
+	require.Equal(t, defaultProposeBlockGasUsed, g.baseGasUsed.Load())
+
+	// The calldata cost of the txList bytes is excluded.
+	g.UpdateGasUsed(100_000, []byte{0, 1}, false)
+	require.Equal(t, 100_000-params.TxDataZeroGas-params.TxDataNonZeroGasEIP2028, g.baseGasUsed.Load())
+
+	g.UpdateGasUsed(90_000, []byte{0, 1}, true)
+	require.Equal(t, uint64(90_000), g.baseGasUsed.Load())
+
+	// Invalid gas used is ignored.
+	g.UpdateGasUsed(1, []byte{0, 1}, false)
+	require.Equal(t, uint64(90_000), g.baseGasUsed.Load())
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(nil, &Config{GasUtilization: 0})
+	require.Error(t, err)
+	_, err = New(nil, &Config{GasUtilization: 101})
+	require.Error(t, err)
+	_, err = New(nil, &Config{GasUtilization: 100})
+	require.NoError(t, err)
+}
+
+func TestAcceptable(t *testing.T) {
+	g := &ProfitabilityGuard{cfg: &Config{Tolerance: 10}}
+
+	require.True(t, g.acceptable(&Estimation{
+		L1GasCost:  big.NewInt(100),
+		L1BlobCost: common.Big0,
+		ProverFee:  common.Big0,
+		Margin:     common.Big0,
+	}))
+	require.True(t, g.acceptable(&Estimation{
+		L1GasCost:  big.NewInt(100),
+		L1BlobCost: common.Big0,
+		ProverFee:  common.Big0,
+		Margin:     big.NewInt(-10),
+	}))
+	require.False(t, g.acceptable(&Estimation{
+		L1GasCost:  big.NewInt(100),
+		L1BlobCost: common.Big0,
+		ProverFee:  common.Big0,
+		Margin:     big.NewInt(-11),
+	}))
+}
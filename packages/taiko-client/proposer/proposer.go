@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math/big"
 	"net/http"
 	"sync"
 	"time"
@@ -16,19 +18,23 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/urfave/cli/v2"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/tracing"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/utils"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/signer"
+	guard "github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer/profitability_guard"
 	selector "github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer/prover_selector"
 	builder "github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer/transaction_builder"
+	policy "github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer/txlist_policy"
 )
 
 var (
@@ -36,6 +42,9 @@ var (
 	requestProverServerTimeout = 12 * time.Second
 )
 
+// ErrUnprofitableProposal is returned when the proposal is skipped by the profitability guard.
+var ErrUnprofitableProposal = errors.New("unprofitable proposal skipped")
+
 // Proposer keep proposing new transactions from L2 execution engine's tx pool at a fixed interval.
 type Proposer struct {
 	// configurations
@@ -44,7 +53,8 @@ type Proposer struct {
 	// RPC clients
 	rpc *rpc.Client
 
-	// Private keys and account addresses
+	// Signer and account addresses
+	signer          signer.Signer
 	proposerAddress common.Address
 
 	// proposingTimer *time.Timer
@@ -53,11 +63,18 @@ type Proposer struct {
 	tierFees []encoding.TierFee
 
 	// Prover selector
-	proverSelector selector.ProverSelector
+	proverSelector    selector.ProverSelector
+	reputationTracker *selector.ReputationTracker
 
 	// Transaction builder
 	txBuilder builder.ProposeBlockTransactionBuilder
 
+	// Transactions list selection policies
+	txListPolicies []policy.TxListPolicy
+
+	// Profitability guard, nil if the profitability check is disabled
+	profitabilityGuard *guard.ProfitabilityGuard
+
 	// Protocol configurations
 	protocolConfigs *bindings.TaikoDataConfig
 
@@ -81,7 +98,6 @@ func (p *Proposer) InitFromCli(ctx context.Context, c *cli.Context) error {
 
 // InitFromConfig initializes the proposer instance based on the given configurations.
 func (p *Proposer) InitFromConfig(ctx context.Context, cfg *Config) (err error) {
-	p.proposerAddress = crypto.PubkeyToAddress(cfg.L1ProposerPrivKey.PublicKey)
 	p.ctx = ctx
 	p.Config = cfg
 	p.lastProposedAt = time.Now()
@@ -91,6 +107,18 @@ func (p *Proposer) InitFromConfig(ctx context.Context, cfg *Config) (err error)
 		return fmt.Errorf("initialize rpc clients error: %w", err)
 	}
 
+	// Signer, either a local private key or a remote signer
+	if p.signer, err = signer.New(
+		ctx,
+		cfg.L1ProposerPrivKey,
+		cfg.RemoteSignerEndpoint,
+		cfg.RemoteSignerAddress,
+		cfg.TxmgrConfigs.SignerCLIConfig.TLSConfig,
+	); err != nil {
+		return fmt.Errorf("initialize signer error: %w", err)
+	}
+	p.proposerAddress = p.signer.Address()
+
 	// Protocol configs
 	protocolConfigs, err := p.rpc.TaikoL1.GetConfig(&bind.CallOpts{Context: ctx})
 	if err != nil {
@@ -107,6 +135,22 @@ func (p *Proposer) InitFromConfig(ctx context.Context, cfg *Config) (err error)
 		return err
 	}
 
+	if err := p.initTxListPolicies(); err != nil {
+		return err
+	}
+
+	if cfg.ProfitabilityCheck {
+		if p.profitabilityGuard, err = guard.New(p.rpc, &guard.Config{
+			Tolerance:      cfg.ProfitabilityTolerance,
+			MaxDelay:       cfg.ProfitabilityMaxDelay,
+			GasUtilization: cfg.ProfitabilityUtilization,
+			BlobAllowed:    cfg.BlobAllowed,
+			AdaptiveBlob:   cfg.AdaptiveBlob,
+		}); err != nil {
+			return err
+		}
+	}
+
 	if p.txmgr, err = txmgr.NewSimpleTxManager(
 		"proposer",
 		log.Root(),
@@ -116,7 +160,26 @@ func (p *Proposer) InitFromConfig(ctx context.Context, cfg *Config) (err error)
 		return err
 	}
 
-	if p.proverSelector, err = selector.NewETHFeeEOASelector(
+	if cfg.ProverMarketplace {
+		if p.reputationTracker, err = selector.NewReputationTracker(ctx, p.rpc, cfg.ProverReputationFile); err != nil {
+			return err
+		}
+		if p.proverSelector, err = selector.NewMarketplaceSelector(
+			&protocolConfigs,
+			p.rpc,
+			p.reputationTracker,
+			p.proposerAddress,
+			cfg.TaikoL1Address,
+			cfg.AssignmentHookAddress,
+			cfg.TierFeePriceBump,
+			cfg.ProverEndpoints,
+			cfg.MaxTierFeePriceBumps,
+			proverAssignmentTimeout,
+			requestProverServerTimeout,
+		); err != nil {
+			return err
+		}
+	} else if p.proverSelector, err = selector.NewETHFeeEOASelector(
 		&protocolConfigs,
 		p.rpc,
 		p.proposerAddress,
@@ -132,10 +195,10 @@ func (p *Proposer) InitFromConfig(ctx context.Context, cfg *Config) (err error)
 		return err
 	}
 
-	if cfg.BlobAllowed {
-		p.txBuilder = builder.NewBlobTransactionBuilder(
+	var (
+		blobTxBuilder = builder.NewBlobTransactionBuilder(
 			p.rpc,
-			p.L1ProposerPrivKey,
+			p.signer,
 			p.proverSelector,
 			p.Config.L1BlockBuilderTip,
 			cfg.TaikoL1Address,
@@ -144,10 +207,9 @@ func (p *Proposer) InitFromConfig(ctx context.Context, cfg *Config) (err error)
 			cfg.ProposeBlockTxGasLimit,
 			cfg.ExtraData,
 		)
-	} else {
-		p.txBuilder = builder.NewCalldataTransactionBuilder(
+		calldataTxBuilder = builder.NewCalldataTransactionBuilder(
 			p.rpc,
-			p.L1ProposerPrivKey,
+			p.signer,
 			p.proverSelector,
 			p.Config.L1BlockBuilderTip,
 			cfg.L2SuggestedFeeRecipient,
@@ -156,6 +218,14 @@ func (p *Proposer) InitFromConfig(ctx context.Context, cfg *Config) (err error)
 			cfg.ProposeBlockTxGasLimit,
 			cfg.ExtraData,
 		)
+	)
+	switch {
+	case cfg.BlobAllowed && cfg.AdaptiveBlob:
+		p.txBuilder = builder.NewAdaptiveTransactionBuilder(p.rpc, blobTxBuilder, calldataTxBuilder)
+	case cfg.BlobAllowed:
+		p.txBuilder = blobTxBuilder
+	default:
+		p.txBuilder = calldataTxBuilder
 	}
 
 	return nil
@@ -165,6 +235,14 @@ func (p *Proposer) InitFromConfig(ctx context.Context, cfg *Config) (err error)
 func (p *Proposer) Start() error {
 	startRPCServer(p)
 
+	if p.reputationTracker != nil {
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			p.reputationTracker.Track(p.ctx)
+		}()
+	}
+
 	// p.wg.Add(1)
 	// go p.eventLoop()
 	return nil
@@ -361,6 +439,28 @@ func (p *Proposer) fetchPoolContent(filterPoolContent bool) ([]types.Transaction
 		txLists = localTxsLists
 	}
 
+	// Apply the configured selection policies, the empty transactions lists are kept as they are,
+	// since they are used to propose empty blocks.
+	if len(p.txListPolicies) != 0 {
+		var selectedTxLists []types.Transactions
+		for _, txs := range txLists {
+			if txs.Len() == 0 {
+				selectedTxLists = append(selectedTxLists, txs)
+				continue
+			}
+
+			selected, err := policy.Chain(txs, p.txListPolicies...)
+			if err != nil {
+				return nil, err
+			}
+
+			if selected.Len() != 0 {
+				selectedTxLists = append(selectedTxLists, selected)
+			}
+		}
+		txLists = selectedTxLists
+	}
+
 	log.Info("Transactions lists count", "count", len(txLists))
 
 	return txLists, nil
@@ -369,7 +469,10 @@ func (p *Proposer) fetchPoolContent(filterPoolContent bool) ([]types.Transaction
 // ProposeOp performs a proposing operation, fetching transactions
 // from L2 execution engine's tx pool, splitting them by proposing constraints,
 // and then proposing them to TaikoL1 contract.
-func (p *Proposer) ProposeOp(ctx context.Context) error {
+func (p *Proposer) ProposeOp(ctx context.Context) (err error) {
+	ctx, span := tracing.Start(ctx, "Proposer.ProposeOp")
+	defer func() { tracing.End(span, err) }()
+
 	// Check if it's time to propose unfiltered pool content.
 	filterPoolContent := time.Now().Before(p.lastProposedAt.Add(p.MinProposingInternal))
 
@@ -411,6 +514,9 @@ func (p *Proposer) ProposeOp(ctx context.Context) error {
 				return fmt.Errorf("failed to encode transactions: %w", err)
 			}
 			if err := p.ProposeTxList(gCtx, txListBytes, uint(txs.Len())); err != nil {
+				if errors.Is(err, ErrUnprofitableProposal) {
+					return nil
+				}
 				return err
 			}
 			p.lastProposedAt = time.Now()
@@ -480,17 +586,67 @@ func (p *Proposer) ProposeOpForTakingL2Blocks(ctx context.Context) ([]types.Tran
 	return txLists, compressedTxLists, nil
 }
 
+// maxTierFee returns the maximum fee offered to the provers, after all price bumps.
+func (p *Proposer) maxTierFee() *big.Int {
+	maxFee := new(big.Int)
+	for _, tierFee := range selector.BumpTierFees(p.tierFees, p.TierFeePriceBump, p.MaxTierFeePriceBumps) {
+		if tierFee.Fee.Cmp(maxFee) > 0 {
+			maxFee = tierFee.Fee
+		}
+	}
+
+	return maxFee
+}
+
 // ProposeTxList proposes the given transactions list to TaikoL1 smart contract.
 func (p *Proposer) ProposeTxList(
 	ctx context.Context,
 	txListBytes []byte,
 	txNum uint,
-) error {
+) (err error) {
+	ctx, span := tracing.Start(ctx, "Proposer.ProposeTxList", attribute.Int("txs", int(txNum)))
+	defer func() { tracing.End(span, err) }()
+
 	compressedTxListBytes, err := utils.Compress(txListBytes)
 	if err != nil {
 		return err
 	}
 
+	// Empty proposals are always proposed, to keep the chain alive.
+	if p.profitabilityGuard != nil && txNum != 0 {
+		var txs types.Transactions
+		if err := rlp.DecodeBytes(txListBytes, &txs); err != nil {
+			return fmt.Errorf("failed to decode transactions: %w", err)
+		}
+
+		ok, estimation, err := p.profitabilityGuard.Check(
+			ctx,
+			&guard.Proposal{
+				Txs:         txs,
+				TxListBytes: compressedTxListBytes,
+				ProverFee:   p.maxTierFee(),
+			},
+			p.lastProposedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to check proposal profitability: %w", err)
+		}
+
+		log.Info(
+			"Proposal profitability estimated",
+			"txs", txNum,
+			"l2Revenue", utils.WeiToEther(estimation.L2Revenue),
+			"l1GasCost", utils.WeiToEther(estimation.L1GasCost),
+			"l1BlobCost", utils.WeiToEther(estimation.L1BlobCost),
+			"proverFee", utils.WeiToEther(estimation.ProverFee),
+			"margin", utils.WeiToEther(estimation.Margin),
+		)
+
+		if !ok {
+			return ErrUnprofitableProposal
+		}
+	}
+
 	txCandidate, err := p.txBuilder.Build(
 		ctx,
 		p.tierFees,
@@ -512,6 +668,10 @@ func (p *Proposer) ProposeTxList(
 		return fmt.Errorf("failed to propose block: %s", receipt.TxHash.Hex())
 	}
 
+	if p.profitabilityGuard != nil {
+		p.profitabilityGuard.UpdateGasUsed(receipt.GasUsed, compressedTxListBytes, len(txCandidate.Blobs) != 0)
+	}
+
 	log.Info("📝 Propose transactions succeeded", "txs", txNum)
 
 	metrics.ProposerProposedTxListsCounter.Add(1)
@@ -543,6 +703,32 @@ func (p *Proposer) Name() string {
 	return "proposer"
 }
 
+// initTxListPolicies initializes the transactions list selection policies based on the configurations,
+// the policies will be applied in the order of: deny list, max transactions per sender, ordering and bundles,
+// so that the bundles will not be split by reordering.
+func (p *Proposer) initTxListPolicies() error {
+	signer := types.LatestSignerForChainID(p.rpc.L2.ChainID)
+
+	if len(p.DenyList) != 0 {
+		p.txListPolicies = append(p.txListPolicies, policy.NewDenyListPolicy(signer, p.DenyList))
+	}
+	if p.MaxTxsPerSender != 0 {
+		p.txListPolicies = append(p.txListPolicies, policy.NewMaxTxsPerSenderPolicy(signer, p.MaxTxsPerSender))
+	}
+	if p.TxListOrdering == TxListOrderingTipPerByte {
+		p.txListPolicies = append(p.txListPolicies, policy.NewTipPerByteOrderingPolicy(signer, nil))
+	}
+	if p.BundlesFilePath != "" {
+		bundles, err := policy.LoadBundles(p.BundlesFilePath)
+		if err != nil {
+			return err
+		}
+		p.txListPolicies = append(p.txListPolicies, policy.NewBundlePolicy(signer, bundles))
+	}
+
+	return nil
+}
+
 // initTierFees initializes the proving fees for every proof tier configured in the protocol for the proposer.
 func (p *Proposer) initTierFees() error {
 	for _, tier := range p.tiers {
@@ -38,7 +38,7 @@ type ProposerTestSuite struct {
 func (s *ProposerTestSuite) SetupTest() {
 	s.ClientTestSuite.SetupTest()
 
-	state2, err := state.New(context.Background(), s.RPCClient)
+	state2, err := state.New(context.Background(), s.RPCClient, nil)
 	s.Nil(err)
 
 	syncer, err := blob.NewSyncer(
@@ -118,7 +118,7 @@ func (s *ProposerTestSuite) TestProposeTxLists() {
 
 	txBuilder := builder.NewBlobTransactionBuilder(
 		p.rpc,
-		p.L1ProposerPrivKey,
+		p.signer,
 		p.proverSelector,
 		p.Config.L1BlockBuilderTip,
 		cfg.TaikoL1Address,
@@ -18,3 +18,32 @@ type ProverSelector interface {
 	) (assignment *encoding.ProverAssignment, assignedProver common.Address, fee *big.Int, err error)
 	ProverEndpoints() []*url.URL
 }
+
+// BumpTierFees returns a copy of the given tier fees after all the price bumps of a prover assignment, in
+// which the fees are bumped by tierFeePriceBump percent cumulatively in each of the
+// maxTierFeePriceBumpIterations iterations after the first one. They are the most a proposer offers.
+func BumpTierFees(
+	tierFees []encoding.TierFee,
+	tierFeePriceBump *big.Int,
+	maxTierFeePriceBumpIterations uint64,
+) []encoding.TierFee {
+	var (
+		fees   = make([]encoding.TierFee, len(tierFees))
+		big100 = new(big.Int).SetUint64(uint64(100))
+	)
+
+	// Deep copy the tierFees slice.
+	for i, fee := range tierFees {
+		fees[i] = encoding.TierFee{Tier: fee.Tier, Fee: new(big.Int).Set(fee.Fee)}
+	}
+
+	for i := 1; i < int(maxTierFeePriceBumpIterations); i++ {
+		cumulativeBumpPercent := new(big.Int).Mul(tierFeePriceBump, new(big.Int).SetUint64(uint64(i)))
+		for idx := range fees {
+			fee := new(big.Int).Mul(fees[idx].Fee, cumulativeBumpPercent)
+			fees[idx].Fee = fees[idx].Fee.Add(fees[idx].Fee, fee.Div(fee, big100))
+		}
+	}
+
+	return fees
+}
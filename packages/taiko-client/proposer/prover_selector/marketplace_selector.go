@@ -0,0 +1,271 @@
+package selector
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/go-resty/resty/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/server"
+)
+
+// proverQuote is the price of a prover endpoint for proving a block.
+type proverQuote struct {
+	endpoint *url.URL
+	prover   common.Address
+	fees     []encoding.TierFee
+	maxFee   *big.Int
+	score    float64
+}
+
+// cost returns the expected cost of a block proven by the quote's prover, as its maximum tier fee
+// divided by its reputation score.
+func (q *proverQuote) cost() *big.Float {
+	return new(big.Float).Quo(new(big.Float).SetInt(q.maxFee), big.NewFloat(q.score))
+}
+
+// MarketplaceSelector is a prover selector implementation which requests the minimum tier fees of all
+// prover endpoints in parallel, and requests an assignment at its own fees from each prover within the
+// proposer's budget, in the order of their fees weighted by their reputation.
+type MarketplaceSelector struct {
+	protocolConfigs               *bindings.TaikoDataConfig
+	rpc                           *rpc.Client
+	reputation                    *ReputationTracker
+	proposerAddress               common.Address
+	taikoL1Address                common.Address
+	assignmentHookAddress         common.Address
+	tierFeePriceBump              *big.Int
+	proverEndpoints               []*url.URL
+	maxTierFeePriceBumpIterations uint64
+	proposalExpiry                time.Duration
+	requestTimeout                time.Duration
+}
+
+// NewMarketplaceSelector creates a new MarketplaceSelector instance.
+func NewMarketplaceSelector(
+	protocolConfigs *bindings.TaikoDataConfig,
+	rpc *rpc.Client,
+	reputation *ReputationTracker,
+	proposerAddress common.Address,
+	taikoL1Address common.Address,
+	assignmentHookAddress common.Address,
+	tierFeePriceBump *big.Int,
+	proverEndpoints []*url.URL,
+	maxTierFeePriceBumpIterations uint64,
+	proposalExpiry time.Duration,
+	requestTimeout time.Duration,
+) (*MarketplaceSelector, error) {
+	if len(proverEndpoints) == 0 {
+		return nil, errEmptyProverEndpoints
+	}
+
+	for _, endpoint := range proverEndpoints {
+		if endpoint.Scheme != httpScheme && endpoint.Scheme != httpsScheme {
+			return nil, fmt.Errorf("invalid prover endpoint %s", endpoint)
+		}
+	}
+
+	return &MarketplaceSelector{
+		protocolConfigs,
+		rpc,
+		reputation,
+		proposerAddress,
+		taikoL1Address,
+		assignmentHookAddress,
+		tierFeePriceBump,
+		proverEndpoints,
+		maxTierFeePriceBumpIterations,
+		proposalExpiry,
+		requestTimeout,
+	}, nil
+}
+
+// ProverEndpoints returns all registered prover endpoints.
+func (s *MarketplaceSelector) ProverEndpoints() []*url.URL { return s.proverEndpoints }
+
+// AssignProver tries to pick a prover through the registered prover endpoints.
+func (s *MarketplaceSelector) AssignProver(
+	ctx context.Context,
+	tierFees []encoding.TierFee,
+	txListHash common.Hash,
+) (*encoding.ProverAssignment, common.Address, *big.Int, error) {
+	var (
+		expiry = uint64(time.Now().Add(s.proposalExpiry).Unix())
+		quotes = rankQuotes(s.requestQuotes(ctx, s.budget(tierFees)))
+	)
+
+	for _, quote := range quotes {
+		assignment, proverAddress, err := assignProver(
+			ctx,
+			s.protocolConfigs.ChainId,
+			quote.endpoint,
+			expiry,
+			s.proposerAddress,
+			quote.fees,
+			s.taikoL1Address,
+			s.assignmentHookAddress,
+			txListHash,
+			s.requestTimeout,
+		)
+		if err != nil {
+			log.Warn("Failed to assign prover", "endpoint", quote.endpoint, "error", err)
+			continue
+		}
+
+		ok, err := rpc.CheckProverBalance(
+			ctx,
+			s.rpc,
+			proverAddress,
+			s.assignmentHookAddress,
+			s.protocolConfigs.LivenessBond,
+		)
+		if err != nil {
+			log.Warn("Failed to check prover balance", "endpoint", quote.endpoint, "error", err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		log.Info(
+			"Prover selected from marketplace",
+			"prover", proverAddress,
+			"endpoint", quote.endpoint,
+			"score", quote.score,
+			"maxProverFee", quote.maxFee,
+		)
+
+		return assignment, proverAddress, quote.maxFee, nil
+	}
+
+	return nil, common.Address{}, nil, errUnableToFindProver
+}
+
+// budget returns the highest tier fees the proposer is willing to pay, which are the given tier fees
+// after all the configured price bumps.
+func (s *MarketplaceSelector) budget(tierFees []encoding.TierFee) []encoding.TierFee {
+	return BumpTierFees(tierFees, s.tierFeePriceBump, s.maxTierFeePriceBumpIterations)
+}
+
+// requestQuotes requests the status of all prover endpoints in parallel, and returns the quotes of
+// the ones whose minimum tier fees are within the given budget.
+func (s *MarketplaceSelector) requestQuotes(ctx context.Context, budget []encoding.TierFee) []*proverQuote {
+	var (
+		quotes []*proverQuote
+		mutex  sync.Mutex
+		wg     sync.WaitGroup
+	)
+
+	for _, endpoint := range s.proverEndpoints {
+		wg.Add(1)
+		go func(endpoint *url.URL) {
+			defer wg.Done()
+
+			status, err := requestProverStatus(ctx, endpoint, s.requestTimeout)
+			if err != nil {
+				log.Warn("Failed to request prover status", "endpoint", endpoint, "error", err)
+				return
+			}
+
+			fees, maxFee, ok := quoteFees(status, budget)
+			if !ok {
+				log.Debug("Prover fees exceed the budget", "endpoint", endpoint, "status", status)
+				return
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			quotes = append(quotes, &proverQuote{
+				endpoint: endpoint,
+				prover:   common.HexToAddress(status.Prover),
+				fees:     fees,
+				maxFee:   maxFee,
+				score:    s.reputation.Score(common.HexToAddress(status.Prover)),
+			})
+		}(endpoint)
+	}
+	wg.Wait()
+
+	return quotes
+}
+
+// requestProverStatus fetches the status of the given prover endpoint by HTTP API.
+func requestProverStatus(ctx context.Context, endpoint *url.URL, timeout time.Duration) (*server.Status, error) {
+	requestURL, err := url.JoinPath(endpoint.String(), "/status")
+	if err != nil {
+		return nil, err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	status := &server.Status{}
+	resp, err := resty.New().R().
+		SetContext(ctxTimeout).
+		SetHeader("Accept", "application/json").
+		SetResult(status).
+		Get(requestURL)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("unsuccessful response %d", resp.StatusCode())
+	}
+
+	return status, nil
+}
+
+// quoteFees returns the tier fees asked by the prover with the given status, and the maximum of them,
+// returns false if any of them exceeds the given budget.
+func quoteFees(status *server.Status, budget []encoding.TierFee) ([]encoding.TierFee, *big.Int, bool) {
+	var (
+		fees   = make([]encoding.TierFee, len(budget))
+		maxFee = new(big.Int)
+	)
+
+	for i, tierFee := range budget {
+		var minFee uint64
+		switch tierFee.Tier {
+		case encoding.TierOptimisticID:
+			minFee = status.MinOptimisticTierFee
+		case encoding.TierSgxID:
+			minFee = status.MinSgxTierFee
+		case encoding.TierSgxAndZkVMID:
+			minFee = status.MinSgxAndZkVMTierFee
+		}
+
+		fee := new(big.Int).SetUint64(minFee)
+		if fee.Cmp(tierFee.Fee) > 0 {
+			return nil, nil, false
+		}
+		if fee.Cmp(maxFee) > 0 {
+			maxFee = fee
+		}
+		fees[i] = encoding.TierFee{Tier: tierFee.Tier, Fee: fee}
+	}
+
+	return fees, maxFee, true
+}
+
+// rankQuotes sorts the given quotes by their expected costs, the quote with a higher reputation score
+// comes first if they have the same cost.
+func rankQuotes(quotes []*proverQuote) []*proverQuote {
+	sort.SliceStable(quotes, func(i, j int) bool {
+		if c := quotes[i].cost().Cmp(quotes[j].cost()); c != 0 {
+			return c < 0
+		}
+		return quotes[i].score > quotes[j].score
+	})
+
+	return quotes
+}
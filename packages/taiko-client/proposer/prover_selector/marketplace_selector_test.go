@@ -0,0 +1,70 @@
+package selector
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/server"
+)
+
+func TestRankQuotes(t *testing.T) {
+	require.Empty(t, rankQuotes(nil))
+
+	quotes := rankQuotes([]*proverQuote{
+		{prover: common.HexToAddress("0x01"), maxFee: big.NewInt(100), score: 0.5},
+		{prover: common.HexToAddress("0x02"), maxFee: big.NewInt(100), score: 0.9},
+		// Cheaper, but less reliable.
+		{prover: common.HexToAddress("0x03"), maxFee: big.NewInt(60), score: 0.25},
+		{prover: common.HexToAddress("0x04"), maxFee: big.NewInt(90), score: 0.9},
+		// Same cost as 0x04 with a lower score.
+		{prover: common.HexToAddress("0x05"), maxFee: big.NewInt(50), score: 0.5},
+	})
+
+	var provers []common.Address
+	for _, quote := range quotes {
+		provers = append(provers, quote.prover)
+	}
+	require.Equal(t, []common.Address{
+		common.HexToAddress("0x04"),
+		common.HexToAddress("0x05"),
+		common.HexToAddress("0x02"),
+		common.HexToAddress("0x01"),
+		common.HexToAddress("0x03"),
+	}, provers)
+}
+
+func TestQuoteFees(t *testing.T) {
+	budget := []encoding.TierFee{
+		{Tier: encoding.TierOptimisticID, Fee: big.NewInt(10)},
+		{Tier: encoding.TierSgxID, Fee: big.NewInt(20)},
+	}
+
+	fees, maxFee, ok := quoteFees(&server.Status{MinOptimisticTierFee: 5, MinSgxTierFee: 20}, budget)
+	require.True(t, ok)
+	require.Equal(t, big.NewInt(20), maxFee)
+	require.Equal(t, []encoding.TierFee{
+		{Tier: encoding.TierOptimisticID, Fee: big.NewInt(5)},
+		{Tier: encoding.TierSgxID, Fee: big.NewInt(20)},
+	}, fees)
+	// The budget is not modified.
+	require.Equal(t, big.NewInt(10), budget[0].Fee)
+
+	_, _, ok = quoteFees(&server.Status{MinOptimisticTierFee: 5, MinSgxTierFee: 21}, budget)
+	require.False(t, ok)
+}
+
+func TestBudget(t *testing.T) {
+	s := &MarketplaceSelector{tierFeePriceBump: big.NewInt(10), maxTierFeePriceBumpIterations: 3}
+	tierFees := []encoding.TierFee{{Tier: encoding.TierOptimisticID, Fee: big.NewInt(100)}}
+
+	// 100 -> 110 -> 132
+	require.Equal(t, big.NewInt(132), s.budget(tierFees)[0].Fee)
+	require.Equal(t, big.NewInt(100), tierFees[0].Fee)
+
+	s.maxTierFeePriceBumpIterations = 1
+	require.Equal(t, big.NewInt(100), s.budget(tierFees)[0].Fee)
+}
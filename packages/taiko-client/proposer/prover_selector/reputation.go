@@ -0,0 +1,326 @@
+package selector
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	chainIterator "github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/chain_iterator"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+)
+
+var (
+	reputationSyncInterval = 1 * time.Minute
+)
+
+// ProverStats contains the on-chain proving history of a prover, as the assigned prover of blocks.
+type ProverStats struct {
+	// Number of the assigned blocks which have been proven by this prover
+	Proved uint64 `json:"proved"`
+	// Number of the assigned blocks which have been proven by this prover within the proving window
+	OnTime uint64 `json:"onTime"`
+	// Number of the assigned blocks which have been verified with this prover's transition
+	Verified uint64 `json:"verified"`
+	// Number of the assigned blocks which have been verified with another prover's transition
+	Failed uint64 `json:"failed"`
+}
+
+// reputationState is the persisted state of the ReputationTracker.
+type reputationState struct {
+	LastScannedHeight uint64                          `json:"lastScannedHeight"`
+	Provers           map[common.Address]*ProverStats `json:"provers"`
+}
+
+// ReputationTracker builds the provers' reputation scores from the TaikoL1.TransitionProved and
+// TaikoL1.BlockVerified events, and persists them to the given file.
+type ReputationTracker struct {
+	rpc   *rpc.Client
+	tiers []*rpc.TierProviderTierWithID
+	path  string
+	state *reputationState
+	mutex sync.RWMutex
+}
+
+// NewReputationTracker creates a new ReputationTracker instance, if the given file path is empty,
+// the reputation scores will only be kept in memory.
+func NewReputationTracker(ctx context.Context, rpc *rpc.Client, path string) (*ReputationTracker, error) {
+	state, err := loadReputationState(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start scanning from the protocol's genesis height, if there is no persisted state.
+	if state.LastScannedHeight == 0 {
+		stateVars, err := rpc.GetProtocolStateVariables(&bind.CallOpts{Context: ctx})
+		if err != nil {
+			return nil, err
+		}
+		state.LastScannedHeight = stateVars.A.GenesisHeight
+	}
+
+	tiers, err := rpc.GetTiers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReputationTracker{rpc: rpc, tiers: tiers, path: path, state: state}, nil
+}
+
+// Track starts the inner event loop, to keep the reputation scores updated.
+func (t *ReputationTracker) Track(ctx context.Context) {
+	ticker := time.NewTicker(reputationSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := t.sync(ctx); err != nil {
+			log.Error("Failed to sync provers reputation", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Score returns the reputation score of the given prover, which is in the range of (0, 1), a prover
+// without any history will get a neutral score of 0.5.
+func (t *ReputationTracker) Score(prover common.Address) float64 {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	stats, ok := t.state.Provers[prover]
+	if !ok {
+		return score(&ProverStats{})
+	}
+
+	return score(stats)
+}
+
+// Stats returns a copy of the given prover's proving history.
+func (t *ReputationTracker) Stats(prover common.Address) ProverStats {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	if stats, ok := t.state.Provers[prover]; ok {
+		return *stats
+	}
+
+	return ProverStats{}
+}
+
+// sync scans the new L1 blocks since the last scanned height, and updates the reputation scores.
+func (t *ReputationTracker) sync(ctx context.Context) error {
+	t.mutex.RLock()
+	startHeight := t.state.LastScannedHeight
+	t.mutex.RUnlock()
+
+	iter, err := chainIterator.NewBlockBatchIterator(ctx, &chainIterator.BlockBatchIteratorConfig{
+		Client:      t.rpc.L1,
+		StartHeight: new(big.Int).SetUint64(startHeight),
+		OnBlocks:    t.onBlocks,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := iter.Iter(); err != nil {
+		return err
+	}
+
+	return t.save()
+}
+
+// onBlocks handles the TaikoL1 events emitted in the given L1 blocks range.
+func (t *ReputationTracker) onBlocks(
+	ctx context.Context,
+	start, end *types.Header,
+	_ chainIterator.UpdateCurrentFunc,
+	_ chainIterator.EndIterFunc,
+) error {
+	var (
+		startHeight = start.Number.Uint64() + 1
+		endHeight   = end.Number.Uint64()
+		opts        = &bind.FilterOpts{Start: startHeight, End: &endHeight, Context: ctx}
+	)
+
+	provedIter, err := t.rpc.TaikoL1.FilterTransitionProved(opts, nil)
+	if err != nil {
+		return err
+	}
+	defer provedIter.Close()
+
+	for provedIter.Next() {
+		e := provedIter.Event
+		block, err := t.assignedBlock(ctx, e.BlockId)
+		if err != nil {
+			return err
+		}
+		if block == nil || e.Prover != block.AssignedProver {
+			continue
+		}
+
+		onTime, err := t.provedOnTime(ctx, block, e)
+		if err != nil {
+			return err
+		}
+		t.update(block.AssignedProver, func(stats *ProverStats) {
+			stats.Proved++
+			if onTime {
+				stats.OnTime++
+			}
+		})
+	}
+
+	verifiedIter, err := t.rpc.TaikoL1.FilterBlockVerified(opts, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer verifiedIter.Close()
+
+	for verifiedIter.Next() {
+		e := verifiedIter.Event
+		block, err := t.assignedBlock(ctx, e.BlockId)
+		if err != nil {
+			return err
+		}
+		if block == nil {
+			continue
+		}
+		t.update(block.AssignedProver, func(stats *ProverStats) {
+			if e.Prover == block.AssignedProver {
+				stats.Verified++
+			} else {
+				stats.Failed++
+			}
+		})
+	}
+
+	t.mutex.Lock()
+	t.state.LastScannedHeight = endHeight
+	t.mutex.Unlock()
+
+	return nil
+}
+
+// assignedBlock fetches the given block from the protocol, returns nil if the block has already been
+// overwritten in the protocol's ring buffer, or has no assigned prover.
+func (t *ReputationTracker) assignedBlock(ctx context.Context, blockID *big.Int) (*bindings.TaikoDataBlock, error) {
+	block, err := t.rpc.GetL2BlockInfo(ctx, blockID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get L2 block info (%d): %w", blockID, err)
+	}
+	if block.BlockId != blockID.Uint64() || block.AssignedProver == (common.Address{}) {
+		return nil, nil
+	}
+
+	return &block, nil
+}
+
+// provedOnTime checks whether the given transition was proven within the proving window of its tier,
+// since the block was proposed.
+func (t *ReputationTracker) provedOnTime(
+	ctx context.Context,
+	block *bindings.TaikoDataBlock,
+	e *bindings.TaikoL1ClientTransitionProved,
+) (bool, error) {
+	provingWindow, err := provingWindow(e.Tier, t.tiers)
+	if err != nil {
+		return false, err
+	}
+
+	header, err := t.rpc.L1.HeaderByHash(ctx, e.Raw.BlockHash)
+	if err != nil {
+		return false, fmt.Errorf("failed to get L1 header (%s): %w", e.Raw.BlockHash, err)
+	}
+
+	return header.Time <= block.ProposedAt+uint64(provingWindow.Seconds()), nil
+}
+
+// update updates the given prover's stats.
+func (t *ReputationTracker) update(prover common.Address, f func(stats *ProverStats)) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if _, ok := t.state.Provers[prover]; !ok {
+		t.state.Provers[prover] = &ProverStats{}
+	}
+	f(t.state.Provers[prover])
+}
+
+// save persists the current state to the file.
+func (t *ReputationTracker) save() error {
+	if t.path == "" {
+		return nil
+	}
+
+	t.mutex.RLock()
+	data, err := json.Marshal(t.state)
+	t.mutex.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(t.path, data, 0600)
+}
+
+// loadReputationState loads the persisted state from the given file, or returns an empty state
+// if the file does not exist.
+func loadReputationState(path string) (*reputationState, error) {
+	state := &reputationState{Provers: make(map[common.Address]*ProverStats)}
+	if path == "" {
+		return state, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return state, nil
+		}
+		return nil, fmt.Errorf("failed to read reputation file: %w", err)
+	}
+
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to parse reputation file: %w", err)
+	}
+	if state.Provers == nil {
+		state.Provers = make(map[common.Address]*ProverStats)
+	}
+
+	return state, nil
+}
+
+// provingWindow returns the proving window of the given tier.
+func provingWindow(tier uint16, tiers []*rpc.TierProviderTierWithID) (time.Duration, error) {
+	for _, t := range tiers {
+		if t.ID == tier {
+			return time.Duration(t.ProvingWindow) * time.Minute, nil
+		}
+	}
+
+	return 0, fmt.Errorf("tier %d not found", tier)
+}
+
+// score calculates the reputation score based on the given stats, as the average of the rate of the
+// assigned blocks verified with the prover's transitions, and the rate of the prover's proofs submitted
+// within the proving window, both using Laplace smoothing.
+func score(stats *ProverStats) float64 {
+	var (
+		verifiedRate = float64(stats.Verified+1) / float64(stats.Verified+stats.Failed+2)
+		onTimeRate   = float64(stats.OnTime+1) / float64(stats.Proved+2)
+	)
+
+	return (verifiedRate + onTimeRate) / 2
+}
@@ -0,0 +1,77 @@
+package selector
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+)
+
+func TestScore(t *testing.T) {
+	require.Equal(t, 0.5, score(&ProverStats{}))
+	require.Equal(t, 0.75, score(&ProverStats{Proved: 2, OnTime: 2, Verified: 2}))
+	require.Equal(t, 0.5, score(&ProverStats{Proved: 2, Verified: 2}))
+	require.Equal(t, 0.375, score(&ProverStats{Failed: 2}))
+	require.Greater(t, score(&ProverStats{Verified: 100, Failed: 1}), score(&ProverStats{Verified: 1}))
+	// Late proofs lower the score.
+	require.Greater(
+		t,
+		score(&ProverStats{Proved: 10, OnTime: 10, Verified: 10}),
+		score(&ProverStats{Proved: 10, OnTime: 5, Verified: 10}),
+	)
+}
+
+func TestReputationTrackerPersistence(t *testing.T) {
+	var (
+		path   = filepath.Join(t.TempDir(), "reputation.json")
+		prover = common.HexToAddress("0x01")
+	)
+
+	state, err := loadReputationState(path)
+	require.Nil(t, err)
+	require.Empty(t, state.Provers)
+
+	tracker := &ReputationTracker{path: path, state: state}
+	tracker.update(prover, func(stats *ProverStats) { stats.Verified++ })
+	tracker.update(prover, func(stats *ProverStats) { stats.Failed++ })
+	tracker.update(prover, func(stats *ProverStats) {
+		stats.Proved++
+		stats.OnTime++
+	})
+	tracker.state.LastScannedHeight = 10
+	require.Nil(t, tracker.save())
+
+	loaded, err := loadReputationState(path)
+	require.Nil(t, err)
+	require.Equal(t, uint64(10), loaded.LastScannedHeight)
+	require.Equal(t, ProverStats{Proved: 1, OnTime: 1, Verified: 1, Failed: 1}, *loaded.Provers[prover])
+
+	tracker = &ReputationTracker{path: path, state: loaded}
+	require.Equal(t, score(&ProverStats{Proved: 1, OnTime: 1, Verified: 1, Failed: 1}), tracker.Score(prover))
+	require.Equal(t, 0.5, tracker.Score(common.HexToAddress("0x02")))
+	require.Equal(t, ProverStats{}, tracker.Stats(common.HexToAddress("0x02")))
+
+	require.Nil(t, os.WriteFile(path, []byte("invalid"), 0600))
+	_, err = loadReputationState(path)
+	require.ErrorContains(t, err, "failed to parse reputation file")
+}
+
+func TestProvingWindow(t *testing.T) {
+	tiers := []*rpc.TierProviderTierWithID{
+		{ID: encoding.TierOptimisticID, ITierProviderTier: bindings.ITierProviderTier{ProvingWindow: 60}},
+	}
+
+	window, err := provingWindow(encoding.TierOptimisticID, tiers)
+	require.Nil(t, err)
+	require.Equal(t, time.Hour, window)
+
+	_, err = provingWindow(encoding.TierSgxID, tiers)
+	require.ErrorContains(t, err, "not found")
+}
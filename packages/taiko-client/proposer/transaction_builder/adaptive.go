@@ -0,0 +1,114 @@
+package builder
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+)
+
+// AdaptiveTransactionBuilder is responsible for building a TaikoL1.proposeBlock transaction with txList
+// bytes saved in either blob or calldata, whichever is cheaper under the current L1 fee market.
+type AdaptiveTransactionBuilder struct {
+	rpc             *rpc.Client
+	blobBuilder     *BlobTransactionBuilder
+	calldataBuilder *CalldataTransactionBuilder
+}
+
+// NewAdaptiveTransactionBuilder creates a new AdaptiveTransactionBuilder instance based on the given builders.
+func NewAdaptiveTransactionBuilder(
+	rpc *rpc.Client,
+	blobBuilder *BlobTransactionBuilder,
+	calldataBuilder *CalldataTransactionBuilder,
+) *AdaptiveTransactionBuilder {
+	return &AdaptiveTransactionBuilder{rpc, blobBuilder, calldataBuilder}
+}
+
+// Build implements the ProposeBlockTransactionBuilder interface.
+func (b *AdaptiveTransactionBuilder) Build(
+	ctx context.Context,
+	tierFees []encoding.TierFee,
+	includeParentMetaHash bool,
+	txListBytes []byte,
+) (*txmgr.TxCandidate, error) {
+	useBlob, err := b.shouldUseBlob(ctx, txListBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if useBlob {
+		return b.blobBuilder.Build(ctx, tierFees, includeParentMetaHash, txListBytes)
+	}
+
+	return b.calldataBuilder.Build(ctx, tierFees, includeParentMetaHash, txListBytes)
+}
+
+// shouldUseBlob checks whether saving the given txList bytes in a blob is cheaper than in calldata,
+// based on the current L1 head's base fee and excess blob gas.
+func (b *AdaptiveTransactionBuilder) shouldUseBlob(ctx context.Context, txListBytes []byte) (bool, error) {
+	l1Head, err := b.rpc.L1.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch L1 head: %w", err)
+	}
+	if l1Head.BaseFee == nil || l1Head.ExcessBlobGas == nil {
+		return false, fmt.Errorf("L1 head %d has no base fee or excess blob gas", l1Head.Number)
+	}
+
+	tip, err := b.rpc.L1.SuggestGasTipCap(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch L1 gas tip cap: %w", err)
+	}
+
+	var (
+		gasPrice     = new(big.Int).Add(l1Head.BaseFee, tip)
+		blobBaseFee  = eip4844.CalcBlobFee(*l1Head.ExcessBlobGas)
+		calldataCost = CalcCalldataCost(txListBytes, gasPrice)
+		blobCost     = CalcBlobCost(blobBaseFee)
+		useBlob      = IsBlobCheaper(txListBytes, calldataCost, blobCost)
+	)
+
+	log.Info(
+		"Transaction type selected",
+		"blob", useBlob,
+		"txListBytes", len(txListBytes),
+		"gasPrice", gasPrice,
+		"blobBaseFee", blobBaseFee,
+		"calldataCost", calldataCost,
+		"blobCost", blobCost,
+	)
+
+	return useBlob, nil
+}
+
+// IsBlobCheaper checks whether the given txList bytes fit in a blob, and saving them in it is cheaper
+// than in calldata.
+func IsBlobCheaper(txListBytes []byte, calldataCost *big.Int, blobCost *big.Int) bool {
+	return len(txListBytes) <= eth.MaxBlobDataSize && blobCost.Cmp(calldataCost) < 0
+}
+
+// CalcCalldataCost calculates the cost of saving the given bytes in calldata.
+func CalcCalldataCost(data []byte, gasPrice *big.Int) *big.Int {
+	var gas uint64
+	for _, b := range data {
+		if b == 0 {
+			gas += params.TxDataZeroGas
+		} else {
+			gas += params.TxDataNonZeroGasEIP2028
+		}
+	}
+
+	return new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gas))
+}
+
+// CalcBlobCost calculates the cost of saving the bytes in a single blob.
+func CalcBlobCost(blobBaseFee *big.Int) *big.Int {
+	return new(big.Int).Mul(blobBaseFee, new(big.Int).SetUint64(params.BlobTxBlobGasPerBlob))
+}
@@ -0,0 +1,57 @@
+package builder
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalcCalldataCost(t *testing.T) {
+	require.Equal(
+		t,
+		new(big.Int).SetUint64(2*(params.TxDataZeroGas+2*params.TxDataNonZeroGasEIP2028)),
+		CalcCalldataCost([]byte{0, 1, 2}, big.NewInt(2)),
+	)
+	require.Equal(t, big.NewInt(0), CalcCalldataCost([]byte{}, big.NewInt(2)))
+}
+
+func TestCalcBlobCost(t *testing.T) {
+	require.Equal(t, new(big.Int).SetUint64(3*params.BlobTxBlobGasPerBlob), CalcBlobCost(big.NewInt(3)))
+}
+
+func TestIsBlobCheaper(t *testing.T) {
+	// With a gas price and a blob base fee of 1 wei, a blob costs as much as this many non-zero calldata bytes.
+	crossover := int(params.BlobTxBlobGasPerBlob / params.TxDataNonZeroGasEIP2028)
+
+	tests := []struct {
+		name        string
+		txListBytes []byte
+		gasPrice    *big.Int
+		blobBaseFee *big.Int
+		expected    bool
+	}{
+		{"empty", []byte{}, big.NewInt(1), big.NewInt(1), false},
+		{"below crossover", bytes.Repeat([]byte{1}, crossover-1), big.NewInt(1), big.NewInt(1), false},
+		{"at crossover", bytes.Repeat([]byte{1}, crossover), big.NewInt(1), big.NewInt(1), false},
+		{"above crossover", bytes.Repeat([]byte{1}, crossover+1), big.NewInt(1), big.NewInt(1), true},
+		{"zero bytes at crossover", make([]byte, crossover), big.NewInt(1), big.NewInt(1), false},
+		{"higher gas price", bytes.Repeat([]byte{1}, crossover/2+1), big.NewInt(2), big.NewInt(1), true},
+		{"higher blob base fee", bytes.Repeat([]byte{1}, crossover+1), big.NewInt(1), big.NewInt(2), false},
+		{"max blob data size", bytes.Repeat([]byte{1}, eth.MaxBlobDataSize), big.NewInt(1), big.NewInt(1), true},
+		{"exceeds blob", bytes.Repeat([]byte{1}, eth.MaxBlobDataSize+1), big.NewInt(1), big.NewInt(1), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				tt.expected,
+				IsBlobCheaper(tt.txListBytes, CalcCalldataCost(tt.txListBytes, tt.gasPrice), CalcBlobCost(tt.blobBaseFee)),
+			)
+		})
+	}
+}
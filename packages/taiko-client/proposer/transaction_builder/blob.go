@@ -2,18 +2,17 @@ package builder
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"crypto/sha256"
 	"math/big"
 
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/signer"
 	selector "github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer/prover_selector"
 )
 
@@ -21,7 +20,7 @@ import (
 // bytes saved in blob.
 type BlobTransactionBuilder struct {
 	rpc                     *rpc.Client
-	proposerPrivateKey      *ecdsa.PrivateKey
+	proposerSigner          signer.Signer
 	proverSelector          selector.ProverSelector
 	l1BlockBuilderTip       *big.Int
 	taikoL1Address          common.Address
@@ -34,7 +33,7 @@ type BlobTransactionBuilder struct {
 // NewBlobTransactionBuilder creates a new BlobTransactionBuilder instance based on giving configurations.
 func NewBlobTransactionBuilder(
 	rpc *rpc.Client,
-	proposerPrivateKey *ecdsa.PrivateKey,
+	proposerSigner signer.Signer,
 	proverSelector selector.ProverSelector,
 	l1BlockBuilderTip *big.Int,
 	taikoL1Address common.Address,
@@ -45,7 +44,7 @@ func NewBlobTransactionBuilder(
 ) *BlobTransactionBuilder {
 	return &BlobTransactionBuilder{
 		rpc,
-		proposerPrivateKey,
+		proposerSigner,
 		proverSelector,
 		l1BlockBuilderTip,
 		taikoL1Address,
@@ -107,7 +106,7 @@ func (b *BlobTransactionBuilder) Build(
 	}
 	blobHash := kzg4844.CalcBlobHashV1(sha256.New(), &commitment)
 
-	signature, err := crypto.Sign(blobHash[:], b.proposerPrivateKey)
+	signature, err := b.proposerSigner.Sign(ctx, blobHash[:])
 	if err != nil {
 		return nil, err
 	}
@@ -2,7 +2,6 @@ package builder
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"math/big"
 
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
@@ -11,6 +10,7 @@ import (
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/signer"
 	selector "github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer/prover_selector"
 )
 
@@ -18,7 +18,7 @@ import (
 // bytes saved in calldata.
 type CalldataTransactionBuilder struct {
 	rpc                     *rpc.Client
-	proposerPrivateKey      *ecdsa.PrivateKey
+	proposerSigner          signer.Signer
 	proverSelector          selector.ProverSelector
 	l1BlockBuilderTip       *big.Int
 	l2SuggestedFeeRecipient common.Address
@@ -31,7 +31,7 @@ type CalldataTransactionBuilder struct {
 // NewCalldataTransactionBuilder creates a new CalldataTransactionBuilder instance based on giving configurations.
 func NewCalldataTransactionBuilder(
 	rpc *rpc.Client,
-	proposerPrivateKey *ecdsa.PrivateKey,
+	proposerSigner signer.Signer,
 	proverSelector selector.ProverSelector,
 	l1BlockBuilderTip *big.Int,
 	l2SuggestedFeeRecipient common.Address,
@@ -42,7 +42,7 @@ func NewCalldataTransactionBuilder(
 ) *CalldataTransactionBuilder {
 	return &CalldataTransactionBuilder{
 		rpc,
-		proposerPrivateKey,
+		proposerSigner,
 		proverSelector,
 		l1BlockBuilderTip,
 		l2SuggestedFeeRecipient,
@@ -87,7 +87,7 @@ func (b *CalldataTransactionBuilder) Build(
 		return nil, err
 	}
 
-	signature, err := crypto.Sign(crypto.Keccak256(txListBytes), b.proposerPrivateKey)
+	signature, err := b.proposerSigner.Sign(ctx, txListBytes)
 	if err != nil {
 		return nil, err
 	}
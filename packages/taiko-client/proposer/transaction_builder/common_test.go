@@ -13,6 +13,7 @@ import (
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/testutils"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/signer"
 	selector "github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer/prover_selector"
 )
 
@@ -47,7 +48,7 @@ func (s *TransactionBuilderTestSuite) SetupTest() {
 	s.Nil(err)
 	s.calldataTxBuilder = NewCalldataTransactionBuilder(
 		s.RPCClient,
-		l1ProposerPrivKey,
+		signer.NewLocalSigner(l1ProposerPrivKey),
 		proverSelector,
 		common.Big0,
 		common.HexToAddress(os.Getenv("TAIKO_L2_ADDRESS")),
@@ -58,7 +59,7 @@ func (s *TransactionBuilderTestSuite) SetupTest() {
 	)
 	s.blobTxBuiler = NewBlobTransactionBuilder(
 		s.RPCClient,
-		l1ProposerPrivKey,
+		signer.NewLocalSigner(l1ProposerPrivKey),
 		proverSelector,
 		common.Big0,
 		common.HexToAddress(os.Getenv("TAIKO_L1_ADDRESS")),
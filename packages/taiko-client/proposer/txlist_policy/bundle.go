@@ -0,0 +1,196 @@
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// BundlePolicy keeps the given transaction bundles atomic: a bundle is either included in the list
+// as a whole, placed at the position of its first transaction and in the given order, or not included
+// at all. A bundle is placed later if its senders' lower nonce transactions come after it in the list,
+// and dropped if they can't be placed before it.
+type BundlePolicy struct {
+	signer  types.Signer
+	bundles [][]common.Hash
+}
+
+// NewBundlePolicy creates a new BundlePolicy instance.
+func NewBundlePolicy(signer types.Signer, bundles [][]common.Hash) *BundlePolicy {
+	return &BundlePolicy{signer: signer, bundles: bundles}
+}
+
+// LoadBundles loads the transaction bundles from the given JSON file, which contains
+// an array of transaction hash arrays.
+func LoadBundles(path string) ([][]common.Hash, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bundles file: %w", err)
+	}
+
+	var bundles [][]common.Hash
+	if err := json.Unmarshal(data, &bundles); err != nil {
+		return nil, fmt.Errorf("failed to parse bundles file: %w", err)
+	}
+
+	return bundles, nil
+}
+
+// Apply implements the TxListPolicy interface.
+func (p *BundlePolicy) Apply(txs types.Transactions) (types.Transactions, error) {
+	// Drop the partially included bundles, until all the remaining bundles are complete, since
+	// dropping a transaction may also drop other bundles' transactions from the same sender.
+	for {
+		partial := make(map[common.Hash]struct{})
+		present := make(map[common.Hash]struct{}, len(txs))
+		for _, tx := range txs {
+			present[tx.Hash()] = struct{}{}
+		}
+		for _, bundle := range p.bundles {
+			if bundleState(bundle, present) != bundlePartial {
+				continue
+			}
+			for _, hash := range bundle {
+				partial[hash] = struct{}{}
+			}
+		}
+
+		if len(partial) == 0 {
+			break
+		}
+
+		var err error
+		if txs, err = dropWithFollowers(p.signer, txs, func(tx *types.Transaction, _ common.Address) bool {
+			_, ok := partial[tx.Hash()]
+			return ok
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		byHash   = make(map[common.Hash]*types.Transaction, len(txs))
+		bySender = make(map[common.Address]types.Transactions)
+		senders  = make(map[common.Hash]common.Address, len(txs))
+		bundled  = make(map[common.Hash][]common.Hash)
+	)
+	for _, tx := range txs {
+		sender, err := types.Sender(p.signer, tx)
+		if err != nil {
+			return nil, err
+		}
+		byHash[tx.Hash()] = tx
+		senders[tx.Hash()] = sender
+		bySender[sender] = append(bySender[sender], tx)
+	}
+	for _, bundle := range p.bundles {
+		if len(bundle) == 0 {
+			continue
+		}
+		if _, ok := byHash[bundle[0]]; !ok {
+			continue
+		}
+		for _, hash := range bundle {
+			bundled[hash] = bundle
+		}
+	}
+
+	var (
+		ordered = make(types.Transactions, 0, len(txs))
+		emitted = make(map[common.Hash]struct{}, len(txs))
+		queued  = make(map[common.Hash]struct{}, len(txs))
+		waiting [][]common.Hash
+	)
+
+	// ready checks whether all the lower nonce transactions of the given unit's senders have been
+	// emitted, or come earlier in the unit itself.
+	ready := func(unit []common.Hash) bool {
+		for i, hash := range unit {
+			for _, prev := range bySender[senders[hash]] {
+				if prev.Nonce() >= byHash[hash].Nonce() {
+					continue
+				}
+				if _, ok := emitted[prev.Hash()]; ok {
+					continue
+				}
+				if slices.Contains(unit[:i], prev.Hash()) {
+					continue
+				}
+				return false
+			}
+		}
+		return true
+	}
+
+	// Emit the bundles and the other transactions in list order, a bundle at the position of its first
+	// transaction. Units whose senders' lower nonces aren't emitted yet wait until they are, so each
+	// sender's nonces stay in order.
+	for _, tx := range txs {
+		if _, ok := queued[tx.Hash()]; ok {
+			continue
+		}
+
+		unit := []common.Hash{tx.Hash()}
+		if bundle, ok := bundled[tx.Hash()]; ok {
+			unit = make([]common.Hash, 0, len(bundle))
+			for _, hash := range bundle {
+				if _, ok := queued[hash]; !ok {
+					unit = append(unit, hash)
+				}
+			}
+		}
+		for _, hash := range unit {
+			queued[hash] = struct{}{}
+		}
+		waiting = append(waiting, unit)
+
+		for i := 0; i < len(waiting); {
+			if !ready(waiting[i]) {
+				i++
+				continue
+			}
+
+			for _, hash := range waiting[i] {
+				ordered = append(ordered, byHash[hash])
+				emitted[hash] = struct{}{}
+			}
+
+			// Restart from the earliest waiting unit, which may be ready now.
+			waiting = append(waiting[:i], waiting[i+1:]...)
+			i = 0
+		}
+	}
+
+	// The units still waiting can never be placed without a nonce gap, e.g. a bundle ordering a sender's
+	// transactions against their nonces, so they are dropped, along with the units depending on them.
+	return ordered, nil
+}
+
+const (
+	bundleAbsent = iota
+	bundleComplete
+	bundlePartial
+)
+
+// bundleState checks whether the given bundle is absent, complete or partially included in the list.
+func bundleState(bundle []common.Hash, present map[common.Hash]struct{}) int {
+	var found int
+	for _, hash := range bundle {
+		if _, ok := present[hash]; ok {
+			found++
+		}
+	}
+
+	switch found {
+	case 0:
+		return bundleAbsent
+	case len(bundle):
+		return bundleComplete
+	default:
+		return bundlePartial
+	}
+}
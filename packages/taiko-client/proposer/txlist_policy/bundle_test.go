@@ -0,0 +1,137 @@
+package policy
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundlePolicyComplete(t *testing.T) {
+	var (
+		alice = newTestKey(t)
+		bob   = newTestKey(t)
+		carol = newTestKey(t)
+		txs   = types.Transactions{
+			newTestTx(t, alice, 0, 1, nil),
+			newTestTx(t, bob, 0, 1, nil),
+			newTestTx(t, carol, 0, 1, nil),
+		}
+	)
+
+	ordered, err := NewBundlePolicy(
+		testSigner,
+		[][]common.Hash{{txs[0].Hash(), txs[2].Hash()}},
+	).Apply(txs)
+	require.Nil(t, err)
+	require.Equal(t, hashes(types.Transactions{txs[0], txs[2], txs[1]}), hashes(ordered))
+}
+
+func TestBundlePolicyPartial(t *testing.T) {
+	var (
+		alice   = newTestKey(t)
+		bob     = newTestKey(t)
+		carol   = newTestKey(t)
+		missing = newTestTx(t, carol, 1, 1, nil)
+		txs     = types.Transactions{
+			newTestTx(t, alice, 0, 1, nil),
+			newTestTx(t, alice, 1, 1, nil),
+			newTestTx(t, bob, 0, 1, nil),
+			newTestTx(t, carol, 0, 1, nil),
+		}
+	)
+
+	filtered, err := NewBundlePolicy(
+		testSigner,
+		[][]common.Hash{
+			{txs[0].Hash(), missing.Hash()},
+			// This bundle is dropped too, since alice's first transaction has been dropped.
+			{txs[1].Hash(), txs[2].Hash()},
+		},
+	).Apply(txs)
+	require.Nil(t, err)
+	require.Equal(t, hashes(types.Transactions{txs[3]}), hashes(filtered))
+}
+
+func TestBundlePolicyNonceOrder(t *testing.T) {
+	var (
+		alice = newTestKey(t)
+		bob   = newTestKey(t)
+		txs   = types.Transactions{
+			newTestTx(t, alice, 0, 1, nil),
+			newTestTx(t, bob, 0, 1, nil),
+			newTestTx(t, bob, 1, 1, nil),
+		}
+	)
+
+	// The bundle waits for bob's first transaction, instead of placing bob's second one before it.
+	ordered, err := NewBundlePolicy(
+		testSigner,
+		[][]common.Hash{{txs[0].Hash(), txs[2].Hash()}},
+	).Apply(txs)
+	require.Nil(t, err)
+	require.Equal(t, hashes(types.Transactions{txs[1], txs[0], txs[2]}), hashes(ordered))
+}
+
+func TestBundlePolicyNonceOrderDelaysFollowers(t *testing.T) {
+	var (
+		alice = newTestKey(t)
+		bob   = newTestKey(t)
+		txs   = types.Transactions{
+			newTestTx(t, alice, 0, 1, nil),
+			newTestTx(t, alice, 1, 1, nil),
+			newTestTx(t, bob, 0, 1, nil),
+			newTestTx(t, bob, 1, 1, nil),
+		}
+	)
+
+	// Alice's second transaction waits for the delayed bundle holding her first one.
+	ordered, err := NewBundlePolicy(
+		testSigner,
+		[][]common.Hash{{txs[0].Hash(), txs[3].Hash()}},
+	).Apply(txs)
+	require.Nil(t, err)
+	require.Equal(t, hashes(types.Transactions{txs[2], txs[0], txs[3], txs[1]}), hashes(ordered))
+}
+
+func TestBundlePolicyNonceOrderUnplaceable(t *testing.T) {
+	var (
+		bob   = newTestKey(t)
+		carol = newTestKey(t)
+		txs   = types.Transactions{
+			newTestTx(t, bob, 0, 1, nil),
+			newTestTx(t, bob, 1, 1, nil),
+			newTestTx(t, bob, 2, 1, nil),
+			newTestTx(t, carol, 0, 1, nil),
+		}
+	)
+
+	// A bundle ordering bob's transactions against their nonces can't be placed, so it's dropped
+	// along with bob's later transaction.
+	ordered, err := NewBundlePolicy(
+		testSigner,
+		[][]common.Hash{{txs[1].Hash(), txs[0].Hash()}},
+	).Apply(txs)
+	require.Nil(t, err)
+	require.Equal(t, hashes(types.Transactions{txs[3]}), hashes(ordered))
+}
+
+func TestLoadBundles(t *testing.T) {
+	bundles := [][]common.Hash{{common.HexToHash("0x01"), common.HexToHash("0x02")}}
+	data, err := json.Marshal(bundles)
+	require.Nil(t, err)
+
+	path := filepath.Join(t.TempDir(), "bundles.json")
+	require.Nil(t, os.WriteFile(path, data, 0600))
+
+	loaded, err := LoadBundles(path)
+	require.Nil(t, err)
+	require.Equal(t, bundles, loaded)
+
+	_, err = LoadBundles(filepath.Join(t.TempDir(), "notExist.json"))
+	require.ErrorContains(t, err, "failed to read bundles file")
+}
@@ -0,0 +1,38 @@
+package policy
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// DenyListPolicy removes all transactions sent from or sent to the denied addresses.
+type DenyListPolicy struct {
+	signer types.Signer
+	denied map[common.Address]struct{}
+}
+
+// NewDenyListPolicy creates a new DenyListPolicy instance.
+func NewDenyListPolicy(signer types.Signer, addresses []common.Address) *DenyListPolicy {
+	denied := make(map[common.Address]struct{}, len(addresses))
+	for _, address := range addresses {
+		denied[address] = struct{}{}
+	}
+
+	return &DenyListPolicy{signer: signer, denied: denied}
+}
+
+// Apply implements the TxListPolicy interface.
+func (p *DenyListPolicy) Apply(txs types.Transactions) (types.Transactions, error) {
+	return dropWithFollowers(p.signer, txs, func(tx *types.Transaction, sender common.Address) bool {
+		if _, ok := p.denied[sender]; ok {
+			return true
+		}
+		if tx.To() != nil {
+			if _, ok := p.denied[*tx.To()]; ok {
+				return true
+			}
+		}
+
+		return false
+	})
+}
@@ -0,0 +1,47 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDenyListPolicySender(t *testing.T) {
+	var (
+		alice = newTestKey(t)
+		bob   = newTestKey(t)
+		txs   = types.Transactions{
+			newTestTx(t, alice, 0, 1, nil),
+			newTestTx(t, bob, 0, 1, nil),
+			newTestTx(t, alice, 1, 1, nil),
+		}
+	)
+
+	filtered, err := NewDenyListPolicy(
+		testSigner,
+		[]common.Address{crypto.PubkeyToAddress(alice.PublicKey)},
+	).Apply(txs)
+	require.Nil(t, err)
+	require.Equal(t, hashes(types.Transactions{txs[1]}), hashes(filtered))
+}
+
+func TestDenyListPolicyRecipient(t *testing.T) {
+	var (
+		alice = newTestKey(t)
+		bob   = newTestKey(t)
+		txs   = types.Transactions{
+			newTestTx(t, alice, 0, 1, nil),
+			newTestTx(t, alice, 1, 1, nil),
+			newTestTx(t, alice, 2, 1, nil),
+			newTestTx(t, bob, 0, 1, nil),
+		}
+	)
+
+	// The transactions after the denied one are dropped too, since their nonces are not continuous anymore.
+	filtered, err := NewDenyListPolicy(testSigner, []common.Address{*txs[1].To()}).Apply(txs)
+	require.Nil(t, err)
+	require.Equal(t, hashes(types.Transactions{txs[0], txs[3]}), hashes(filtered))
+}
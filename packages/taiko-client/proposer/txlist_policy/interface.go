@@ -0,0 +1,52 @@
+package policy
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// TxListPolicy is an interface for filtering or reordering a transactions list fetched from
+// the L2 execution engine's transaction pool, before it gets proposed.
+type TxListPolicy interface {
+	Apply(txs types.Transactions) (types.Transactions, error)
+}
+
+// Chain applies the given policies to the transactions list one by one.
+func Chain(txs types.Transactions, policies ...TxListPolicy) (types.Transactions, error) {
+	var err error
+	for _, p := range policies {
+		if txs, err = p.Apply(txs); err != nil {
+			return nil, err
+		}
+	}
+
+	return txs, nil
+}
+
+// dropWithFollowers removes the transactions marked by the given function from the list, and since
+// the later transactions of the same sender will have a nonce gap after that, they are removed too.
+func dropWithFollowers(
+	signer types.Signer,
+	txs types.Transactions,
+	drop func(tx *types.Transaction, sender common.Address) bool,
+) (types.Transactions, error) {
+	var (
+		dropped  = make(map[common.Address]bool)
+		filtered = make(types.Transactions, 0, len(txs))
+	)
+	for _, tx := range txs {
+		sender, err := types.Sender(signer, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		if dropped[sender] || drop(tx, sender) {
+			dropped[sender] = true
+			continue
+		}
+
+		filtered = append(filtered, tx)
+	}
+
+	return filtered, nil
+}
@@ -0,0 +1,70 @@
+package policy
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+var testSigner = types.LatestSignerForChainID(common.Big1)
+
+func newTestKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	return key
+}
+
+func newTestTx(t *testing.T, key *ecdsa.PrivateKey, nonce uint64, tip int64, data []byte) *types.Transaction {
+	to := common.BigToAddress(big.NewInt(int64(nonce) + 1))
+	tx, err := types.SignNewTx(key, testSigner, &types.DynamicFeeTx{
+		ChainID:   common.Big1,
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(tip),
+		GasFeeCap: big.NewInt(tip),
+		Gas:       21_000,
+		To:        &to,
+		Data:      data,
+	})
+	require.Nil(t, err)
+
+	return tx
+}
+
+func hashes(txs types.Transactions) []common.Hash {
+	hashes := make([]common.Hash, len(txs))
+	for i, tx := range txs {
+		hashes[i] = tx.Hash()
+	}
+
+	return hashes
+}
+
+func TestChain(t *testing.T) {
+	var (
+		alice = newTestKey(t)
+		bob   = newTestKey(t)
+		txs   = types.Transactions{
+			newTestTx(t, alice, 0, 1, nil),
+			newTestTx(t, alice, 1, 1, nil),
+			newTestTx(t, bob, 0, 2, nil),
+		}
+	)
+
+	filtered, err := Chain(
+		txs,
+		NewMaxTxsPerSenderPolicy(testSigner, 1),
+		NewTipPerByteOrderingPolicy(testSigner, nil),
+	)
+	require.Nil(t, err)
+	require.Equal(t, hashes(types.Transactions{txs[2], txs[0]}), hashes(filtered))
+
+	filtered, err = Chain(txs)
+	require.Nil(t, err)
+	require.Equal(t, hashes(txs), hashes(filtered))
+}
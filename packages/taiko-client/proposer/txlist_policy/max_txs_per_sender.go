@@ -0,0 +1,28 @@
+package policy
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// MaxTxsPerSenderPolicy limits the number of transactions from a single sender in one transactions list,
+// only the first transactions (which have the lowest nonces) of each sender will be kept.
+type MaxTxsPerSenderPolicy struct {
+	signer types.Signer
+	max    uint64
+}
+
+// NewMaxTxsPerSenderPolicy creates a new MaxTxsPerSenderPolicy instance.
+func NewMaxTxsPerSenderPolicy(signer types.Signer, max uint64) *MaxTxsPerSenderPolicy {
+	return &MaxTxsPerSenderPolicy{signer: signer, max: max}
+}
+
+// Apply implements the TxListPolicy interface.
+func (p *MaxTxsPerSenderPolicy) Apply(txs types.Transactions) (types.Transactions, error) {
+	counts := make(map[common.Address]uint64)
+
+	return dropWithFollowers(p.signer, txs, func(_ *types.Transaction, sender common.Address) bool {
+		counts[sender]++
+		return counts[sender] > p.max
+	})
+}
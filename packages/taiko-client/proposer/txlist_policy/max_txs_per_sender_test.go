@@ -0,0 +1,30 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxTxsPerSenderPolicy(t *testing.T) {
+	var (
+		alice = newTestKey(t)
+		bob   = newTestKey(t)
+		txs   = types.Transactions{
+			newTestTx(t, alice, 0, 1, nil),
+			newTestTx(t, bob, 0, 1, nil),
+			newTestTx(t, alice, 1, 1, nil),
+			newTestTx(t, alice, 2, 1, nil),
+			newTestTx(t, bob, 1, 1, nil),
+		}
+	)
+
+	filtered, err := NewMaxTxsPerSenderPolicy(testSigner, 2).Apply(txs)
+	require.Nil(t, err)
+	require.Equal(t, hashes(types.Transactions{txs[0], txs[1], txs[2], txs[4]}), hashes(filtered))
+
+	filtered, err = NewMaxTxsPerSenderPolicy(testSigner, 0).Apply(txs)
+	require.Nil(t, err)
+	require.Empty(t, filtered)
+}
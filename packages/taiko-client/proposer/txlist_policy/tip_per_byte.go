@@ -0,0 +1,110 @@
+package policy
+
+import (
+	"container/heap"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// TipPerByteOrderingPolicy reorders the transactions list by the priority fee paid per transaction
+// byte, so the transactions which pay the most for the L1 data they occupy come first. Transactions
+// of the same sender always keep their nonce order.
+type TipPerByteOrderingPolicy struct {
+	signer  types.Signer
+	baseFee *big.Int
+}
+
+// NewTipPerByteOrderingPolicy creates a new TipPerByteOrderingPolicy instance, if the given base fee
+// is nil, transactions' tip caps will be used for ordering.
+func NewTipPerByteOrderingPolicy(signer types.Signer, baseFee *big.Int) *TipPerByteOrderingPolicy {
+	return &TipPerByteOrderingPolicy{signer: signer, baseFee: baseFee}
+}
+
+// Apply implements the TxListPolicy interface.
+func (p *TipPerByteOrderingPolicy) Apply(txs types.Transactions) (types.Transactions, error) {
+	var (
+		bySender  = make(map[common.Address]types.Transactions)
+		positions = make(map[common.Hash]int, len(txs))
+		senders   []common.Address
+	)
+	for i, tx := range txs {
+		positions[tx.Hash()] = i
+
+		sender, err := types.Sender(p.signer, tx)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := bySender[sender]; !ok {
+			senders = append(senders, sender)
+		}
+		bySender[sender] = append(bySender[sender], tx)
+	}
+
+	h := make(tipPerByteHeap, 0, len(senders))
+	for _, sender := range senders {
+		h = append(h, &tipPerByteEntry{
+			txs:      bySender[sender],
+			score:    p.score(bySender[sender][0]),
+			position: positions[bySender[sender][0].Hash()],
+		})
+	}
+	heap.Init(&h)
+
+	ordered := make(types.Transactions, 0, len(txs))
+	for h.Len() > 0 {
+		entry := h[0]
+		ordered = append(ordered, entry.txs[0])
+
+		if entry.txs = entry.txs[1:]; len(entry.txs) == 0 {
+			heap.Pop(&h)
+			continue
+		}
+		entry.score = p.score(entry.txs[0])
+		entry.position = positions[entry.txs[0].Hash()]
+		heap.Fix(&h, 0)
+	}
+
+	return ordered, nil
+}
+
+// score calculates the priority fee paid per byte of the given transaction.
+func (p *TipPerByteOrderingPolicy) score(tx *types.Transaction) *big.Int {
+	tip, err := tx.EffectiveGasTip(p.baseFee)
+	if err != nil || tip.Sign() <= 0 || tx.Size() == 0 {
+		return common.Big0
+	}
+
+	return new(big.Int).Div(new(big.Int).Mul(tip, new(big.Int).SetUint64(tx.Gas())), new(big.Int).SetUint64(tx.Size()))
+}
+
+// tipPerByteEntry is the pending transactions of one sender, ordered by nonce.
+type tipPerByteEntry struct {
+	txs      types.Transactions
+	score    *big.Int
+	position int // Position of the first transaction in the original list, used to break ties
+}
+
+// tipPerByteHeap is a max heap of senders' pending transactions, keyed by the score of their first transaction.
+type tipPerByteHeap []*tipPerByteEntry
+
+func (h tipPerByteHeap) Len() int { return len(h) }
+func (h tipPerByteHeap) Less(i, j int) bool {
+	if cmp := h[i].score.Cmp(h[j].score); cmp != 0 {
+		return cmp > 0
+	}
+	return h[i].position < h[j].position
+}
+func (h tipPerByteHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *tipPerByteHeap) Push(x any) { *h = append(*h, x.(*tipPerByteEntry)) }
+
+func (h *tipPerByteHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil
+	*h = old[0 : n-1]
+	return x
+}
@@ -0,0 +1,28 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTipPerByteOrderingPolicy(t *testing.T) {
+	var (
+		alice = newTestKey(t)
+		bob   = newTestKey(t)
+		carol = newTestKey(t)
+		txs   = types.Transactions{
+			newTestTx(t, alice, 0, 1, nil),
+			newTestTx(t, alice, 1, 100, nil),
+			newTestTx(t, bob, 0, 10, nil),
+			newTestTx(t, carol, 0, 10, make([]byte, 4096)),
+		}
+	)
+
+	ordered, err := NewTipPerByteOrderingPolicy(testSigner, nil).Apply(txs)
+	require.Nil(t, err)
+	// Alice's second transaction pays the highest tip, but it can only be included after her first one,
+	// and carol's transaction pays the lowest tip per byte because of its large calldata.
+	require.Equal(t, hashes(types.Transactions{txs[2], txs[0], txs[1], txs[3]}), hashes(ordered))
+}
@@ -0,0 +1,320 @@
+package bond
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/utils"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+)
+
+// topUpWindow is the rolling window in which the total top up amount is limited.
+const topUpWindow = 24 * time.Hour
+
+// Config contains the configurations of a bond manager.
+type Config struct {
+	// TaikoTokenAddress is the address of the TaikoToken contract.
+	TaikoTokenAddress common.Address
+	// Holder is the address which pays the liveness bonds, i.e. the prover or the ProverSet contract.
+	Holder common.Address
+	// Spenders are the contracts which transfer the liveness bonds from the holder.
+	Spenders []common.Address
+	// ManageAllowances is whether the allowances of the holder can be approved by the prover.
+	ManageAllowances bool
+	// LivenessBond is the liveness bond of a block.
+	LivenessBond *big.Int
+	// MinBalance is the TaikoToken balance, below which an alert is raised and a top up is made.
+	MinBalance *big.Int
+	// Treasury is the address to top up the holder from, empty means no top up.
+	Treasury common.Address
+	// TopUpAmount is the amount of a single top up.
+	TopUpAmount *big.Int
+	// MaxTopUpPerDay is the maximum total amount of the top ups in 24 hours, nil means no limit.
+	MaxTopUpPerDay *big.Int
+	// AllowanceThreshold is the allowance, below which the AllowanceAmount will be approved again,
+	// nil means never approving.
+	AllowanceThreshold *big.Int
+	// AllowanceAmount is the amount to approve.
+	AllowanceAmount *big.Int
+	// CheckInterval is the interval to check the balance and allowances, it must be positive.
+	CheckInterval time.Duration
+}
+
+// topUp is a top up made by the manager.
+type topUp struct {
+	time   time.Time
+	amount *big.Int
+}
+
+// Manager keeps watching the TaikoToken balance and allowances used to pay the liveness bonds, it tops up
+// the balance from a treasury and approves more allowances once they hit the configured thresholds,
+// and raises alerts when they are insufficient, so the prover never starts rejecting assignments.
+type Manager struct {
+	rpc   *rpc.Client
+	txmgr txmgr.TxManager
+	cfg   *Config
+
+	mu     sync.Mutex
+	topUps []*topUp
+}
+
+// New creates a new bond manager instance.
+func New(cli *rpc.Client, txmgr txmgr.TxManager, cfg *Config) *Manager {
+	return &Manager{rpc: cli, txmgr: txmgr, cfg: cfg}
+}
+
+// Loop keeps checking the balance and allowances on an interval, until the given context is done.
+func (m *Manager) Loop(ctx context.Context) {
+	ticker := time.NewTicker(m.cfg.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.Check(ctx); err != nil {
+			log.Error("Failed to check liveness bond balance and allowances", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check checks the balance and allowances once, topping up or approving if necessary.
+func (m *Manager) Check(ctx context.Context) error {
+	var alerts int
+
+	for _, spender := range m.cfg.Spenders {
+		ok, err := m.checkAllowance(ctx, spender)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			alerts++
+		}
+	}
+
+	ok, err := m.checkBalance(ctx)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		alerts++
+	}
+
+	metrics.ProverBondAlertGauge.Set(float64(alerts))
+
+	return nil
+}
+
+// checkBalance checks the TaikoToken balance of the holder, and tops it up if it's below the minimum balance.
+// Returns false if the balance is still insufficient.
+func (m *Manager) checkBalance(ctx context.Context) (bool, error) {
+	balance, err := m.rpc.TaikoToken.BalanceOf(&bind.CallOpts{Context: ctx}, m.cfg.Holder)
+	if err != nil {
+		return false, fmt.Errorf("failed to get TaikoToken balance: %w", err)
+	}
+	metrics.ProverBondBalanceGauge.Set(toEther(balance))
+
+	if balance.Cmp(m.cfg.MinBalance) >= 0 {
+		return true, nil
+	}
+
+	if m.cfg.Treasury == (common.Address{}) {
+		log.Warn(
+			"Liveness bond balance is low, please top up",
+			"holder", m.cfg.Holder,
+			"balance", utils.WeiToEther(balance),
+			"minBalance", utils.WeiToEther(m.cfg.MinBalance),
+		)
+		return false, nil
+	}
+
+	amount := m.allowedTopUp(time.Now(), m.cfg.TopUpAmount)
+	if amount.Sign() == 0 {
+		log.Error(
+			"Liveness bond balance is low, and the daily top up limit is reached",
+			"holder", m.cfg.Holder,
+			"balance", utils.WeiToEther(balance),
+			"minBalance", utils.WeiToEther(m.cfg.MinBalance),
+			"maxTopUpPerDay", utils.WeiToEther(m.cfg.MaxTopUpPerDay),
+		)
+		return false, nil
+	}
+
+	if err := m.topUp(ctx, amount); err != nil {
+		log.Error(
+			"Failed to top up liveness bond balance from treasury",
+			"holder", m.cfg.Holder,
+			"treasury", m.cfg.Treasury,
+			"amount", utils.WeiToEther(amount),
+			"error", err,
+		)
+		return false, nil
+	}
+
+	return new(big.Int).Add(balance, amount).Cmp(m.cfg.MinBalance) >= 0, nil
+}
+
+// topUp transfers the given amount of TaikoToken from the treasury to the holder, the treasury should
+// have approved enough allowance for the prover.
+func (m *Manager) topUp(ctx context.Context, amount *big.Int) error {
+	opts := &bind.CallOpts{Context: ctx}
+	treasuryAllowance, err := m.rpc.TaikoToken.Allowance(opts, m.cfg.Treasury, m.txmgr.From())
+	if err != nil {
+		return err
+	}
+	treasuryBalance, err := m.rpc.TaikoToken.BalanceOf(opts, m.cfg.Treasury)
+	if err != nil {
+		return err
+	}
+	if treasuryAllowance.Cmp(amount) < 0 || treasuryBalance.Cmp(amount) < 0 {
+		return fmt.Errorf(
+			"insufficient treasury balance (%s) or allowance (%s)",
+			utils.WeiToEther(treasuryBalance),
+			utils.WeiToEther(treasuryAllowance),
+		)
+	}
+
+	data, err := encoding.TaikoTokenABI.Pack("transferFrom", m.cfg.Treasury, m.cfg.Holder, amount)
+	if err != nil {
+		return err
+	}
+	if err := m.send(ctx, data); err != nil {
+		return err
+	}
+
+	m.recordTopUp(time.Now(), amount)
+	metrics.ProverBondTopUpCounter.Add(1)
+
+	log.Info(
+		"Topped up liveness bond balance from treasury",
+		"holder", m.cfg.Holder,
+		"treasury", m.cfg.Treasury,
+		"amount", utils.WeiToEther(amount),
+	)
+
+	return nil
+}
+
+// checkAllowance checks the allowance of the given spender contract, and approves more if it's below
+// the threshold. Returns false if the allowance can not cover a liveness bond.
+func (m *Manager) checkAllowance(ctx context.Context, spender common.Address) (bool, error) {
+	allowance, err := m.rpc.TaikoToken.Allowance(&bind.CallOpts{Context: ctx}, m.cfg.Holder, spender)
+	if err != nil {
+		return false, fmt.Errorf("failed to get TaikoToken allowance: %w", err)
+	}
+
+	if !m.needsApproval(allowance) {
+		if allowance.Cmp(m.cfg.LivenessBond) < 0 {
+			log.Warn(
+				"Allowance can not cover a liveness bond, please approve more",
+				"holder", m.cfg.Holder,
+				"spender", spender,
+				"allowance", utils.WeiToEther(allowance),
+				"livenessBond", utils.WeiToEther(m.cfg.LivenessBond),
+			)
+			return false, nil
+		}
+		return true, nil
+	}
+
+	log.Info(
+		"Allowance is below the threshold, approve more",
+		"spender", spender,
+		"allowance", utils.WeiToEther(allowance),
+		"threshold", utils.WeiToEther(m.cfg.AllowanceThreshold),
+		"amount", utils.WeiToEther(m.cfg.AllowanceAmount),
+	)
+
+	data, err := encoding.TaikoTokenABI.Pack("approve", spender, m.cfg.AllowanceAmount)
+	if err != nil {
+		return false, err
+	}
+	if err := m.send(ctx, data); err != nil {
+		log.Error("Failed to approve allowance", "spender", spender, "error", err)
+		return false, nil
+	}
+
+	return m.cfg.AllowanceAmount.Cmp(m.cfg.LivenessBond) >= 0, nil
+}
+
+// needsApproval returns true if the given allowance is below the threshold and can be approved by the prover.
+func (m *Manager) needsApproval(allowance *big.Int) bool {
+	return m.cfg.ManageAllowances &&
+		m.cfg.AllowanceThreshold != nil &&
+		allowance.Cmp(m.cfg.AllowanceThreshold) < 0
+}
+
+// send sends a transaction calling the TaikoToken contract with the given data.
+func (m *Manager) send(ctx context.Context, data []byte) error {
+	receipt, err := m.txmgr.Send(ctx, txmgr.TxCandidate{TxData: data, To: &m.cfg.TaikoTokenAddress})
+	if err != nil {
+		return err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("transaction reverted: %s", receipt.TxHash.Hex())
+	}
+
+	return nil
+}
+
+// allowedTopUp returns the part of the given amount which can still be topped up at the given time,
+// without exceeding the daily limit.
+func (m *Manager) allowedTopUp(now time.Time, amount *big.Int) *big.Int {
+	if m.cfg.MaxTopUpPerDay == nil {
+		return amount
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	spent := new(big.Int)
+	for _, t := range m.topUps {
+		if now.Sub(t.time) < topUpWindow {
+			spent.Add(spent, t.amount)
+		}
+	}
+
+	remaining := new(big.Int).Sub(m.cfg.MaxTopUpPerDay, spent)
+	if remaining.Sign() <= 0 {
+		return new(big.Int)
+	}
+	if remaining.Cmp(amount) < 0 {
+		return remaining
+	}
+
+	return amount
+}
+
+// recordTopUp records a top up made at the given time, and drops the ones out of the window.
+func (m *Manager) recordTopUp(now time.Time, amount *big.Int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	topUps := m.topUps[:0]
+	for _, t := range m.topUps {
+		if now.Sub(t.time) < topUpWindow {
+			topUps = append(topUps, t)
+		}
+	}
+	m.topUps = append(topUps, &topUp{time: now, amount: amount})
+}
+
+// toEther converts the given wei amount to a float64 ether amount for metrics.
+func toEther(wei *big.Int) float64 {
+	f, _ := utils.WeiToEther(wei).Float64()
+	return f
+}
@@ -0,0 +1,48 @@
+package bond
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllowedTopUp(t *testing.T) {
+	var (
+		m   = New(nil, nil, &Config{MaxTopUpPerDay: big.NewInt(100)})
+		now = time.Now()
+	)
+
+	require.Equal(t, big.NewInt(60), m.allowedTopUp(now, big.NewInt(60)))
+	m.recordTopUp(now, big.NewInt(60))
+
+	// Only the remaining part of the daily limit can be topped up.
+	require.Equal(t, big.NewInt(40), m.allowedTopUp(now.Add(time.Hour), big.NewInt(60)))
+	m.recordTopUp(now.Add(time.Hour), big.NewInt(40))
+	require.Zero(t, m.allowedTopUp(now.Add(2*time.Hour), big.NewInt(60)).Sign())
+
+	// The first top up is out of the window.
+	require.Equal(t, big.NewInt(60), m.allowedTopUp(now.Add(topUpWindow), big.NewInt(60)))
+	m.recordTopUp(now.Add(topUpWindow), big.NewInt(10))
+	require.Len(t, m.topUps, 2)
+}
+
+func TestAllowedTopUpNoLimit(t *testing.T) {
+	m := New(nil, nil, &Config{})
+
+	m.recordTopUp(time.Now(), big.NewInt(1_000))
+	require.Equal(t, big.NewInt(1_000), m.allowedTopUp(time.Now(), big.NewInt(1_000)))
+}
+
+func TestNeedsApproval(t *testing.T) {
+	m := New(nil, nil, &Config{ManageAllowances: true, AllowanceThreshold: big.NewInt(50)})
+
+	require.True(t, m.needsApproval(big.NewInt(49)))
+	require.False(t, m.needsApproval(big.NewInt(50)))
+
+	// Never approve if the threshold is not set, or the allowances are not managed by the prover.
+	require.False(t, New(nil, nil, &Config{ManageAllowances: true}).needsApproval(common.Big0))
+	require.False(t, New(nil, nil, &Config{AllowanceThreshold: big.NewInt(50)}).needsApproval(common.Big0))
+}
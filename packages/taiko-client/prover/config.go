@@ -10,7 +10,6 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/urfave/cli/v2"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
@@ -31,6 +30,8 @@ type Config struct {
 	AssignmentHookAddress                   common.Address
 	ProverSetAddress                        common.Address
 	L1ProverPrivKey                         *ecdsa.PrivateKey
+	RemoteSignerEndpoint                    string
+	RemoteSignerAddress                     common.Address
 	StartingBlockID                         *big.Int
 	Dummy                                   bool
 	GuardianProverMinorityAddress           common.Address
@@ -41,6 +42,18 @@ type Config struct {
 	BackOffRetryInterval                    time.Duration
 	ProveUnassignedBlocks                   bool
 	ContesterMode                           bool
+	CatchUpMode                             bool
+	CatchUpWorkersPerTier                   uint64
+	CatchUpProducerConcurrency              uint64
+	ProofGasMaxSpend                        *big.Int
+	ProofGasSlackFeeCap                     *big.Int
+	ProofGasEscalationWindow                time.Duration
+	BondCheckInterval                       time.Duration
+	BondMinBalance                          *big.Int
+	BondTreasury                            common.Address
+	BondTopUpAmount                         *big.Int
+	BondMaxTopUpPerDay                      *big.Int
+	BondAllowanceThreshold                  *big.Int
 	EnableLivenessBondProof                 bool
 	RPCTimeout                              time.Duration
 	ProveBlockGasLimit                      uint64
@@ -56,11 +69,16 @@ type Config struct {
 	MaxBlockSlippage                        uint64
 	Allowance                               *big.Int
 	GuardianProverHealthCheckServerEndpoint *url.URL
+	GuardianSigningHistoryPath              string
+	GuardianHeartbeatInterval               time.Duration
+	GuardianHeartbeatDiagnostics            bool
 	RaikoHostEndpoint                       string
 	RaikoJWT                                string
 	L1NodeVersion                           string
 	L2NodeVersion                           string
 	BlockConfirmations                      uint64
+	L1EventStream                           bool
+	L1LogCacheBlocks                        uint64
 	TxmgrConfigs                            *txmgr.CLIConfig
 }
 
@@ -69,9 +87,13 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 	var (
 		jwtSecret []byte
 	)
-	l1ProverPrivKey, err := crypto.ToECDSA(common.FromHex(c.String(flags.L1ProverPrivKey.Name)))
+	l1ProverPrivKey, remoteSignerEndpoint, remoteSignerAddress, err := pkgFlags.ParsePrivKeyOrRemoteSigner(
+		c,
+		flags.L1ProverPrivKey,
+		"L1 prover private key",
+	)
 	if err != nil {
-		return nil, fmt.Errorf("invalid L1 prover private key: %w", err)
+		return nil, err
 	}
 
 	var startingBlockID *big.Int
@@ -141,6 +163,59 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		return nil, err
 	}
 
+	proofGasMaxSpend, err := optionalEtherToWei(c, flags.ProofGasMaxSpend)
+	if err != nil {
+		return nil, err
+	}
+
+	proofGasSlackFeeCap, err := utils.GWeiToWei(c.Float64(flags.ProofGasSlackFeeCap.Name))
+	if err != nil {
+		return nil, fmt.Errorf("invalid proof submission slack fee cap: %w", err)
+	}
+
+	if c.Duration(flags.BondCheckInterval.Name) < 0 {
+		return nil, fmt.Errorf("invalid --%s: %s", flags.BondCheckInterval.Name, c.Duration(flags.BondCheckInterval.Name))
+	}
+
+	if c.Duration(flags.GuardianHeartbeatInterval.Name) <= 0 {
+		return nil, fmt.Errorf(
+			"invalid --%s: %s",
+			flags.GuardianHeartbeatInterval.Name,
+			c.Duration(flags.GuardianHeartbeatInterval.Name),
+		)
+	}
+
+	bondMinBalance, err := optionalEtherToWei(c, flags.BondMinBalance)
+	if err != nil {
+		return nil, err
+	}
+	bondTopUpAmount, err := optionalEtherToWei(c, flags.BondTopUpAmount)
+	if err != nil {
+		return nil, err
+	}
+	bondMaxTopUpPerDay, err := optionalEtherToWei(c, flags.BondMaxTopUpPerDay)
+	if err != nil {
+		return nil, err
+	}
+	bondAllowanceThreshold, err := optionalEtherToWei(c, flags.BondAllowanceThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	var bondTreasury common.Address
+	if c.IsSet(flags.BondTreasury.Name) {
+		if !common.IsHexAddress(c.String(flags.BondTreasury.Name)) {
+			return nil, fmt.Errorf("invalid bond treasury address: %s", c.String(flags.BondTreasury.Name))
+		}
+		bondTreasury = common.HexToAddress(c.String(flags.BondTreasury.Name))
+		if bondTopUpAmount == nil || bondTopUpAmount.Sign() == 0 {
+			return nil, errors.New("--bond.topUpAmount flag is required if bond treasury is set")
+		}
+	}
+	if bondAllowanceThreshold != nil && allowance.Cmp(bondAllowanceThreshold) <= 0 {
+		return nil, errors.New("--prover.allowance should be greater than --bond.allowanceThreshold")
+	}
+
 	if !c.IsSet(flags.GuardianProverMajority.Name) && !c.IsSet(flags.RaikoHostEndpoint.Name) {
 		return nil, errors.New("empty raiko host endpoint")
 	}
@@ -163,6 +238,8 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		AssignmentHookAddress:                   common.HexToAddress(c.String(flags.AssignmentHookAddress.Name)),
 		ProverSetAddress:                        common.HexToAddress(c.String(flags.ProverSetAddress.Name)),
 		L1ProverPrivKey:                         l1ProverPrivKey,
+		RemoteSignerEndpoint:                    remoteSignerEndpoint,
+		RemoteSignerAddress:                     remoteSignerAddress,
 		RaikoHostEndpoint:                       c.String(flags.RaikoHostEndpoint.Name),
 		RaikoJWT:                                common.Bytes2Hex(jwtSecret),
 		StartingBlockID:                         startingBlockID,
@@ -171,11 +248,26 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		GuardianProverMajorityAddress:           common.HexToAddress(c.String(flags.GuardianProverMajority.Name)),
 		GuardianProofSubmissionDelay:            c.Duration(flags.GuardianProofSubmissionDelay.Name),
 		GuardianProverHealthCheckServerEndpoint: guardianProverHealthCheckServerEndpoint,
+		GuardianSigningHistoryPath:              c.String(flags.GuardianSigningHistory.Name),
+		GuardianHeartbeatInterval:               c.Duration(flags.GuardianHeartbeatInterval.Name),
+		GuardianHeartbeatDiagnostics:            c.Bool(flags.GuardianHeartbeatDiagnostics.Name),
 		Graffiti:                                c.String(flags.Graffiti.Name),
 		BackOffMaxRetries:                       c.Uint64(flags.BackOffMaxRetries.Name),
 		BackOffRetryInterval:                    c.Duration(flags.BackOffRetryInterval.Name),
 		ProveUnassignedBlocks:                   c.Bool(flags.ProveUnassignedBlocks.Name),
 		ContesterMode:                           c.Bool(flags.ContesterMode.Name),
+		CatchUpMode:                             c.Bool(flags.CatchUpMode.Name),
+		CatchUpWorkersPerTier:                   c.Uint64(flags.CatchUpWorkersPerTier.Name),
+		CatchUpProducerConcurrency:              c.Uint64(flags.CatchUpProducerConcurrency.Name),
+		ProofGasMaxSpend:                        proofGasMaxSpend,
+		ProofGasSlackFeeCap:                     proofGasSlackFeeCap,
+		ProofGasEscalationWindow:                c.Duration(flags.ProofGasEscalationWindow.Name),
+		BondCheckInterval:                       c.Duration(flags.BondCheckInterval.Name),
+		BondMinBalance:                          bondMinBalance,
+		BondTreasury:                            bondTreasury,
+		BondTopUpAmount:                         bondTopUpAmount,
+		BondMaxTopUpPerDay:                      bondMaxTopUpPerDay,
+		BondAllowanceThreshold:                  bondAllowanceThreshold,
 		EnableLivenessBondProof:                 c.Bool(flags.EnableLivenessBondProof.Name),
 		RPCTimeout:                              c.Duration(flags.RPCTimeout.Name),
 		ProveBlockGasLimit:                      c.Uint64(flags.TxGasLimit.Name),
@@ -193,6 +285,8 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		L1NodeVersion:                           c.String(flags.L1NodeVersion.Name),
 		L2NodeVersion:                           c.String(flags.L2NodeVersion.Name),
 		BlockConfirmations:                      c.Uint64(flags.BlockConfirmations.Name),
+		L1EventStream:                           c.Bool(flags.L1EventStream.Name),
+		L1LogCacheBlocks:                        c.Uint64(flags.L1LogCacheBlocks.Name),
 		TxmgrConfigs: pkgFlags.InitTxmgrConfigsFromCli(
 			c.String(flags.L1HTTPEndpoint.Name),
 			l1ProverPrivKey,
@@ -200,3 +294,17 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		),
 	}, nil
 }
+
+// optionalEtherToWei parses the given ether amount flag into wei, returns nil if the flag is not set.
+func optionalEtherToWei(c *cli.Context, flag *cli.Float64Flag) (*big.Int, error) {
+	if !c.IsSet(flag.Name) {
+		return nil, nil
+	}
+
+	amount, err := utils.EtherToWei(c.Float64(flag.Name))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s flag value: %w", flag.Name, err)
+	}
+
+	return amount, nil
+}
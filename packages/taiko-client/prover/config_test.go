@@ -104,6 +104,27 @@ func (s *ProverTestSuite) TestNewConfigFromCliContextProverKeyError() {
 	}), "invalid L1 prover private key")
 }
 
+func (s *ProverTestSuite) TestNewConfigFromCliContextBondCheckIntervalErr() {
+	app := s.SetupApp()
+	app.Flags = append(app.Flags, flags.BondCheckInterval)
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextBondCheckIntervalErr",
+		"--" + flags.L1ProverPrivKey.Name, os.Getenv("L1_PROVER_PRIVATE_KEY"),
+		"--" + flags.BondCheckInterval.Name, "-1s",
+	}), "invalid --"+flags.BondCheckInterval.Name)
+}
+
+func (s *ProverTestSuite) TestNewConfigFromCliContextGuardianHeartbeatIntervalErr() {
+	app := s.SetupApp()
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextGuardianHeartbeatIntervalErr",
+		"--" + flags.L1ProverPrivKey.Name, os.Getenv("L1_PROVER_PRIVATE_KEY"),
+		"--" + flags.GuardianHeartbeatInterval.Name, "-1s",
+	}), "invalid --"+flags.GuardianHeartbeatInterval.Name)
+}
+
 func (s *ProverTestSuite) SetupApp() *cli.App {
 	app := cli.NewApp()
 	app.Flags = []cli.Flag{
@@ -131,6 +152,10 @@ func (s *ProverTestSuite) SetupApp() *cli.App {
 		&cli.StringFlag{Name: flags.L1NodeVersion.Name},
 		&cli.StringFlag{Name: flags.L2NodeVersion.Name},
 		&cli.StringFlag{Name: flags.RaikoHostEndpoint.Name},
+		&cli.DurationFlag{
+			Name:  flags.GuardianHeartbeatInterval.Name,
+			Value: flags.GuardianHeartbeatInterval.Value,
+		},
 	}
 	app.Flags = append(app.Flags, flags.TxmgrFlags...)
 	app.Action = func(ctx *cli.Context) error {
@@ -53,7 +53,7 @@ func (s *EventHandlerTestSuite) SetupTest() {
 	s.d = d
 
 	// Init calldata syncer
-	testState, err := state.New(context.Background(), s.RPCClient)
+	testState, err := state.New(context.Background(), s.RPCClient, nil)
 	s.Nil(err)
 	s.Nil(testState.ResetL1Current(context.Background(), common.Big0))
 
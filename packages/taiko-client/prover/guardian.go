@@ -6,6 +6,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/log"
 	"golang.org/x/sync/errgroup"
+
+	guardianProverHeartbeater "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/guardian_prover_heartbeater"
 )
 
 var (
@@ -18,7 +20,12 @@ func (p *Prover) guardianProverHeartbeatLoop(ctx context.Context) {
 	p.wg.Add(1)
 	defer p.wg.Done()
 
-	ticker := time.NewTicker(heartbeatInterval)
+	interval := p.cfg.GuardianHeartbeatInterval
+	if interval == 0 {
+		interval = heartbeatInterval
+	}
+
+	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
 	for {
@@ -29,15 +36,16 @@ func (p *Prover) guardianProverHeartbeatLoop(ctx context.Context) {
 			var (
 				latestL1Block uint64
 				latestL2Block uint64
-				err           error
 				g             = new(errgroup.Group)
 			)
 
 			g.Go(func() error {
+				var err error
 				latestL1Block, err = p.rpc.L1.BlockNumber(ctx)
 				return err
 			})
 			g.Go(func() error {
+				var err error
 				latestL2Block, err = p.rpc.L2.BlockNumber(ctx)
 				return err
 			})
@@ -46,13 +54,58 @@ func (p *Prover) guardianProverHeartbeatLoop(ctx context.Context) {
 				continue
 			}
 
+			var diagnostics *guardianProverHeartbeater.Diagnostics
+			if p.cfg.GuardianHeartbeatDiagnostics {
+				diagnostics = p.collectHeartbeatDiagnostics(ctx)
+			}
+
 			if err := p.guardianProverHeartbeater.SendHeartbeat(
 				ctx,
 				latestL1Block,
 				latestL2Block,
+				diagnostics,
 			); err != nil {
 				log.Error("Failed to send guardian prover heartbeat", "error", err)
 			}
 		}
 	}
 }
+
+// collectHeartbeatDiagnostics collects the node health diagnostics which will be sent alongside a heartbeat,
+// the diagnostics which can not be fetched will be left empty, since they should never block a heartbeat.
+func (p *Prover) collectHeartbeatDiagnostics(ctx context.Context) *guardianProverHeartbeater.Diagnostics {
+	diagnostics := &guardianProverHeartbeater.Diagnostics{
+		Timestamp:     uint64(time.Now().Unix()),
+		PendingProofs: uint64(len(p.proofSubmissionCh) + len(p.proofGenerationCh)),
+	}
+	if p.proofScheduler != nil {
+		diagnostics.PendingProofs += uint64(p.proofScheduler.Len())
+	}
+
+	if peers, err := p.rpc.L1.PeerCount(ctx); err != nil {
+		log.Debug("Failed to get L1 peer count", "error", err)
+	} else {
+		diagnostics.L1PeerCount = &peers
+	}
+
+	if peers, err := p.rpc.L2.PeerCount(ctx); err != nil {
+		log.Debug("Failed to get L2 peer count", "error", err)
+	} else {
+		diagnostics.L2PeerCount = &peers
+	}
+
+	progress, err := p.rpc.L2ExecutionEngineSyncProgress(ctx)
+	if err != nil {
+		log.Warn("Failed to get L2 execution engine sync progress", "error", err)
+		return diagnostics
+	}
+
+	diagnostics.L2Syncing = progress.IsSyncing()
+	diagnostics.L2SyncCurrentBlockID = progress.CurrentBlockID.Uint64()
+	diagnostics.L2SyncHighestBlockID = progress.HighestBlockID.Uint64()
+	if diagnostics.L2SyncHighestBlockID > diagnostics.L2SyncCurrentBlockID {
+		diagnostics.L2SyncLag = diagnostics.L2SyncHighestBlockID - diagnostics.L2SyncCurrentBlockID
+	}
+
+	return diagnostics
+}
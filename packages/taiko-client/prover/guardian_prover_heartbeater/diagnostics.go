@@ -0,0 +1,22 @@
+package guardianproverheartbeater
+
+// DiagnosticsVersion is the current version of the heartbeat diagnostics payload, it should be bumped
+// whenever a field is changed or removed, so that the health check server can decode the payload accordingly.
+const DiagnosticsVersion uint64 = 1
+
+// Diagnostics contains the node health diagnostics of a guardian prover, which will be sent
+// alongside each heartbeat.
+type Diagnostics struct {
+	Version              uint64  `json:"version"`
+	Timestamp            uint64  `json:"timestamp"`
+	LatestL1Block        uint64  `json:"latestL1Block"`
+	LatestL2Block        uint64  `json:"latestL2Block"`
+	L1PeerCount          *uint64 `json:"l1PeerCount,omitempty"`
+	L2PeerCount          *uint64 `json:"l2PeerCount,omitempty"`
+	L2Syncing            bool    `json:"l2Syncing"`
+	L2SyncCurrentBlockID uint64  `json:"l2SyncCurrentBlockID"`
+	L2SyncHighestBlockID uint64  `json:"l2SyncHighestBlockID"`
+	L2SyncLag            uint64  `json:"l2SyncLag"`
+	PendingProofs        uint64  `json:"pendingProofs"`
+	LastSignedBlockID    uint64  `json:"lastSignedBlockID"`
+}
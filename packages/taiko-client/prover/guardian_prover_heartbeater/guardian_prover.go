@@ -1,27 +1,35 @@
 package guardianproverheartbeater
 
 import (
+	"bytes"
 	"context"
-	"crypto/ecdsa"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"net/url"
+	"sync/atomic"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/go-resty/resty/v2"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/signer"
+	protection "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/signing_protection"
 )
 
 // healthCheckReq is the request body sent to the health check server when a heartbeat is sent.
+// The diagnostics fields are optional, so that the request stays compatible with the health check servers
+// which only know about the legacy fields.
 type healthCheckReq struct {
-	ProverAddress      string `json:"prover"`
-	HeartBeatSignature []byte `json:"heartBeatSignature"`
-	LatestL1Block      uint64 `json:"latestL1Block"`
-	LatestL2Block      uint64 `json:"latestL2Block"`
+	ProverAddress        string          `json:"prover"`
+	HeartBeatSignature   []byte          `json:"heartBeatSignature"`
+	LatestL1Block        uint64          `json:"latestL1Block"`
+	LatestL2Block        uint64          `json:"latestL2Block"`
+	Diagnostics          json.RawMessage `json:"diagnostics,omitempty"`
+	DiagnosticsSignature []byte          `json:"diagnosticsSignature,omitempty"`
 }
 
 // signedBlockReq is the request body sent to the health check server when a block is signed.
@@ -44,21 +52,25 @@ type startupReq struct {
 
 // GuardianProverHeartBeater is responsible for signing and sending known blocks to the health check server.
 type GuardianProverHeartBeater struct {
-	privateKey                *ecdsa.PrivateKey
+	signer                    signer.Signer
+	signingProtection         *protection.SigningProtection
 	healthCheckServerEndpoint *url.URL
 	rpc                       *rpc.Client
 	proverAddress             common.Address
+	lastSignedBlockID         atomic.Uint64
 }
 
 // New creates a new GuardianProverBlockSender instance.
 func New(
-	privateKey *ecdsa.PrivateKey,
+	signer signer.Signer,
+	signingProtection *protection.SigningProtection,
 	healthCheckServerEndpoint *url.URL,
 	rpc *rpc.Client,
 	proverAddress common.Address,
 ) *GuardianProverHeartBeater {
 	return &GuardianProverHeartBeater{
-		privateKey:                privateKey,
+		signer:                    signer,
+		signingProtection:         signingProtection,
 		healthCheckServerEndpoint: healthCheckServerEndpoint,
 		rpc:                       rpc,
 		proverAddress:             proverAddress,
@@ -91,7 +103,7 @@ func (s *GuardianProverHeartBeater) post(ctx context.Context, route string, req
 func (s *GuardianProverHeartBeater) SignAndSendBlock(ctx context.Context, blockID *big.Int) error {
 	signed, header, err := s.signBlock(ctx, blockID)
 	if err != nil {
-		return nil
+		return err
 	}
 
 	if signed == nil {
@@ -102,9 +114,21 @@ func (s *GuardianProverHeartBeater) SignAndSendBlock(ctx context.Context, blockI
 		return err
 	}
 
+	s.updateLastSignedBlockID(blockID.Uint64())
+
 	return nil
 }
 
+// updateLastSignedBlockID updates the last signed block ID, if the given one is higher.
+func (s *GuardianProverHeartBeater) updateLastSignedBlockID(blockID uint64) {
+	for {
+		last := s.lastSignedBlockID.Load()
+		if blockID <= last || s.lastSignedBlockID.CompareAndSwap(last, blockID) {
+			return
+		}
+	}
+}
+
 // SendStartupMessage sends the startup message to the health check server.
 func (s *GuardianProverHeartBeater) SendStartupMessage(
 	ctx context.Context,
@@ -118,15 +142,16 @@ func (s *GuardianProverHeartBeater) SendStartupMessage(
 		return nil
 	}
 
-	sig, err := crypto.Sign(
-		crypto.Keccak256Hash(
+	sig, err := s.signer.Sign(
+		ctx,
+		bytes.Join([][]byte{
 			s.proverAddress.Bytes(),
 			[]byte(revision),
 			[]byte(version),
 			[]byte(l1NodeVersion),
 			[]byte(l2NodeVersion),
-		).Bytes(),
-		s.privateKey)
+		}, nil),
+	)
 	if err != nil {
 		return err
 	}
@@ -216,7 +241,18 @@ func (s *GuardianProverHeartBeater) signBlock(ctx context.Context, blockID *big.
 		"eventBlockID", blockID.Uint64(),
 	)
 
-	signed, err := crypto.Sign(header.Hash().Bytes(), s.privateKey)
+	// Make sure we never sign two different hashes for the same block ID.
+	if err := s.signingProtection.CheckAndRecord(blockID.Uint64(), header.Hash()); err != nil {
+		return nil, nil, err
+	}
+
+	// The header hash is the keccak256 hash of its RLP encoding.
+	encoded, err := rlp.EncodeToBytes(header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signed, err := s.signer.Sign(ctx, encoded)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -224,13 +260,15 @@ func (s *GuardianProverHeartBeater) signBlock(ctx context.Context, blockID *big.
 	return signed, header, nil
 }
 
-// SendHeartbeat sends a heartbeat to the health check server.
+// SendHeartbeat sends a heartbeat to the health check server, if the given diagnostics is not nil,
+// it will also be signed and sent alongside the heartbeat.
 func (s *GuardianProverHeartBeater) SendHeartbeat(
 	ctx context.Context,
 	latestL1Block uint64,
 	latestL2Block uint64,
+	diagnostics *Diagnostics,
 ) error {
-	sig, err := crypto.Sign(crypto.Keccak256Hash([]byte("HEART_BEAT")).Bytes(), s.privateKey)
+	sig, err := s.signer.Sign(ctx, []byte("HEART_BEAT"))
 	if err != nil {
 		return err
 	}
@@ -242,11 +280,27 @@ func (s *GuardianProverHeartBeater) SendHeartbeat(
 		LatestL2Block:      latestL2Block,
 	}
 
+	if diagnostics != nil {
+		diagnostics.Version = DiagnosticsVersion
+		diagnostics.LatestL1Block = latestL1Block
+		diagnostics.LatestL2Block = latestL2Block
+		diagnostics.LastSignedBlockID = s.lastSignedBlockID.Load()
+
+		encoded, err := json.Marshal(diagnostics)
+		if err != nil {
+			return err
+		}
+		if req.DiagnosticsSignature, err = s.signer.Sign(ctx, encoded); err != nil {
+			return err
+		}
+		req.Diagnostics = encoded
+	}
+
 	if err := s.post(ctx, "healthCheck", req); err != nil {
 		return err
 	}
 
-	log.Info("Successfully sent heartbeat", "signature", common.Bytes2Hex(sig))
+	log.Info("Successfully sent heartbeat", "signature", common.Bytes2Hex(sig), "diagnostics", diagnostics != nil)
 
 	return nil
 }
@@ -0,0 +1,107 @@
+package guardianproverheartbeater
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/signer"
+)
+
+func newTestHeartbeater(t *testing.T, handler http.HandlerFunc) *GuardianProverHeartBeater {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	endpoint, err := url.Parse(srv.URL)
+	require.Nil(t, err)
+
+	return New(signer.NewLocalSigner(key), nil, endpoint, nil, crypto.PubkeyToAddress(key.PublicKey))
+}
+
+func TestSendHeartbeatLegacy(t *testing.T) {
+	var body map[string]json.RawMessage
+	s := newTestHeartbeater(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/healthCheck", r.URL.Path)
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+	})
+
+	require.Nil(t, s.SendHeartbeat(context.Background(), 10, 20, nil))
+
+	require.Equal(t, "10", string(body["latestL1Block"]))
+	require.Equal(t, "20", string(body["latestL2Block"]))
+	require.NotNil(t, body["heartBeatSignature"])
+	require.Nil(t, body["diagnostics"])
+	require.Nil(t, body["diagnosticsSignature"])
+}
+
+func TestSendHeartbeatDiagnostics(t *testing.T) {
+	var req healthCheckReq
+	s := newTestHeartbeater(t, func(_ http.ResponseWriter, r *http.Request) {
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&req))
+	})
+	s.lastSignedBlockID.Store(8)
+
+	peers := uint64(3)
+	require.Nil(t, s.SendHeartbeat(context.Background(), 10, 20, &Diagnostics{
+		L2PeerCount:   &peers,
+		PendingProofs: 2,
+		L2SyncLag:     1,
+	}))
+
+	// The legacy fields are kept.
+	require.Equal(t, uint64(10), req.LatestL1Block)
+	require.Equal(t, uint64(20), req.LatestL2Block)
+	require.Equal(t, s.proverAddress.Hex(), req.ProverAddress)
+
+	var diagnostics Diagnostics
+	require.Nil(t, json.Unmarshal(req.Diagnostics, &diagnostics))
+	require.Equal(t, DiagnosticsVersion, diagnostics.Version)
+	require.Equal(t, uint64(10), diagnostics.LatestL1Block)
+	require.Equal(t, uint64(20), diagnostics.LatestL2Block)
+	require.Equal(t, uint64(8), diagnostics.LastSignedBlockID)
+	require.Equal(t, uint64(2), diagnostics.PendingProofs)
+	require.Equal(t, peers, *diagnostics.L2PeerCount)
+	require.Nil(t, diagnostics.L1PeerCount)
+
+	// The diagnostics should be signed by the guardian prover.
+	pubKey, err := crypto.SigToPub(crypto.Keccak256(req.Diagnostics), req.DiagnosticsSignature)
+	require.Nil(t, err)
+	require.Equal(t, s.proverAddress, crypto.PubkeyToAddress(*pubKey))
+}
+
+func TestSendHeartbeatServerError(t *testing.T) {
+	s := newTestHeartbeater(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	require.NotNil(t, s.SendHeartbeat(context.Background(), 10, 20, &Diagnostics{}))
+}
+
+func TestUpdateLastSignedBlockID(t *testing.T) {
+	s := &GuardianProverHeartBeater{}
+
+	var wg sync.WaitGroup
+	for i := uint64(1); i <= 100; i++ {
+		wg.Add(1)
+		go func(blockID uint64) {
+			defer wg.Done()
+			s.updateLastSignedBlockID(blockID)
+		}(i)
+	}
+	wg.Wait()
+	require.Equal(t, uint64(100), s.lastSignedBlockID.Load())
+
+	// A lower block ID never overwrites a higher one.
+	s.updateLastSignedBlockID(50)
+	require.Equal(t, uint64(100), s.lastSignedBlockID.Load())
+}
@@ -19,7 +19,12 @@ type BlockSigner interface {
 
 // Heartbeater defines an interface that communicates with a central Guardian Prover server, sending heartbeats.
 type Heartbeater interface {
-	SendHeartbeat(ctx context.Context, latestL1Block uint64, latestL2Block uint64) error
+	SendHeartbeat(
+		ctx context.Context,
+		latestL1Block uint64,
+		latestL2Block uint64,
+		diagnostics *Diagnostics,
+	) error
 }
 
 // BlockSenderHeartbeater defines an interface that communicates with a central Guardian Prover server,
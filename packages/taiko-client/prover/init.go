@@ -15,6 +15,7 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/utils"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	bond "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/bond_manager"
 	handler "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/event_handler"
 	proofProducer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
 	proofSubmitter "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_submitter"
@@ -91,12 +92,50 @@ func (p *Prover) setApprovalAmount(ctx context.Context, contract common.Address)
 	return nil
 }
 
+// newBondManager creates a new liveness bond manager based on the prover configurations.
+func (p *Prover) newBondManager(livenessBond *big.Int) *bond.Manager {
+	cfg := &bond.Config{
+		TaikoTokenAddress:  p.cfg.TaikoTokenAddress,
+		Holder:             p.ProverAddress(),
+		Spenders:           []common.Address{p.cfg.TaikoL1Address, p.cfg.AssignmentHookAddress},
+		ManageAllowances:   true,
+		LivenessBond:       livenessBond,
+		MinBalance:         p.cfg.BondMinBalance,
+		Treasury:           p.cfg.BondTreasury,
+		TopUpAmount:        p.cfg.BondTopUpAmount,
+		MaxTopUpPerDay:     p.cfg.BondMaxTopUpPerDay,
+		AllowanceThreshold: p.cfg.BondAllowanceThreshold,
+		AllowanceAmount:    p.cfg.Allowance,
+		CheckInterval:      p.cfg.BondCheckInterval,
+	}
+	if cfg.MinBalance == nil {
+		cfg.MinBalance = livenessBond
+	}
+	// The allowances of a ProverSet contract can only be approved by its owner.
+	if p.cfg.ProverSetAddress != rpc.ZeroAddress {
+		cfg.Holder = p.cfg.ProverSetAddress
+		cfg.ManageAllowances = false
+	}
+
+	return bond.New(p.rpc, p.txmgr, cfg)
+}
+
 // initProofSubmitters initializes the proof submitters from the given tiers in protocol.
 func (p *Prover) initProofSubmitters(
 	txmgr *txmgr.SimpleTxManager,
 	txBuilder *transaction.ProveBlockTxBuilder,
 	tiers []*rpc.TierProviderTierWithID,
 ) error {
+	var gasStrategy *transaction.DeadlineGasStrategy
+	if p.cfg.ProofGasMaxSpend != nil {
+		gasStrategy = transaction.NewDeadlineGasStrategy(
+			p.cfg.ProofGasSlackFeeCap,
+			p.cfg.ProofGasMaxSpend,
+			p.cfg.ProofGasEscalationWindow,
+			tiers,
+		)
+	}
+
 	for _, tier := range p.sharedState.GetTiers() {
 		var (
 			producer  proofProducer.ProofProducer
@@ -134,6 +173,7 @@ func (p *Prover) initProofSubmitters(
 			tiers,
 			p.IsGuardianProver(),
 			p.cfg.GuardianProofSubmissionDelay,
+			gasStrategy,
 		); err != nil {
 			return err
 		}
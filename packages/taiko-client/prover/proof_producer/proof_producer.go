@@ -8,6 +8,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
 )
@@ -55,6 +56,9 @@ type ProofWithHeader struct {
 	Proof   []byte
 	Opts    *ProofRequestOptions
 	Tier    uint16
+	// SpanContext is the context of the span which requested the proof, so that the proof submission
+	// can be traced in the same trace.
+	SpanContext trace.SpanContext
 }
 
 type ProofProducer interface {
@@ -19,6 +19,7 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/tracing"
 )
 
 const (
@@ -77,7 +78,15 @@ func (s *SGXProofProducer) RequestProof(
 	blockID *big.Int,
 	meta *bindings.TaikoDataBlockMetadata,
 	header *types.Header,
-) (*ProofWithHeader, error) {
+) (_ *ProofWithHeader, err error) {
+	ctx, span := tracing.Start(
+		ctx,
+		"SGXProofProducer.RequestProof",
+		tracing.BlockID(blockID),
+		tracing.Tier(s.Tier()),
+	)
+	defer func() { tracing.End(span, err) }()
+
 	log.Info(
 		"Request proof from raiko-host service",
 		"blockID", blockID,
@@ -117,7 +126,7 @@ func (s *SGXProofProducer) callProverDaemon(ctx context.Context, opts *ProofRequ
 		if ctx.Err() != nil {
 			return nil
 		}
-		output, err := s.requestProof(opts)
+		output, err := s.requestProof(ctx, opts)
 		if err != nil {
 			log.Error("Failed to request proof", "height", opts.BlockID, "error", err, "endpoint", s.RaikoHostEndpoint)
 			return err
@@ -157,8 +166,15 @@ func (s *SGXProofProducer) callProverDaemon(ctx context.Context, opts *ProofRequ
 	return proof, nil
 }
 
-// requestProof sends a RPC request to proverd to try to get the requested proof.
-func (s *SGXProofProducer) requestProof(opts *ProofRequestOptions) (*RaikoRequestProofBodyResponse, error) {
+// requestProof sends a RPC request to proverd to try to get the requested proof, the trace context
+// is passed to proverd in the request headers.
+func (s *SGXProofProducer) requestProof(
+	ctx context.Context,
+	opts *ProofRequestOptions,
+) (_ *RaikoRequestProofBodyResponse, err error) {
+	ctx, span := tracing.Start(ctx, "SGXProofProducer.requestProof", tracing.BlockID(opts.BlockID))
+	defer func() { tracing.End(span, err) }()
+
 	reqBody := RaikoRequestProofBody{
 		Type:     s.ProofType,
 		Block:    opts.BlockID,
@@ -178,11 +194,12 @@ func (s *SGXProofProducer) requestProof(opts *ProofRequestOptions) (*RaikoReques
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", s.RaikoHostEndpoint+"/v1/proof", bytes.NewBuffer(jsonValue))
+	req, err := http.NewRequestWithContext(ctx, "POST", s.RaikoHostEndpoint+"/v1/proof", bytes.NewBuffer(jsonValue))
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/json")
+	tracing.InjectHeaders(ctx, req.Header)
 	if len(s.JWT) > 0 {
 		req.Header.Set("Authorization", "Bearer "+base64.StdEncoding.EncodeToString([]byte(s.JWT)))
 	}
@@ -2,12 +2,16 @@ package producer
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
@@ -49,3 +53,25 @@ func TestSGXProducerRequestProof(t *testing.T) {
 	require.Equal(t, res.Tier, encoding.TierSgxID)
 	require.NotEmpty(t, res.Proof)
 }
+
+func TestSGXProducerTraceContext(t *testing.T) {
+	var traceparent string
+	raiko := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		require.Nil(t, json.NewEncoder(w).Encode(&RaikoRequestProofBodyResponse{
+			Data: &RaikoProofData{Proof: "0x1234"},
+		}))
+	}))
+	defer raiko.Close()
+
+	ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "test")
+	defer span.End()
+
+	producer := &SGXProofProducer{RaikoHostEndpoint: raiko.URL, ProofType: ProofTypeSgx}
+	proof, err := producer.callProverDaemon(ctx, &ProofRequestOptions{BlockID: common.Big1})
+	require.Nil(t, err)
+	require.Equal(t, common.Hex2Bytes("1234"), proof)
+
+	// The trace context is passed to Raiko, as the parent of the request span.
+	require.Contains(t, traceparent, span.SpanContext().TraceID().String())
+}
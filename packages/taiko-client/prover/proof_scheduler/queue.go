@@ -0,0 +1,98 @@
+package scheduler
+
+import (
+	"container/heap"
+	"time"
+
+	proofProducer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
+)
+
+// item is a proof request waiting in a queue.
+type item struct {
+	req      *proofProducer.ProofRequestBody
+	deadline time.Time
+}
+
+// blockID returns the ID of the block which the proof request belongs to.
+func (i *item) blockID() uint64 {
+	return i.req.Event.BlockId.Uint64()
+}
+
+// itemHeap is a min-heap of items, ordered by the given less function.
+type itemHeap struct {
+	items []*item
+	less  func(a, b *item) bool
+}
+
+func (h *itemHeap) Len() int           { return len(h.items) }
+func (h *itemHeap) Less(i, j int) bool { return h.less(h.items[i], h.items[j]) }
+func (h *itemHeap) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *itemHeap) Push(x interface{}) { h.items = append(h.items, x.(*item)) }
+func (h *itemHeap) Pop() interface{} {
+	old := h.items
+	n := len(old)
+	it := old[n-1]
+	old[n-1] = nil
+	h.items = old[:n-1]
+	return it
+}
+
+// queue is a priority queue of proof requests of a single tier. The requests whose proving window
+// has not expired yet are always popped first, ordered by their deadlines, since they are the ones
+// which can still lose a liveness bond. The expired requests are popped afterwards, ordered by block ID,
+// because blocks can only be verified in order.
+type queue struct {
+	pending *itemHeap
+	expired *itemHeap
+	notify  chan struct{}
+}
+
+// newQueue creates a new empty queue.
+func newQueue() *queue {
+	return &queue{
+		pending: &itemHeap{less: func(a, b *item) bool {
+			if a.deadline.Equal(b.deadline) {
+				return a.blockID() < b.blockID()
+			}
+			return a.deadline.Before(b.deadline)
+		}},
+		expired: &itemHeap{less: func(a, b *item) bool { return a.blockID() < b.blockID() }},
+		notify:  make(chan struct{}, 1),
+	}
+}
+
+// push adds a new item to the queue.
+func (q *queue) push(it *item) {
+	heap.Push(q.pending, it)
+}
+
+// pop removes and returns the item with the highest priority at the given time,
+// or nil if the queue is empty.
+func (q *queue) pop(now time.Time) *item {
+	// Move the items whose deadline has passed to the expired heap.
+	for q.pending.Len() > 0 && !q.pending.items[0].deadline.After(now) {
+		heap.Push(q.expired, heap.Pop(q.pending))
+	}
+
+	if q.pending.Len() > 0 {
+		return heap.Pop(q.pending).(*item)
+	}
+	if q.expired.Len() > 0 {
+		return heap.Pop(q.expired).(*item)
+	}
+
+	return nil
+}
+
+// len returns the number of items in the queue.
+func (q *queue) len() int {
+	return q.pending.Len() + q.expired.Len()
+}
+
+// signal wakes up a worker waiting on the queue, won't block if there is already a pending signal.
+func (q *queue) signal() {
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,177 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
+	proofProducer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
+)
+
+// Handler handles a scheduled proof request.
+type Handler func(ctx context.Context, req *proofProducer.ProofRequestBody) error
+
+// ProducerSelector returns the proof producer which will be used to generate the proof
+// for the given request, or nil if there is no such producer.
+type ProducerSelector func(req *proofProducer.ProofRequestBody) proofProducer.ProofProducer
+
+// Scheduler is used by the prover to catch up with a backlog of blocks. It keeps a worker pool
+// for each tier, which handles the proof requests concurrently, prioritized by their proving window
+// deadlines, while the number of concurrent requests sent to each proof producer is limited.
+type Scheduler struct {
+	ctx                 context.Context
+	workersPerTier      uint64
+	producerConcurrency uint64
+	selectProducer      ProducerSelector
+	handle              Handler
+
+	mu            sync.Mutex
+	queues        map[uint16]*queue
+	producerSlots map[proofProducer.ProofProducer]chan struct{}
+	wg            sync.WaitGroup
+}
+
+// New creates a new Scheduler instance, the workers will be stopped once the given context is done.
+func New(
+	ctx context.Context,
+	workersPerTier uint64,
+	producerConcurrency uint64,
+	selectProducer ProducerSelector,
+	handle Handler,
+) *Scheduler {
+	if workersPerTier == 0 {
+		workersPerTier = 1
+	}
+	if producerConcurrency == 0 {
+		producerConcurrency = 1
+	}
+
+	return &Scheduler{
+		ctx:                 ctx,
+		workersPerTier:      workersPerTier,
+		producerConcurrency: producerConcurrency,
+		selectProducer:      selectProducer,
+		handle:              handle,
+		queues:              make(map[uint16]*queue),
+		producerSlots:       make(map[proofProducer.ProofProducer]chan struct{}),
+	}
+}
+
+// Add schedules the given proof request, the deadline is the time when the proving window
+// of the block expires.
+func (s *Scheduler) Add(req *proofProducer.ProofRequestBody, deadline time.Time) {
+	s.mu.Lock()
+	q, ok := s.queues[req.Tier]
+	if !ok {
+		q = newQueue()
+		s.queues[req.Tier] = q
+		s.startWorkers(req.Tier, q)
+	}
+	q.push(&item{req: req, deadline: deadline})
+	s.mu.Unlock()
+
+	metrics.ProverCatchUpQueuedGauge.Inc()
+	log.Debug("Proof request scheduled", "blockID", req.Event.BlockId, "tier", req.Tier, "deadline", deadline)
+
+	q.signal()
+}
+
+// Len returns the number of the proof requests waiting to be handled.
+func (s *Scheduler) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var n int
+	for _, q := range s.queues {
+		n += q.len()
+	}
+
+	return n
+}
+
+// Wait blocks until all workers have stopped.
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+// startWorkers starts the worker pool of the given tier.
+func (s *Scheduler) startWorkers(tier uint16, q *queue) {
+	log.Info("Start proof request workers", "tier", tier, "workers", s.workersPerTier)
+
+	for i := uint64(0); i < s.workersPerTier; i++ {
+		s.wg.Add(1)
+		go s.worker(q)
+	}
+}
+
+// worker keeps handling the proof requests in the given queue.
+func (s *Scheduler) worker(q *queue) {
+	defer s.wg.Done()
+
+	for {
+		s.mu.Lock()
+		it := q.pop(time.Now())
+		remaining := q.len()
+		s.mu.Unlock()
+
+		if it == nil {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-q.notify:
+				continue
+			}
+		}
+
+		metrics.ProverCatchUpQueuedGauge.Dec()
+		// Wake up another idle worker if there are still requests in the queue.
+		if remaining > 0 {
+			q.signal()
+		}
+
+		s.process(it)
+	}
+}
+
+// process handles the given item, waiting for a free slot of its proof producer first.
+func (s *Scheduler) process(it *item) {
+	if producer := s.selectProducer(it.req); producer != nil {
+		slots := s.slotsOf(producer)
+		select {
+		case <-s.ctx.Done():
+			return
+		case slots <- struct{}{}:
+		}
+		defer func() { <-slots }()
+	}
+
+	metrics.ProverCatchUpInFlightGauge.Inc()
+	defer metrics.ProverCatchUpInFlightGauge.Dec()
+
+	if err := s.handle(s.ctx, it.req); err != nil {
+		log.Error(
+			"Failed to handle scheduled proof request",
+			"blockID", it.req.Event.BlockId,
+			"tier", it.req.Tier,
+			"deadline", it.deadline,
+			"error", err,
+		)
+	}
+}
+
+// slotsOf returns the semaphore which limits the concurrent requests of the given proof producer.
+func (s *Scheduler) slotsOf(producer proofProducer.ProofProducer) chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	slots, ok := s.producerSlots[producer]
+	if !ok {
+		slots = make(chan struct{}, s.producerConcurrency)
+		s.producerSlots[producer] = slots
+	}
+
+	return slots
+}
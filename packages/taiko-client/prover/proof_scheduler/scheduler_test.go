@@ -0,0 +1,159 @@
+package scheduler
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	proofProducer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
+)
+
+// testProducer is a proof producer which is only used as a concurrency limit key.
+type testProducer struct {
+	proofProducer.OptimisticProofProducer
+	name string
+}
+
+func newRequest(blockID uint64, tier uint16) *proofProducer.ProofRequestBody {
+	return &proofProducer.ProofRequestBody{
+		Tier:  tier,
+		Event: &bindings.TaikoL1ClientBlockProposed{BlockId: new(big.Int).SetUint64(blockID), Raw: types.Log{}},
+	}
+}
+
+func TestQueuePop(t *testing.T) {
+	var (
+		q   = newQueue()
+		now = time.Now()
+	)
+
+	q.push(&item{req: newRequest(1, 0), deadline: now.Add(3 * time.Hour)})
+	q.push(&item{req: newRequest(2, 0), deadline: now.Add(time.Hour)})
+	q.push(&item{req: newRequest(3, 0), deadline: now.Add(-time.Minute)})
+	q.push(&item{req: newRequest(4, 0), deadline: now.Add(-time.Hour)})
+	q.push(&item{req: newRequest(5, 0), deadline: now.Add(time.Hour)})
+	require.Equal(t, 5, q.len())
+
+	// The blocks closest to their deadlines first, then the expired ones in order.
+	for _, id := range []uint64{2, 5, 1, 3, 4} {
+		it := q.pop(now)
+		require.NotNil(t, it)
+		require.Equal(t, id, it.blockID())
+	}
+	require.Nil(t, q.pop(now))
+	require.Zero(t, q.len())
+}
+
+func TestQueuePopExpiresWhileWaiting(t *testing.T) {
+	var (
+		q   = newQueue()
+		now = time.Now()
+	)
+
+	q.push(&item{req: newRequest(2, 0), deadline: now.Add(time.Minute)})
+	q.push(&item{req: newRequest(1, 0), deadline: now.Add(-time.Minute)})
+	q.push(&item{req: newRequest(3, 0), deadline: now.Add(time.Hour)})
+
+	// Block 2 expired while waiting, block 3 can still be proven in time.
+	later := now.Add(2 * time.Minute)
+	for _, id := range []uint64{3, 1, 2} {
+		require.Equal(t, id, q.pop(later).blockID())
+	}
+}
+
+func TestSchedulerPriority(t *testing.T) {
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		now         = time.Now()
+		started     = make(chan struct{})
+		release     = make(chan struct{})
+		mu          sync.Mutex
+		handled     []uint64
+		wg          sync.WaitGroup
+	)
+	defer cancel()
+
+	s := New(ctx, 1, 1, func(*proofProducer.ProofRequestBody) proofProducer.ProofProducer {
+		return nil
+	}, func(_ context.Context, req *proofProducer.ProofRequestBody) error {
+		defer wg.Done()
+		if req.Event.BlockId.Uint64() == 1 {
+			close(started)
+			<-release
+		}
+		mu.Lock()
+		handled = append(handled, req.Event.BlockId.Uint64())
+		mu.Unlock()
+		return nil
+	})
+
+	wg.Add(4)
+	// Keep the only worker busy, so that the following requests are queued.
+	s.Add(newRequest(1, 0), now)
+	<-started
+
+	s.Add(newRequest(2, 0), now.Add(3*time.Hour))
+	s.Add(newRequest(3, 0), now.Add(-time.Hour))
+	s.Add(newRequest(4, 0), now.Add(time.Hour))
+	require.Equal(t, 3, s.Len())
+
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, []uint64{1, 4, 2, 3}, handled)
+	require.Zero(t, s.Len())
+
+	cancel()
+	s.Wait()
+}
+
+func TestSchedulerProducerConcurrency(t *testing.T) {
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		producers   = map[uint16]proofProducer.ProofProducer{
+			0: &testProducer{name: "a"},
+			1: &testProducer{name: "b"},
+		}
+		inFlight = map[uint16]*atomic.Int64{0: new(atomic.Int64), 1: new(atomic.Int64)}
+		maxSeen  = map[uint16]*atomic.Int64{0: new(atomic.Int64), 1: new(atomic.Int64)}
+		wg       sync.WaitGroup
+	)
+	defer cancel()
+
+	s := New(ctx, 4, 2, func(req *proofProducer.ProofRequestBody) proofProducer.ProofProducer {
+		return producers[req.Tier]
+	}, func(_ context.Context, req *proofProducer.ProofRequestBody) error {
+		defer wg.Done()
+		n := inFlight[req.Tier].Add(1)
+		defer inFlight[req.Tier].Add(-1)
+		for {
+			seen := maxSeen[req.Tier].Load()
+			if n <= seen || maxSeen[req.Tier].CompareAndSwap(seen, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		return nil
+	})
+
+	for i := uint64(0); i < 16; i++ {
+		wg.Add(1)
+		s.Add(newRequest(i, uint16(i%2)), time.Now().Add(time.Hour))
+	}
+	wg.Wait()
+
+	// Each producer handles two requests at most at the same time, while both
+	// producers are used concurrently.
+	require.Equal(t, int64(2), maxSeen[0].Load())
+	require.Equal(t, int64(2), maxSeen[1].Load())
+
+	cancel()
+	s.Wait()
+}
@@ -39,7 +39,7 @@ func NewProofContester(
 	return &ProofContester{
 		rpc:       rpcClient,
 		txBuilder: builder,
-		sender:    transaction.NewSender(rpcClient, txmgr, proverSetAddress, gasLimit),
+		sender:    transaction.NewSender(rpcClient, txmgr, proverSetAddress, gasLimit, nil),
 		graffiti:  rpc.StringToBytes32(graffiti),
 	}
 }
@@ -12,9 +12,11 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/tracing"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
 	validator "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/anchor_tx_validator"
 	handler "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/event_handler"
@@ -60,6 +62,7 @@ func NewProofSubmitter(
 	tiers []*rpc.TierProviderTierWithID,
 	isGuardian bool,
 	submissionDelay time.Duration,
+	gasStrategy *transaction.DeadlineGasStrategy,
 ) (*ProofSubmitter, error) {
 	anchorValidator, err := validator.New(taikoL2Address, rpcClient.L2.ChainID, rpcClient)
 	if err != nil {
@@ -72,7 +75,7 @@ func NewProofSubmitter(
 		resultCh:         resultCh,
 		anchorValidator:  anchorValidator,
 		txBuilder:        builder,
-		sender:           transaction.NewSender(rpcClient, txmgr, proverSetAddress, gasLimit),
+		sender:           transaction.NewSender(rpcClient, txmgr, proverSetAddress, gasLimit, gasStrategy),
 		proverAddress:    txmgr.From(),
 		proverSetAddress: proverSetAddress,
 		taikoL2Address:   taikoL2Address,
@@ -84,7 +87,15 @@ func NewProofSubmitter(
 }
 
 // RequestProof implements the Submitter interface.
-func (s *ProofSubmitter) RequestProof(ctx context.Context, event *bindings.TaikoL1ClientBlockProposed) error {
+func (s *ProofSubmitter) RequestProof(ctx context.Context, event *bindings.TaikoL1ClientBlockProposed) (err error) {
+	ctx, span := tracing.Start(
+		ctx,
+		"ProofSubmitter.RequestProof",
+		tracing.BlockID(event.BlockId),
+		tracing.Tier(s.Tier()),
+	)
+	defer func() { tracing.End(span, err) }()
+
 	header, err := s.rpc.WaitL2Header(ctx, event.BlockId)
 	if err != nil {
 		return fmt.Errorf("failed to fetch l2 Header, blockID: %d, error: %w", event.BlockId, err)
@@ -136,6 +147,7 @@ func (s *ProofSubmitter) RequestProof(ctx context.Context, event *bindings.Taiko
 	if err != nil {
 		return fmt.Errorf("failed to request proof (id: %d): %w", event.BlockId, err)
 	}
+	result.SpanContext = span.SpanContext()
 	s.resultCh <- result
 
 	metrics.ProverQueuedProofCounter.Add(1)
@@ -148,6 +160,15 @@ func (s *ProofSubmitter) SubmitProof(
 	ctx context.Context,
 	proofWithHeader *proofProducer.ProofWithHeader,
 ) (err error) {
+	// Continue the trace of the proof request, if any.
+	ctx, span := tracing.Start(
+		trace.ContextWithSpanContext(ctx, proofWithHeader.SpanContext),
+		"ProofSubmitter.SubmitProof",
+		tracing.BlockID(proofWithHeader.BlockID),
+		tracing.Tier(proofWithHeader.Tier),
+	)
+	defer func() { tracing.End(span, err) }()
+
 	log.Info(
 		"Submit block proof",
 		"blockID", proofWithHeader.BlockID,
@@ -90,6 +90,7 @@ func (s *ProofSubmitterTestSuite) SetupTest() {
 		tiers,
 		false,
 		0*time.Second,
+		nil,
 	)
 	s.Nil(err)
 	s.contester = NewProofContester(
@@ -102,7 +103,7 @@ func (s *ProofSubmitterTestSuite) SetupTest() {
 	)
 
 	// Init calldata syncer
-	testState, err := state.New(context.Background(), s.RPCClient)
+	testState, err := state.New(context.Background(), s.RPCClient, nil)
 	s.Nil(err)
 	s.Nil(testState.ResetL1Current(context.Background(), common.Big0))
 
@@ -202,6 +203,7 @@ func (s *ProofSubmitterTestSuite) TestGetRandomBumpedSubmissionDelay() {
 		s.submitter.tiers,
 		false,
 		time.Duration(0),
+		nil,
 	)
 	s.Nil(err)
 
@@ -222,6 +224,7 @@ func (s *ProofSubmitterTestSuite) TestGetRandomBumpedSubmissionDelay() {
 		s.submitter.tiers,
 		false,
 		1*time.Hour,
+		nil,
 	)
 	s.Nil(err)
 	delay, err = submitter2.getRandomBumpedSubmissionDelay(time.Now())
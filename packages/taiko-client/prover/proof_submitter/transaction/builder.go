@@ -69,6 +69,7 @@ func (a *ProveBlockTxBuilder) Build(
 			"Build proof submission transaction",
 			"blockID", blockID,
 			"gasLimit", txOpts.GasLimit,
+			"gasFeeCap", txOpts.GasFeeCap,
 			"guardian", guardian,
 		)
 
@@ -0,0 +1,87 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+
+	opcrypto "github.com/ethereum-optimism/optimism/op-service/crypto"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrFeeCapExceeded is returned when signing a transaction which pays more than the fee cap of its send.
+var ErrFeeCapExceeded = errors.New("transaction fee cap exceeded")
+
+// feeCapKey is the context key of the feeCapLimit of a transaction manager send.
+type feeCapKey struct{}
+
+// feeCapLimit is the maximum fee per gas of a transaction manager send.
+type feeCapLimit struct {
+	feeCap *big.Int
+	signed atomic.Bool
+}
+
+// WithFeeCap returns a copy of the given context, in which the transaction sent by a transaction manager
+// using FeeCapSignerFn, and all its fee bumped replacements, pay at most the given fee per gas.
+func WithFeeCap(ctx context.Context, feeCap *big.Int) context.Context {
+	return context.WithValue(ctx, feeCapKey{}, &feeCapLimit{feeCap: feeCap})
+}
+
+// FeeCapSignerFn wraps the given signer, to enforce the fee cap set by WithFeeCap in the signing context.
+// The first transaction of a send gets its fee cap lowered to the limit, and the fee bumped replacements
+// exceeding the limit are refused, so that the transaction manager keeps waiting for the previous one.
+func FeeCapSignerFn(signer opcrypto.SignerFn) opcrypto.SignerFn {
+	return func(ctx context.Context, address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		limit, ok := ctx.Value(feeCapKey{}).(*feeCapLimit)
+		if !ok {
+			return signer(ctx, address, tx)
+		}
+
+		if tx.GasFeeCap().Cmp(limit.feeCap) > 0 {
+			if limit.signed.Load() {
+				return nil, fmt.Errorf("%w: %s > %s", ErrFeeCapExceeded, tx.GasFeeCap(), limit.feeCap)
+			}
+
+			var err error
+			if tx, err = withFeeCap(tx, limit.feeCap); err != nil {
+				return nil, err
+			}
+		}
+
+		signed, err := signer(ctx, address, tx)
+		if err != nil {
+			return nil, err
+		}
+		limit.signed.Store(true)
+
+		return signed, nil
+	}
+}
+
+// withFeeCap returns a copy of the given dynamic fee transaction, with its fee cap and tip cap lowered to
+// the given fee cap.
+func withFeeCap(tx *types.Transaction, feeCap *big.Int) (*types.Transaction, error) {
+	if tx.Type() != types.DynamicFeeTxType {
+		return nil, fmt.Errorf("%w: unsupported transaction type %d", ErrFeeCapExceeded, tx.Type())
+	}
+
+	gasTipCap := tx.GasTipCap()
+	if gasTipCap.Cmp(feeCap) > 0 {
+		gasTipCap = feeCap
+	}
+
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:    tx.ChainId(),
+		Nonce:      tx.Nonce(),
+		GasTipCap:  new(big.Int).Set(gasTipCap),
+		GasFeeCap:  new(big.Int).Set(feeCap),
+		Gas:        tx.Gas(),
+		To:         tx.To(),
+		Value:      tx.Value(),
+		Data:       tx.Data(),
+		AccessList: tx.AccessList(),
+	}), nil
+}
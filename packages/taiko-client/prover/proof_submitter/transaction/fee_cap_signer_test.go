@@ -0,0 +1,139 @@
+package transaction
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+// feeCapTestBackend is a txmgr.ETHBackend which never mines the sent transactions, and raises its base
+// fee once the first transaction is sent, so that the transaction manager keeps bumping the fees.
+type feeCapTestBackend struct {
+	mu      sync.Mutex
+	baseFee *big.Int
+	raiseTo *big.Int
+	sent    []*types.Transaction
+}
+
+func (b *feeCapTestBackend) BlockNumber(context.Context) (uint64, error) { return 1, nil }
+func (b *feeCapTestBackend) CallContract(context.Context, ethereum.CallMsg, *big.Int) ([]byte, error) {
+	return nil, nil
+}
+func (b *feeCapTestBackend) TransactionReceipt(context.Context, common.Hash) (*types.Receipt, error) {
+	return nil, ethereum.NotFound
+}
+func (b *feeCapTestBackend) SendTransaction(_ context.Context, tx *types.Transaction) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.sent = append(b.sent, tx)
+	b.baseFee = b.raiseTo
+	return nil
+}
+func (b *feeCapTestBackend) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return &types.Header{Number: common.Big1, BaseFee: new(big.Int).Set(b.baseFee)}, nil
+}
+func (b *feeCapTestBackend) SuggestGasTipCap(context.Context) (*big.Int, error) {
+	return common.Big1, nil
+}
+func (b *feeCapTestBackend) NonceAt(context.Context, common.Address, *big.Int) (uint64, error) {
+	return 0, nil
+}
+func (b *feeCapTestBackend) PendingNonceAt(context.Context, common.Address) (uint64, error) {
+	return 0, nil
+}
+func (b *feeCapTestBackend) EstimateGas(context.Context, ethereum.CallMsg) (uint64, error) {
+	return 21_000, nil
+}
+func (b *feeCapTestBackend) Close() {}
+
+// sendWithFeeCap sends a transaction with a FeeCapSignerFn transaction manager until the context
+// times out, and returns all the published transactions.
+func sendWithFeeCap(t *testing.T, baseFee, raiseTo, feeCap *big.Int) []*types.Transaction {
+	var (
+		backend = &feeCapTestBackend{baseFee: baseFee, raiseTo: raiseTo}
+		chainID = big.NewInt(1)
+		signer  = types.LatestSignerForChainID(chainID)
+	)
+
+	mgr, err := txmgr.NewSimpleTxManagerFromConfig("feeCapTest", log.Root(), new(metrics.NoopTxMetrics), txmgr.Config{
+		Backend:                   backend,
+		ChainID:                   chainID,
+		FeeLimitMultiplier:        100,
+		ResubmissionTimeout:       10 * time.Millisecond,
+		ReceiptQueryInterval:      10 * time.Millisecond,
+		NetworkTimeout:            time.Second,
+		TxNotInMempoolTimeout:     time.Minute,
+		SafeAbortNonceTooLowCount: 3,
+		From:                      testAddr,
+		Signer: FeeCapSignerFn(func(_ context.Context, _ common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			return types.SignTx(tx, signer, testKey)
+		}),
+	})
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	if feeCap != nil {
+		ctx = WithFeeCap(ctx, feeCap)
+	}
+
+	_, err = mgr.Send(ctx, txmgr.TxCandidate{To: &common.Address{}, GasLimit: 21_000})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	backend.mu.Lock()
+	defer backend.mu.Unlock()
+	require.Greater(t, len(backend.sent), 1)
+
+	return backend.sent
+}
+
+func TestFeeCapSignerFnBumps(t *testing.T) {
+	feeCap := big.NewInt(30)
+
+	// Without a fee cap, the fees are bumped over it.
+	sent := sendWithFeeCap(t, big.NewInt(10), big.NewInt(100), nil)
+	require.Equal(t, big.NewInt(21), sent[0].GasFeeCap())
+	require.Greater(t, sent[len(sent)-1].GasFeeCap().Cmp(feeCap), 0)
+
+	// With a fee cap, the first transaction is kept after the bumps exceeding it.
+	sent = sendWithFeeCap(t, big.NewInt(10), big.NewInt(100), feeCap)
+	for _, tx := range sent {
+		require.Equal(t, sent[0].Hash(), tx.Hash())
+		require.Equal(t, big.NewInt(21), tx.GasFeeCap())
+	}
+}
+
+func TestFeeCapSignerFnLowersFirstTx(t *testing.T) {
+	feeCap := big.NewInt(30)
+
+	// The first transaction would pay 2 * 20 + 1, its fee cap is lowered to the limit.
+	sent := sendWithFeeCap(t, big.NewInt(20), big.NewInt(100), feeCap)
+	for _, tx := range sent {
+		require.Equal(t, feeCap, tx.GasFeeCap())
+		require.Equal(t, common.Big1, tx.GasTipCap())
+	}
+}
+
+func TestFeeCapSignerFnBumpsUnderCap(t *testing.T) {
+	feeCap := big.NewInt(50)
+
+	// The bumps under the fee cap are still allowed.
+	sent := sendWithFeeCap(t, big.NewInt(10), big.NewInt(20), feeCap)
+	require.Equal(t, big.NewInt(21), sent[0].GasFeeCap())
+	require.Greater(t, sent[len(sent)-1].GasFeeCap().Cmp(sent[0].GasFeeCap()), 0)
+	for _, tx := range sent {
+		require.LessOrEqual(t, tx.GasFeeCap().Cmp(feeCap), 0)
+	}
+}
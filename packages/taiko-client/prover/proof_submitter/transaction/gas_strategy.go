@@ -0,0 +1,138 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	handler "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/event_handler"
+)
+
+var (
+	// gasPricePollingInterval is the interval to check the L1 gas price again, when it's too high
+	// for the current fee cap.
+	gasPricePollingInterval = 12 * time.Second
+)
+
+// DeadlineGasStrategy decides the maximum fee per gas a proof submission transaction can pay, based on
+// how close the block is to its proving window deadline. The fee cap stays at the slack fee cap while
+// there is enough time left, then escalates linearly in the escalation window, up to the maximum spend
+// per proof at the deadline.
+type DeadlineGasStrategy struct {
+	slackFeeCap      *big.Int
+	maxSpendPerProof *big.Int
+	escalationWindow time.Duration
+	tiers            []*rpc.TierProviderTierWithID
+}
+
+// NewDeadlineGasStrategy creates a new DeadlineGasStrategy instance.
+func NewDeadlineGasStrategy(
+	slackFeeCap *big.Int,
+	maxSpendPerProof *big.Int,
+	escalationWindow time.Duration,
+	tiers []*rpc.TierProviderTierWithID,
+) *DeadlineGasStrategy {
+	return &DeadlineGasStrategy{
+		slackFeeCap:      slackFeeCap,
+		maxSpendPerProof: maxSpendPerProof,
+		escalationWindow: escalationWindow,
+		tiers:            tiers,
+	}
+}
+
+// FeeCap returns the maximum fee per gas which can be paid by a transaction with the given gas limit,
+// when the given duration is left until the deadline.
+func (s *DeadlineGasStrategy) FeeCap(gasLimit uint64, timeToDeadline time.Duration) *big.Int {
+	maxFeeCap := new(big.Int).Div(s.maxSpendPerProof, new(big.Int).SetUint64(max(gasLimit, 1)))
+	slackFeeCap := s.slackFeeCap
+	if slackFeeCap.Cmp(maxFeeCap) > 0 {
+		slackFeeCap = maxFeeCap
+	}
+
+	if timeToDeadline >= s.escalationWindow {
+		return new(big.Int).Set(slackFeeCap)
+	}
+	if timeToDeadline <= 0 {
+		return maxFeeCap
+	}
+
+	// slackFeeCap + (maxFeeCap - slackFeeCap) * elapsed / escalationWindow
+	elapsed := s.escalationWindow - timeToDeadline
+	feeCap := new(big.Int).Sub(maxFeeCap, slackFeeCap)
+	feeCap.Mul(feeCap, big.NewInt(int64(elapsed)))
+	feeCap.Div(feeCap, big.NewInt(int64(s.escalationWindow)))
+
+	return feeCap.Add(feeCap, slackFeeCap)
+}
+
+// Deadline returns the proving window deadline of the given block.
+func (s *DeadlineGasStrategy) Deadline(meta *bindings.TaikoDataBlockMetadata) (time.Time, error) {
+	_, expiredAt, _, err := handler.IsProvingWindowExpired(meta, s.tiers)
+	return expiredAt, err
+}
+
+// WaitForFeeCap blocks until the current L1 gas price is not higher than the fee cap of the given block,
+// and returns that fee cap.
+func (s *DeadlineGasStrategy) WaitForFeeCap(
+	ctx context.Context,
+	cli *rpc.Client,
+	meta *bindings.TaikoDataBlockMetadata,
+	gasLimit uint64,
+) (*big.Int, error) {
+	deadline, err := s.Deadline(meta)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		gasPrice, err := currentGasPrice(ctx, cli)
+		if err != nil {
+			return nil, err
+		}
+
+		timeToDeadline := time.Until(deadline)
+		feeCap := s.FeeCap(gasLimit, timeToDeadline)
+		if gasPrice.Cmp(feeCap) <= 0 {
+			return feeCap, nil
+		}
+
+		log.Info(
+			"L1 gas price is too high for proof submission, wait",
+			"blockID", meta.Id,
+			"gasPrice", gasPrice,
+			"feeCap", feeCap,
+			"gasLimit", gasLimit,
+			"timeToDeadline", timeToDeadline.Truncate(time.Second),
+		)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(gasPricePollingInterval):
+		}
+	}
+}
+
+// currentGasPrice returns the gas price a new L1 transaction would pay, which is the base fee of
+// the latest block plus the suggested tip.
+func currentGasPrice(ctx context.Context, cli *rpc.Client) (*big.Int, error) {
+	head, err := cli.L1.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch L1 head: %w", err)
+	}
+	if head.BaseFee == nil {
+		return nil, fmt.Errorf("no base fee in L1 head %d", head.Number)
+	}
+
+	tip, err := cli.L1.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the suggested gas tip cap: %w", err)
+	}
+
+	return new(big.Int).Add(head.BaseFee, tip), nil
+}
@@ -0,0 +1,59 @@
+package transaction
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+)
+
+func TestDeadlineGasStrategyFeeCap(t *testing.T) {
+	var (
+		gasLimit = uint64(100_000)
+		// 1 GWei while there is slack, at most 0.001 Ether per proof, i.e. 10 GWei per gas.
+		strategy = NewDeadlineGasStrategy(big.NewInt(1e9), big.NewInt(1e15), time.Hour, nil)
+	)
+
+	require.Equal(t, big.NewInt(1e9), strategy.FeeCap(gasLimit, 2*time.Hour))
+	require.Equal(t, big.NewInt(1e9), strategy.FeeCap(gasLimit, time.Hour))
+	require.Equal(t, big.NewInt(5.5e9), strategy.FeeCap(gasLimit, 30*time.Minute))
+	require.Equal(t, big.NewInt(1e10), strategy.FeeCap(gasLimit, 0))
+	require.Equal(t, big.NewInt(1e10), strategy.FeeCap(gasLimit, -time.Minute))
+
+	// The fee caps never increase while time is passing.
+	prev := strategy.FeeCap(gasLimit, 2*time.Hour)
+	for left := 2 * time.Hour; left >= -time.Minute; left -= time.Minute {
+		feeCap := strategy.FeeCap(gasLimit, left)
+		require.GreaterOrEqual(t, feeCap.Cmp(prev), 0)
+		prev = feeCap
+	}
+}
+
+func TestDeadlineGasStrategyFeeCapMaxSpend(t *testing.T) {
+	// The slack fee cap is capped by the maximum spend per proof.
+	strategy := NewDeadlineGasStrategy(big.NewInt(1e10), big.NewInt(1e14), time.Hour, nil)
+
+	require.Equal(t, big.NewInt(1e9), strategy.FeeCap(100_000, 2*time.Hour))
+	require.Equal(t, big.NewInt(1e9), strategy.FeeCap(100_000, time.Minute))
+	require.Equal(t, big.NewInt(1e8), strategy.FeeCap(1_000_000, 0))
+}
+
+func TestDeadlineGasStrategyDeadline(t *testing.T) {
+	strategy := NewDeadlineGasStrategy(
+		big.NewInt(1e9),
+		big.NewInt(1e15),
+		time.Hour,
+		[]*rpc.TierProviderTierWithID{{ID: 100, ITierProviderTier: bindings.ITierProviderTier{ProvingWindow: 60}}},
+	)
+
+	deadline, err := strategy.Deadline(&bindings.TaikoDataBlockMetadata{MinTier: 100, Timestamp: 1_000})
+	require.Nil(t, err)
+	require.Equal(t, time.Unix(1_000+3_600, 0), deadline)
+
+	_, err = strategy.Deadline(&bindings.TaikoDataBlockMetadata{MinTier: 200, Timestamp: 1_000})
+	require.NotNil(t, err)
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -24,20 +25,23 @@ type Sender struct {
 	txmgr            *txmgr.SimpleTxManager
 	proverSetAddress common.Address
 	gasLimit         uint64
+	gasStrategy      *DeadlineGasStrategy
 }
 
-// NewSender creates a new Sener instance.
+// NewSender creates a new Sener instance, the gas strategy is optional.
 func NewSender(
 	cli *rpc.Client,
 	txmgr *txmgr.SimpleTxManager,
 	proverSetAddress common.Address,
 	gasLimit uint64,
+	gasStrategy *DeadlineGasStrategy,
 ) *Sender {
 	return &Sender{
 		rpc:              cli,
 		txmgr:            txmgr,
 		proverSetAddress: proverSetAddress,
 		gasLimit:         gasLimit,
+		gasStrategy:      gasStrategy,
 	}
 }
 
@@ -47,6 +51,18 @@ func (s *Sender) Send(
 	proofWithHeader *producer.ProofWithHeader,
 	buildTx TxBuilder,
 ) error {
+	// Wait until the L1 gas price is acceptable for the deadline of this block.
+	txOpts := &bind.TransactOpts{GasLimit: s.gasLimit}
+	if s.gasStrategy != nil && proofWithHeader.Tier < encoding.TierGuardianMinorityID {
+		gasLimit, err := s.estimateGasLimit(ctx, buildTx)
+		if err != nil {
+			return err
+		}
+		if txOpts.GasFeeCap, err = s.gasStrategy.WaitForFeeCap(ctx, s.rpc, proofWithHeader.Meta, gasLimit); err != nil {
+			return err
+		}
+	}
+
 	// Check if the proof has already been submitted.
 	proofStatus, err := rpc.GetBlockProofStatus(
 		ctx,
@@ -69,13 +85,17 @@ func (s *Sender) Send(
 	}
 
 	// Assemble the TaikoL1.proveBlock transaction.
-	txCandidate, err := buildTx(&bind.TransactOpts{GasLimit: s.gasLimit})
+	txCandidate, err := buildTx(txOpts)
 	if err != nil {
 		return err
 	}
 
-	// Send the transaction.
-	receipt, err := s.txmgr.Send(ctx, *txCandidate)
+	// Send the transaction, the transaction manager's signer keeps all fee bumps under the fee cap.
+	sendCtx := ctx
+	if txOpts.GasFeeCap != nil {
+		sendCtx = WithFeeCap(ctx, txOpts.GasFeeCap)
+	}
+	receipt, err := s.txmgr.Send(sendCtx, *txCandidate)
 	if err != nil {
 		return encoding.TryParsingCustomError(err)
 	}
@@ -108,6 +128,31 @@ func (s *Sender) Send(
 	return nil
 }
 
+// estimateGasLimit returns the gas limit of the proof submission transaction, which is
+// estimated if no gas limit is configured.
+func (s *Sender) estimateGasLimit(ctx context.Context, buildTx TxBuilder) (uint64, error) {
+	if s.gasLimit != 0 {
+		return s.gasLimit, nil
+	}
+
+	txCandidate, err := buildTx(&bind.TransactOpts{})
+	if err != nil {
+		return 0, err
+	}
+
+	gasLimit, err := s.rpc.L1.EstimateGas(ctx, ethereum.CallMsg{
+		From:  s.txmgr.From(),
+		To:    txCandidate.To,
+		Data:  txCandidate.TxData,
+		Value: txCandidate.Value,
+	})
+	if err != nil {
+		return 0, encoding.TryParsingCustomError(err)
+	}
+
+	return gasLimit, nil
+}
+
 // validateProof checks if the proof's corresponding L1 block is still in the canonical chain and if the
 // latest verified head is not ahead of this block proof.
 func (s *Sender) validateProof(ctx context.Context, proofWithHeader *producer.ProofWithHeader) (bool, error) {
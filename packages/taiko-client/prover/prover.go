@@ -15,6 +15,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/urfave/cli/v2"
 
@@ -23,14 +24,19 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/version"
 	eventIterator "github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/chain_iterator/event_iterator"
+	eventStream "github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/chain_iterator/event_stream"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/signer"
+	bond "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/bond_manager"
 	handler "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/event_handler"
 	guardianProverHeartbeater "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/guardian_prover_heartbeater"
 	proofProducer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
+	proofScheduler "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_scheduler"
 	proofSubmitter "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_submitter"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_submitter/transaction"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/server"
 	state "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/shared_state"
+	protection "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/signing_protection"
 )
 
 // Prover keeps trying to prove newly proposed blocks.
@@ -42,9 +48,16 @@ type Prover struct {
 	// Clients
 	rpc *rpc.Client
 
+	// Shared L1 event stream, nil if the protocol events are fetched through subscriptions
+	eventStream *eventStream.EventStream
+
+	// Signer, either a local private key or a remote signer
+	signer signer.Signer
+
 	// Guardian prover related
 	server                    *server.ProverServer
 	guardianProverHeartbeater guardianProverHeartbeater.BlockSenderHeartbeater
+	signingProtection         *protection.SigningProtection
 
 	// Contract configurations
 	protocolConfig *bindings.TaikoDataConfig
@@ -64,6 +77,12 @@ type Prover struct {
 	proofSubmitters []proofSubmitter.Submitter
 	proofContester  proofSubmitter.Contester
 
+	// Liveness bond balance and allowances manager
+	bondManager *bond.Manager
+
+	// Catch-up mode proof request scheduler
+	proofScheduler *proofScheduler.Scheduler
+
 	assignmentExpiredCh chan *bindings.TaikoL1ClientBlockProposed
 	proveNotify         chan struct{}
 
@@ -118,6 +137,29 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 		return err
 	}
 
+	// Shared L1 event stream
+	if cfg.L1EventStream {
+		if p.eventStream, err = eventStream.Shared(&eventStream.Config{
+			Client:      p.rpc.L1,
+			ChainID:     p.rpc.L1.ChainID,
+			Addresses:   []common.Address{cfg.TaikoL1Address},
+			CacheBlocks: cfg.L1LogCacheBlocks,
+		}); err != nil {
+			return err
+		}
+	}
+
+	// Signer
+	if p.signer, err = signer.New(
+		ctx,
+		cfg.L1ProverPrivKey,
+		cfg.RemoteSignerEndpoint,
+		cfg.RemoteSignerAddress,
+		cfg.TxmgrConfigs.SignerCLIConfig.TLSConfig,
+	); err != nil {
+		return fmt.Errorf("initialize signer error: %w", err)
+	}
+
 	// Configs
 	protocolConfigs, err := p.rpc.TaikoL1.GetConfig(&bind.CallOpts{Context: ctx})
 	if err != nil {
@@ -153,11 +195,18 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 		p.cfg.GuardianProverMinorityAddress,
 	)
 
-	if p.txmgr, err = txmgr.NewSimpleTxManager(
+	// The proof submission transactions' fee caps are enforced by the transaction manager's signer.
+	txmgrConfigs, err := txmgr.NewConfig(*cfg.TxmgrConfigs, log.Root())
+	if err != nil {
+		return err
+	}
+	txmgrConfigs.Signer = transaction.FeeCapSignerFn(txmgrConfigs.Signer)
+
+	if p.txmgr, err = txmgr.NewSimpleTxManagerFromConfig(
 		"prover",
 		log.Root(),
 		&metrics.TxMgrMetrics,
-		*cfg.TxmgrConfigs,
+		txmgrConfigs,
 	); err != nil {
 		return err
 	}
@@ -167,6 +216,22 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 		return err
 	}
 
+	// Liveness bond manager, guardian provers don't pay liveness bonds.
+	if p.rpc.TaikoToken != nil && !p.IsGuardianProver() && p.cfg.BondCheckInterval > 0 {
+		p.bondManager = p.newBondManager(protocolConfigs.LivenessBond)
+	}
+
+	// Proof request scheduler
+	if p.cfg.CatchUpMode {
+		p.proofScheduler = proofScheduler.New(
+			p.ctx,
+			p.cfg.CatchUpWorkersPerTier,
+			p.cfg.CatchUpProducerConcurrency,
+			p.selectProducer,
+			p.handleScheduledProofRequest,
+		)
+	}
+
 	// Proof contester
 	p.proofContester = proofSubmitter.NewProofContester(
 		p.rpc,
@@ -179,7 +244,7 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 
 	// Prover server
 	if p.server, err = server.New(&server.NewProverServerOpts{
-		ProverPrivateKey:      p.cfg.L1ProverPrivKey,
+		ProverSigner:          p.signer,
 		ProverSetAddress:      p.cfg.ProverSetAddress,
 		MinOptimisticTierFee:  p.cfg.MinOptimisticTierFee,
 		MinSgxTierFee:         p.cfg.MinSgxTierFee,
@@ -210,8 +275,13 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 			}
 		}
 
+		if p.signingProtection, err = protection.New(p.cfg.GuardianSigningHistoryPath); err != nil {
+			return err
+		}
+
 		p.guardianProverHeartbeater = guardianProverHeartbeater.New(
-			p.cfg.L1ProverPrivKey,
+			p.signer,
+			p.signingProtection,
 			p.cfg.GuardianProverHealthCheckServerEndpoint,
 			p.rpc,
 			p.ProverAddress(),
@@ -235,14 +305,23 @@ func (p *Prover) Start() error {
 		}
 	}
 
-	// 2. Start the prover server.
+	// 2. Start the liveness bond manager.
+	if p.bondManager != nil {
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			p.bondManager.Loop(p.ctx)
+		}()
+	}
+
+	// 3. Start the prover server.
 	go func() {
 		if err := p.server.Start(fmt.Sprintf(":%v", p.cfg.HTTPServerPort)); !errors.Is(err, http.ErrServerClosed) {
 			log.Crit("Failed to start http server", "error", err)
 		}
 	}()
 
-	// 3. Start the guardian prover heartbeat sender if the current prover is a guardian prover.
+	// 4. Start the guardian prover heartbeat sender if the current prover is a guardian prover.
 	if p.IsGuardianProver() && p.cfg.GuardianProverHealthCheckServerEndpoint != nil {
 		// Send the startup message to the guardian prover health check server.
 		if err := p.guardianProverHeartbeater.SendStartupMessage(
@@ -259,7 +338,7 @@ func (p *Prover) Start() error {
 		go p.guardianProverHeartbeatLoop(p.ctx)
 	}
 
-	// 4. Start the main event loop of the prover.
+	// 5. Start the main event loop of the prover.
 	go p.eventLoop()
 
 	return nil
@@ -294,10 +373,22 @@ func (p *Prover) eventLoop() {
 	transitionProvedCh := make(chan *bindings.TaikoL1ClientTransitionProved, chBufferSize)
 	transitionContestedCh := make(chan *bindings.TaikoL1ClientTransitionContested, chBufferSize)
 	// Subscriptions
-	blockProposedSub := rpc.SubscribeBlockProposed(p.rpc.TaikoL1, blockProposedCh)
-	blockVerifiedSub := rpc.SubscribeBlockVerified(p.rpc.TaikoL1, blockVerifiedCh)
-	transitionProvedSub := rpc.SubscribeTransitionProved(p.rpc.TaikoL1, transitionProvedCh)
-	transitionContestedSub := rpc.SubscribeTransitionContested(p.rpc.TaikoL1, transitionContestedCh)
+	var blockProposedSub, blockVerifiedSub, transitionProvedSub, transitionContestedSub event.Subscription
+	if p.eventStream != nil {
+		blockProposedSub = eventStream.SubscribeBlockProposed(p.eventStream, p.rpc.TaikoL1, blockProposedCh)
+		blockVerifiedSub = eventStream.SubscribeBlockVerified(p.eventStream, p.rpc.TaikoL1, blockVerifiedCh)
+		transitionProvedSub = eventStream.SubscribeTransitionProved(p.eventStream, p.rpc.TaikoL1, transitionProvedCh)
+		transitionContestedSub = eventStream.SubscribeTransitionContested(
+			p.eventStream,
+			p.rpc.TaikoL1,
+			transitionContestedCh,
+		)
+	} else {
+		blockProposedSub = rpc.SubscribeBlockProposed(p.rpc.TaikoL1, blockProposedCh)
+		blockVerifiedSub = rpc.SubscribeBlockVerified(p.rpc.TaikoL1, blockVerifiedCh)
+		transitionProvedSub = rpc.SubscribeTransitionProved(p.rpc.TaikoL1, transitionProvedCh)
+		transitionContestedSub = rpc.SubscribeTransitionContested(p.rpc.TaikoL1, transitionContestedCh)
+	}
 	defer func() {
 		blockProposedSub.Unsubscribe()
 		blockVerifiedSub.Unsubscribe()
@@ -314,7 +405,11 @@ func (p *Prover) eventLoop() {
 		case proofWithHeader := <-p.proofGenerationCh:
 			p.withRetry(func() error { return p.submitProofOp(proofWithHeader) })
 		case req := <-p.proofSubmissionCh:
-			p.withRetry(func() error { return p.requestProofOp(req.Event, req.Tier) })
+			if p.proofScheduler != nil {
+				p.proofScheduler.Add(req, p.provingDeadline(req))
+			} else {
+				p.withRetry(func() error { return p.requestProofOp(req.Event, req.Tier) })
+			}
 		case <-p.proveNotify:
 			if err := p.proveOp(); err != nil {
 				log.Error("Prove new blocks error", "error", err)
@@ -341,6 +436,17 @@ func (p *Prover) Close(ctx context.Context) {
 		log.Error("Failed to shut down prover server", "error", err)
 	}
 	p.wg.Wait()
+	if p.proofScheduler != nil {
+		p.proofScheduler.Wait()
+	}
+	if p.eventStream != nil {
+		p.eventStream.Close()
+	}
+	if p.signingProtection != nil {
+		if err := p.signingProtection.Close(); err != nil {
+			log.Error("Failed to close guardian signing history", "error", err)
+		}
+	}
 }
 
 // proveOp iterates through BlockProposed events.
@@ -351,6 +457,7 @@ func (p *Prover) proveOp() error {
 		StartHeight:          new(big.Int).SetUint64(p.sharedState.GetL1Current().Number.Uint64()),
 		OnBlockProposedEvent: p.blockProposedHandler.Handle,
 		BlockConfirmations:   &p.cfg.BlockConfirmations,
+		EventStream:          p.eventStream,
 	})
 	if err != nil {
 		log.Error("Failed to start event iterator", "event", "BlockProposed", "error", err)
@@ -393,14 +500,7 @@ func (p *Prover) contestProofOp(req *proofProducer.ContestRequestBody) error {
 
 // requestProofOp requests a new proof generation operation.
 func (p *Prover) requestProofOp(e *bindings.TaikoL1ClientBlockProposed, minTier uint16) error {
-	if p.IsGuardianProver() {
-		if minTier > encoding.TierGuardianMinorityID {
-			minTier = encoding.TierGuardianMajorityID
-		} else {
-			minTier = encoding.TierGuardianMinorityID
-		}
-	}
-	if submitter := p.selectSubmitter(minTier); submitter != nil {
+	if submitter := p.selectRequestSubmitter(minTier); submitter != nil {
 		if err := submitter.RequestProof(p.ctx, e); err != nil {
 			log.Error("Request new proof error", "blockID", e.BlockId, "minTier", e.Meta.MinTier, "error", err)
 			return err
@@ -413,6 +513,29 @@ func (p *Prover) requestProofOp(e *bindings.TaikoL1ClientBlockProposed, minTier
 	return nil
 }
 
+// handleScheduledProofRequest handles a proof request scheduled in catch-up mode, since the scheduler
+// limits the concurrency, it retries synchronously with a backoff policy of its own.
+func (p *Prover) handleScheduledProofRequest(ctx context.Context, req *proofProducer.ProofRequestBody) error {
+	return backoff.Retry(
+		func() error { return p.requestProofOp(req.Event, req.Tier) },
+		backoff.WithContext(
+			backoff.WithMaxRetries(backoff.NewConstantBackOff(p.cfg.BackOffRetryInterval), p.cfg.BackOffMaxRetries),
+			ctx,
+		),
+	)
+}
+
+// provingDeadline returns the time when the proving window of the block in the given proof request expires.
+func (p *Prover) provingDeadline(req *proofProducer.ProofRequestBody) time.Time {
+	_, expiredAt, _, err := handler.IsProvingWindowExpired(&req.Event.Meta, p.sharedState.GetTiers())
+	if err != nil {
+		log.Warn("Failed to get proving window deadline", "blockID", req.Event.BlockId, "error", err)
+		return time.Time{}
+	}
+
+	return expiredAt
+}
+
 // submitProofOp performs a proof submission operation.
 func (p *Prover) submitProofOp(proofWithHeader *proofProducer.ProofWithHeader) error {
 	submitter := p.getSubmitterByTier(proofWithHeader.Tier)
@@ -465,6 +588,29 @@ func (p *Prover) selectSubmitter(minTier uint16) proofSubmitter.Submitter {
 	return nil
 }
 
+// selectRequestSubmitter returns the proof submitter which should handle a proof request with the given minTier,
+// guardian provers always use the guardian tiers.
+func (p *Prover) selectRequestSubmitter(minTier uint16) proofSubmitter.Submitter {
+	if p.IsGuardianProver() {
+		if minTier > encoding.TierGuardianMinorityID {
+			minTier = encoding.TierGuardianMajorityID
+		} else {
+			minTier = encoding.TierGuardianMinorityID
+		}
+	}
+
+	return p.selectSubmitter(minTier)
+}
+
+// selectProducer returns the proof producer which will generate the proof for the given request.
+func (p *Prover) selectProducer(req *proofProducer.ProofRequestBody) proofProducer.ProofProducer {
+	if submitter := p.selectRequestSubmitter(req.Tier); submitter != nil {
+		return submitter.Producer()
+	}
+
+	return nil
+}
+
 // getSubmitterByTier returns the proof submitter with the given tier.
 func (p *Prover) getSubmitterByTier(tier uint16) proofSubmitter.Submitter {
 	for _, s := range p.proofSubmitters {
@@ -23,10 +23,12 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/testutils"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/jwt"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/signer"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer"
 	guardianProverHeartbeater "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/guardian_prover_heartbeater"
 	producer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_submitter/transaction"
+	protection "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/signing_protection"
 )
 
 type ProverTestSuite struct {
@@ -558,8 +560,12 @@ func (s *ProverTestSuite) initProver(
 		proverServerURL,
 	)
 
+	p.signer = signer.NewLocalSigner(key)
+	p.signingProtection, err = protection.New("")
+	s.Nil(err)
 	p.guardianProverHeartbeater = guardianProverHeartbeater.New(
-		key,
+		p.signer,
+		p.signingProtection,
 		p.cfg.GuardianProverHealthCheckServerEndpoint,
 		p.rpc,
 		p.ProverAddress(),
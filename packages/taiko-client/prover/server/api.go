@@ -8,7 +8,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/labstack/echo/v4"
 
@@ -232,7 +231,7 @@ func (s *ProverServer) CreateAssignment(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusUnprocessableEntity, err)
 	}
 
-	signed, err := crypto.Sign(crypto.Keccak256Hash(encoded).Bytes(), s.proverPrivateKey)
+	signed, err := s.proverSigner.Sign(c.Request().Context(), encoded)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -2,19 +2,18 @@ package server
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"math/big"
 	"net/http"
 	"os"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/signer"
 	proofProducer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
 )
 
@@ -31,7 +30,7 @@ import (
 // ProverServer represents a prover server instance.
 type ProverServer struct {
 	echo                  *echo.Echo
-	proverPrivateKey      *ecdsa.PrivateKey
+	proverSigner          signer.Signer
 	proverAddress         common.Address
 	proverSetAddress      common.Address
 	minOptimisticTierFee  *big.Int
@@ -52,7 +51,7 @@ type ProverServer struct {
 
 // NewProverServerOpts contains all configurations for creating a prover server instance.
 type NewProverServerOpts struct {
-	ProverPrivateKey      *ecdsa.PrivateKey
+	ProverSigner          signer.Signer
 	ProverSetAddress      common.Address
 	MinOptimisticTierFee  *big.Int
 	MinSgxTierFee         *big.Int
@@ -73,8 +72,8 @@ type NewProverServerOpts struct {
 // New creates a new prover server instance.
 func New(opts *NewProverServerOpts) (*ProverServer, error) {
 	srv := &ProverServer{
-		proverPrivateKey:      opts.ProverPrivateKey,
-		proverAddress:         crypto.PubkeyToAddress(opts.ProverPrivateKey.PublicKey),
+		proverSigner:          opts.ProverSigner,
+		proverAddress:         opts.ProverSigner.Address(),
 		proverSetAddress:      opts.ProverSetAddress,
 		echo:                  echo.New(),
 		minOptimisticTierFee:  opts.MinOptimisticTierFee,
@@ -19,6 +19,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/signer"
 	proofProducer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
 )
 
@@ -49,7 +50,7 @@ func (s *ProverServerTestSuite) SetupTest() {
 	s.Nil(err)
 
 	p, err := New(&NewProverServerOpts{
-		ProverPrivateKey:      l1ProverPrivKey,
+		ProverSigner:          signer.NewLocalSigner(l1ProverPrivKey),
 		MinOptimisticTierFee:  common.Big1,
 		MinSgxTierFee:         common.Big1,
 		MinSgxAndZkVMTierFee:  common.Big1,
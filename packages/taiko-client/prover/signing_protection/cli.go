@@ -0,0 +1,76 @@
+package protection
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
+)
+
+// ExportAction exports the local signing history database to an interchange JSON file.
+func ExportAction(c *cli.Context) error {
+	guardian, err := parseGuardianAddress(c)
+	if err != nil {
+		return err
+	}
+
+	p, err := New(c.String(flags.GuardianSigningHistory.Name))
+	if err != nil {
+		return err
+	}
+	defer p.Close()
+
+	f, err := os.Create(c.String(flags.GuardianSigningHistoryFile.Name))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := p.Export(f, guardian); err != nil {
+		return fmt.Errorf("failed to export guardian signing history: %w", err)
+	}
+
+	return f.Sync()
+}
+
+// ImportAction imports an interchange JSON file into the local signing history database.
+func ImportAction(c *cli.Context) error {
+	guardian, err := parseGuardianAddress(c)
+	if err != nil {
+		return err
+	}
+
+	p, err := New(c.String(flags.GuardianSigningHistory.Name))
+	if err != nil {
+		return err
+	}
+	defer p.Close()
+
+	f, err := os.Open(c.String(flags.GuardianSigningHistoryFile.Name))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	imported, err := p.Import(f, guardian)
+	if err != nil {
+		return fmt.Errorf("failed to import guardian signing history: %w", err)
+	}
+
+	fmt.Fprintf(c.App.Writer, "Imported %d signed blocks\n", imported)
+
+	return nil
+}
+
+// parseGuardianAddress parses the guardian address from the command line flags.
+func parseGuardianAddress(c *cli.Context) (common.Address, error) {
+	guardian := c.String(flags.GuardianAddress.Name)
+	if !common.IsHexAddress(guardian) {
+		return common.Address{}, fmt.Errorf("invalid guardian address: %s", guardian)
+	}
+
+	return common.HexToAddress(guardian), nil
+}
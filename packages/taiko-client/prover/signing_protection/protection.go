@@ -0,0 +1,235 @@
+package protection
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/leveldb"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// InterchangeFormatVersion is the version of the signing history interchange format.
+	InterchangeFormatVersion = "1"
+
+	dbCache   = 16
+	dbHandles = 16
+)
+
+var (
+	// ErrConflictingSignature is returned when a different block hash has already been signed
+	// for the same block ID.
+	ErrConflictingSignature = errors.New("conflicting signature for the same block ID")
+
+	signedBlockPrefix = []byte("signed-block-")
+)
+
+// SignedBlock represents a signed (blockID, blockHash) pair in the signing history.
+type SignedBlock struct {
+	BlockID   uint64      `json:"blockID,string"`
+	BlockHash common.Hash `json:"blockHash"`
+}
+
+// InterchangeMetadata contains the metadata of an exported signing history.
+type InterchangeMetadata struct {
+	InterchangeFormatVersion string         `json:"interchangeFormatVersion"`
+	GuardianAddress          common.Address `json:"guardianAddress"`
+}
+
+// Interchange is the format used to move the signing history of a guardian prover between hosts.
+type Interchange struct {
+	Metadata InterchangeMetadata `json:"metadata"`
+	Data     []*SignedBlock      `json:"data"`
+}
+
+// SigningProtection is a local database of all the blocks signed by a guardian prover, it refuses to
+// sign a different block hash for an already signed block ID, which could happen after a reorg or a restart.
+type SigningProtection struct {
+	db    ethdb.KeyValueStore
+	mutex sync.Mutex
+}
+
+// New opens the signing protection database at the given path, if the path is empty,
+// an in-memory database will be used, which does not survive restarts.
+func New(path string) (*SigningProtection, error) {
+	if path == "" {
+		log.Warn("No guardian signing history path set, the signing history will not be persisted")
+		return &SigningProtection{db: memorydb.New()}, nil
+	}
+
+	db, err := leveldb.New(path, dbCache, dbHandles, "", false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open guardian signing history database: %w", err)
+	}
+
+	return &SigningProtection{db: db}, nil
+}
+
+// CheckAndRecord checks whether the given block hash can be signed for the given block ID, and records it
+// if so. Signing the same block hash again is allowed, while signing a different one returns
+// ErrConflictingSignature.
+func (p *SigningProtection) CheckAndRecord(blockID uint64, blockHash common.Hash) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	return p.checkAndRecord(blockID, blockHash)
+}
+
+// checkAndRecord is the lock-free version of CheckAndRecord.
+func (p *SigningProtection) checkAndRecord(blockID uint64, blockHash common.Hash) error {
+	signed, ok, err := p.get(blockID)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		if signed != blockHash {
+			return fmt.Errorf(
+				"%w: blockID %d, signed %s, new %s",
+				ErrConflictingSignature,
+				blockID,
+				signed,
+				blockHash,
+			)
+		}
+		return nil
+	}
+
+	return p.db.Put(signedBlockKey(blockID), blockHash.Bytes())
+}
+
+// SignedHash returns the signed block hash of the given block ID, if there is any.
+func (p *SigningProtection) SignedHash(blockID uint64) (common.Hash, bool, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	return p.get(blockID)
+}
+
+// get fetches the signed block hash of the given block ID from the database.
+func (p *SigningProtection) get(blockID uint64) (common.Hash, bool, error) {
+	key := signedBlockKey(blockID)
+
+	has, err := p.db.Has(key)
+	if err != nil || !has {
+		return common.Hash{}, false, err
+	}
+
+	value, err := p.db.Get(key)
+	if err != nil {
+		return common.Hash{}, false, err
+	}
+
+	return common.BytesToHash(value), true, nil
+}
+
+// Export writes the whole signing history of the given guardian in the interchange format.
+func (p *SigningProtection) Export(w io.Writer, guardian common.Address) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	interchange := &Interchange{
+		Metadata: InterchangeMetadata{InterchangeFormatVersion: InterchangeFormatVersion, GuardianAddress: guardian},
+		Data:     []*SignedBlock{},
+	}
+
+	it := p.db.NewIterator(signedBlockPrefix, nil)
+	defer it.Release()
+
+	for it.Next() {
+		interchange.Data = append(interchange.Data, &SignedBlock{
+			BlockID:   binary.BigEndian.Uint64(it.Key()[len(signedBlockPrefix):]),
+			BlockHash: common.BytesToHash(it.Value()),
+		})
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(interchange)
+}
+
+// Import reads a signing history of the given guardian in the interchange format, and merges it
+// into the local database. The whole import is refused if any record conflicts with the local ones.
+func (p *SigningProtection) Import(r io.Reader, guardian common.Address) (int, error) {
+	var interchange Interchange
+	if err := json.NewDecoder(r).Decode(&interchange); err != nil {
+		return 0, fmt.Errorf("invalid signing history interchange: %w", err)
+	}
+
+	if interchange.Metadata.InterchangeFormatVersion != InterchangeFormatVersion {
+		return 0, fmt.Errorf(
+			"unsupported signing history interchange format version: %s",
+			interchange.Metadata.InterchangeFormatVersion,
+		)
+	}
+	if interchange.Metadata.GuardianAddress != guardian {
+		return 0, fmt.Errorf(
+			"signing history belongs to guardian %s, expected %s",
+			interchange.Metadata.GuardianAddress,
+			guardian,
+		)
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	// Check all records before writing any of them.
+	seen := make(map[uint64]common.Hash, len(interchange.Data))
+	for _, b := range interchange.Data {
+		if hash, ok := seen[b.BlockID]; ok && hash != b.BlockHash {
+			return 0, fmt.Errorf("%w: blockID %d in the interchange", ErrConflictingSignature, b.BlockID)
+		}
+		seen[b.BlockID] = b.BlockHash
+
+		signed, ok, err := p.get(b.BlockID)
+		if err != nil {
+			return 0, err
+		}
+		if ok && signed != b.BlockHash {
+			return 0, fmt.Errorf(
+				"%w: blockID %d, local %s, imported %s",
+				ErrConflictingSignature,
+				b.BlockID,
+				signed,
+				b.BlockHash,
+			)
+		}
+	}
+
+	batch := p.db.NewBatch()
+	for blockID, hash := range seen {
+		if err := batch.Put(signedBlockKey(blockID), hash.Bytes()); err != nil {
+			return 0, err
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return 0, err
+	}
+
+	return len(seen), nil
+}
+
+// Close closes the underlying database.
+func (p *SigningProtection) Close() error {
+	return p.db.Close()
+}
+
+// signedBlockKey returns the database key of the given block ID, the block ID is big-endian encoded,
+// so that the iteration follows the block ID order.
+func signedBlockKey(blockID uint64) []byte {
+	key := make([]byte, len(signedBlockPrefix)+8)
+	copy(key, signedBlockPrefix)
+	binary.BigEndian.PutUint64(key[len(signedBlockPrefix):], blockID)
+	return key
+}
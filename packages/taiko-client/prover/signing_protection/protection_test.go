@@ -0,0 +1,122 @@
+package protection
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testGuardian = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	testHashA    = common.HexToHash("0xa")
+	testHashB    = common.HexToHash("0xb")
+)
+
+func TestCheckAndRecord(t *testing.T) {
+	p, err := New("")
+	require.Nil(t, err)
+	defer p.Close()
+
+	require.Nil(t, p.CheckAndRecord(1, testHashA))
+	// Signing the same hash again is allowed.
+	require.Nil(t, p.CheckAndRecord(1, testHashA))
+	require.ErrorIs(t, p.CheckAndRecord(1, testHashB), ErrConflictingSignature)
+	require.Nil(t, p.CheckAndRecord(2, testHashB))
+
+	hash, ok, err := p.SignedHash(1)
+	require.Nil(t, err)
+	require.True(t, ok)
+	require.Equal(t, testHashA, hash)
+
+	_, ok, err = p.SignedHash(3)
+	require.Nil(t, err)
+	require.False(t, ok)
+}
+
+func TestPersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history")
+
+	p, err := New(path)
+	require.Nil(t, err)
+	require.Nil(t, p.CheckAndRecord(1, testHashA))
+	require.Nil(t, p.Close())
+
+	// Reopen the database, like after a restart.
+	p, err = New(path)
+	require.Nil(t, err)
+	defer p.Close()
+
+	require.ErrorIs(t, p.CheckAndRecord(1, testHashB), ErrConflictingSignature)
+}
+
+func TestExportImport(t *testing.T) {
+	src, err := New("")
+	require.Nil(t, err)
+	defer src.Close()
+
+	require.Nil(t, src.CheckAndRecord(300, testHashA))
+	require.Nil(t, src.CheckAndRecord(2, testHashB))
+
+	var buf bytes.Buffer
+	require.Nil(t, src.Export(&buf, testGuardian))
+	exported := buf.Bytes()
+
+	// Import into a fresh database.
+	dst, err := New("")
+	require.Nil(t, err)
+	defer dst.Close()
+
+	imported, err := dst.Import(bytes.NewReader(exported), testGuardian)
+	require.Nil(t, err)
+	require.Equal(t, 2, imported)
+	require.ErrorIs(t, dst.CheckAndRecord(300, testHashB), ErrConflictingSignature)
+	require.ErrorIs(t, dst.CheckAndRecord(2, testHashA), ErrConflictingSignature)
+
+	// Exporting again keeps the block ID order.
+	buf.Reset()
+	require.Nil(t, dst.Export(&buf, testGuardian))
+	require.Equal(t, exported, buf.Bytes())
+
+	// Wrong guardian.
+	_, err = dst.Import(bytes.NewReader(exported), common.HexToAddress("0x02"))
+	require.ErrorContains(t, err, "belongs to guardian")
+}
+
+func TestImportConflict(t *testing.T) {
+	src, err := New("")
+	require.Nil(t, err)
+	defer src.Close()
+	require.Nil(t, src.CheckAndRecord(1, testHashA))
+	require.Nil(t, src.CheckAndRecord(2, testHashA))
+
+	var buf bytes.Buffer
+	require.Nil(t, src.Export(&buf, testGuardian))
+
+	dst, err := New("")
+	require.Nil(t, err)
+	defer dst.Close()
+	require.Nil(t, dst.CheckAndRecord(2, testHashB))
+
+	_, err = dst.Import(&buf, testGuardian)
+	require.ErrorIs(t, err, ErrConflictingSignature)
+
+	// Nothing should be written if the import is refused.
+	_, ok, err := dst.SignedHash(1)
+	require.Nil(t, err)
+	require.False(t, ok)
+}
+
+func TestImportInvalidVersion(t *testing.T) {
+	p, err := New("")
+	require.Nil(t, err)
+	defer p.Close()
+
+	_, err = p.Import(
+		bytes.NewReader([]byte(`{"metadata":{"interchangeFormatVersion":"0"},"data":[]}`)),
+		testGuardian,
+	)
+	require.ErrorContains(t, err, "unsupported")
+}
@@ -0,0 +1,50 @@
+package status
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+)
+
+// Config contains the configurations to collect the status of a Taiko node.
+type Config struct {
+	*rpc.ClientConfig
+	AssignmentHookAddress common.Address
+	Prover                *common.Address
+	JSON                  bool
+	Timeout               time.Duration
+	Limit                 uint64
+}
+
+// NewConfigFromCliContext creates a new config instance from the command line flags.
+func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
+	var prover *common.Address
+	if c.IsSet(flags.StatusProver.Name) {
+		if !common.IsHexAddress(c.String(flags.StatusProver.Name)) {
+			return nil, fmt.Errorf("invalid prover address: %s", c.String(flags.StatusProver.Name))
+		}
+		address := common.HexToAddress(c.String(flags.StatusProver.Name))
+		prover = &address
+	}
+
+	return &Config{
+		ClientConfig: &rpc.ClientConfig{
+			L1Endpoint:        c.String(flags.L1WSEndpoint.Name),
+			L2Endpoint:        c.String(flags.L2WSEndpoint.Name),
+			TaikoL1Address:    common.HexToAddress(c.String(flags.TaikoL1Address.Name)),
+			TaikoL2Address:    common.HexToAddress(c.String(flags.TaikoL2Address.Name)),
+			TaikoTokenAddress: common.HexToAddress(c.String(flags.TaikoTokenAddress.Name)),
+			Timeout:           c.Duration(flags.RPCTimeout.Name),
+		},
+		AssignmentHookAddress: common.HexToAddress(c.String(flags.AssignmentHookAddress.Name)),
+		Prover:                prover,
+		JSON:                  c.Bool(flags.StatusJSON.Name),
+		Timeout:               c.Duration(flags.StatusTimeout.Name),
+		Limit:                 c.Uint64(flags.StatusLimit.Name),
+	}, nil
+}
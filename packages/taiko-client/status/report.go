@@ -0,0 +1,119 @@
+package status
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/utils"
+)
+
+// maxPrintedPendingProofs is the maximum number of pending proof block IDs printed in the text report.
+const maxPrintedPendingProofs = 10
+
+// WriteJSON writes the status to the given writer in JSON format.
+func (s *Status) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(s)
+}
+
+// WriteText writes the status to the given writer in human-readable text format.
+func (s *Status) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	section(tw, "Chains")
+	row(tw, "L1 head", s.L1.String())
+	row(tw, "L2 head", s.L2.String())
+
+	section(tw, "Protocol")
+	row(tw, "Genesis height", s.Protocol.GenesisHeight)
+	row(tw, "Number of blocks", s.Protocol.NumBlocks)
+	row(tw, "Last synced block ID", s.Protocol.LastSyncedBlockID)
+	row(tw, "Last verified block ID", s.Protocol.LastVerifiedBlockID)
+	row(tw, "Pending blocks", s.Protocol.PendingBlocks)
+	row(tw, "Available slots", s.Protocol.AvailableSlots)
+	row(tw, "Proving paused", s.Protocol.ProvingPaused)
+	row(tw, "Liveness bond", ether(s.Protocol.LivenessBond))
+
+	section(tw, "Tiers")
+	fmt.Fprintln(tw, "  ID\tVerifier\tValidity bond\tContest bond\tCooldown\tProving window\tMax blocks to verify")
+	for _, t := range s.Tiers {
+		fmt.Fprintf(
+			tw,
+			"  %d\t%s\t%s\t%s\t%s\t%s\t%d\n",
+			t.ID,
+			t.VerifierName,
+			ether(t.ValidityBond),
+			ether(t.ContestBond),
+			time.Duration(t.CooldownWindow)*time.Minute,
+			time.Duration(t.ProvingWindow)*time.Minute,
+			t.MaxBlocksToVerifyPerProof,
+		)
+	}
+
+	if s.Prover != nil {
+		section(tw, "Prover")
+		row(tw, "Address", s.Prover.Address.Hex())
+		row(tw, "ETH balance", ether(s.Prover.EthBalance))
+		row(tw, "TaikoToken balance", ether(s.Prover.TaikoTokenBalance))
+		row(tw, "TaikoToken allowance", ether(s.Prover.Allowance))
+		row(tw, "Bond sufficient", s.Prover.BondSufficient)
+		row(tw, "Pending proofs", pendingProofsText(s.Prover.PendingProofs))
+		row(tw, "Pending proofs from", s.Prover.PendingProofsFrom)
+	}
+
+	return tw.Flush()
+}
+
+// String implements the fmt.Stringer interface.
+func (h *ChainHead) String() string {
+	return fmt.Sprintf(
+		"#%d %s (chainID %s, %s)",
+		h.Number,
+		h.Hash.Hex(),
+		h.ChainID,
+		time.Unix(int64(h.Timestamp), 0).UTC().Format(time.RFC3339),
+	)
+}
+
+// section writes a section title.
+func section(w io.Writer, title string) {
+	fmt.Fprintf(w, "%s\n", title)
+}
+
+// row writes a key-value row inside a section.
+func row(w io.Writer, key string, value interface{}) {
+	fmt.Fprintf(w, "  %s\t%v\n", key, value)
+}
+
+// ether formats the given wei amount in ether, or "-" if it is not available.
+func ether(wei *big.Int) string {
+	if wei == nil {
+		return "-"
+	}
+	return utils.WeiToEther(wei).Text('f', 6)
+}
+
+// pendingProofsText formats the given pending proof block IDs.
+func pendingProofsText(ids []uint64) string {
+	if len(ids) == 0 {
+		return "0"
+	}
+
+	printed := make([]string, 0, maxPrintedPendingProofs)
+	for i, id := range ids {
+		if i == maxPrintedPendingProofs {
+			printed = append(printed, "...")
+			break
+		}
+		printed = append(printed, fmt.Sprintf("%d", id))
+	}
+
+	return fmt.Sprintf("%d [%s]", len(ids), strings.Join(printed, ", "))
+}
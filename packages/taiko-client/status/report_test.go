@@ -0,0 +1,92 @@
+package status
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func testStatus() *Status {
+	return &Status{
+		L1: &ChainHead{ChainID: big.NewInt(1), Number: 100, Hash: common.HexToHash("0x01"), Timestamp: 1},
+		L2: &ChainHead{ChainID: big.NewInt(167), Number: 50, Hash: common.HexToHash("0x02"), Timestamp: 2},
+		Protocol: &ProtocolStatus{
+			NumBlocks:           51,
+			LastVerifiedBlockID: 40,
+			PendingBlocks:       10,
+			LivenessBond:        big.NewInt(5e18),
+		},
+		Tiers: []*TierStatus{
+			{ID: 100, VerifierName: "", ValidityBond: big.NewInt(5e18), ContestBond: big.NewInt(6e18), ProvingWindow: 60},
+			{ID: 200, VerifierName: "tier_sgx", ValidityBond: big.NewInt(5e18), ContestBond: big.NewInt(9e18)},
+		},
+	}
+}
+
+func TestWriteText(t *testing.T) {
+	s := testStatus()
+
+	var buf bytes.Buffer
+	require.Nil(t, s.WriteText(&buf))
+	require.Contains(t, buf.String(), "L1 head")
+	require.Contains(t, buf.String(), "#100")
+	require.Contains(t, buf.String(), "Last verified block ID  40")
+	require.Contains(t, buf.String(), "tier_sgx")
+	require.Contains(t, buf.String(), "1h0m0s")
+	require.NotContains(t, buf.String(), "Prover")
+
+	s.Prover = &ProverStatus{
+		Address:        common.HexToAddress("0x03"),
+		EthBalance:     big.NewInt(1e18),
+		BondSufficient: true,
+		PendingProofs:  []uint64{41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51},
+	}
+
+	buf.Reset()
+	require.Nil(t, s.WriteText(&buf))
+	require.Contains(t, buf.String(), "1.000000")
+	require.Contains(t, buf.String(), "TaikoToken balance    -")
+	require.Contains(t, buf.String(), "11 [41, 42, 43, 44, 45, 46, 47, 48, 49, 50, ...]")
+}
+
+func TestWriteJSON(t *testing.T) {
+	s := testStatus()
+	s.Prover = &ProverStatus{Address: common.HexToAddress("0x03"), PendingProofs: []uint64{}}
+
+	var buf bytes.Buffer
+	require.Nil(t, s.WriteJSON(&buf))
+
+	var decoded Status
+	require.Nil(t, json.Unmarshal(buf.Bytes(), &decoded))
+	require.Equal(t, s.L2.Number, decoded.L2.Number)
+	require.Equal(t, s.Protocol.LastVerifiedBlockID, decoded.Protocol.LastVerifiedBlockID)
+	require.Equal(t, 0, s.Protocol.LivenessBond.Cmp(decoded.Protocol.LivenessBond))
+	require.Len(t, decoded.Tiers, 2)
+	require.Equal(t, s.Prover.Address, decoded.Prover.Address)
+	require.Contains(t, buf.String(), `"pendingProofs": []`)
+}
+
+func TestPendingProofsRange(t *testing.T) {
+	protocol := testStatus().Protocol
+
+	from, count := pendingProofsRange(protocol, 0)
+	require.Equal(t, uint64(41), from)
+	require.Equal(t, uint64(10), count)
+
+	from, count = pendingProofsRange(protocol, 20)
+	require.Equal(t, uint64(41), from)
+	require.Equal(t, uint64(10), count)
+
+	from, count = pendingProofsRange(protocol, 3)
+	require.Equal(t, uint64(48), from)
+	require.Equal(t, uint64(3), count)
+}
+
+func TestPendingProofsText(t *testing.T) {
+	require.Equal(t, "0", pendingProofsText(nil))
+	require.Equal(t, "2 [1, 2]", pendingProofsText([]uint64{1, 2}))
+}
@@ -0,0 +1,295 @@
+package status
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/urfave/cli/v2"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+)
+
+// maxConcurrentBlockQueries is the maximum number of concurrent TaikoL1.getBlock calls
+// when searching the pending proofs.
+const maxConcurrentBlockQueries = 16
+
+// ChainHead represents the head block of a chain.
+type ChainHead struct {
+	ChainID   *big.Int    `json:"chainID"`
+	Number    uint64      `json:"number"`
+	Hash      common.Hash `json:"hash"`
+	Timestamp uint64      `json:"timestamp"`
+}
+
+// ProtocolStatus represents the state of the TaikoL1 contract.
+type ProtocolStatus struct {
+	GenesisHeight       uint64   `json:"genesisHeight"`
+	NumBlocks           uint64   `json:"numBlocks"`
+	LastSyncedBlockID   uint64   `json:"lastSyncedBlockID"`
+	LastVerifiedBlockID uint64   `json:"lastVerifiedBlockID"`
+	PendingBlocks       uint64   `json:"pendingBlocks"`
+	AvailableSlots      uint64   `json:"availableSlots"`
+	ProvingPaused       bool     `json:"provingPaused"`
+	LivenessBond        *big.Int `json:"livenessBond"`
+}
+
+// TierStatus represents the configurations of a proof tier.
+type TierStatus struct {
+	ID                        uint16   `json:"id"`
+	VerifierName              string   `json:"verifierName"`
+	ValidityBond              *big.Int `json:"validityBond"`
+	ContestBond               *big.Int `json:"contestBond"`
+	CooldownWindow            uint64   `json:"cooldownWindow"`
+	ProvingWindow             uint16   `json:"provingWindow"`
+	MaxBlocksToVerifyPerProof uint8    `json:"maxBlocksToVerifyPerProof"`
+}
+
+// ProverStatus represents the state of a prover.
+type ProverStatus struct {
+	Address           common.Address `json:"address"`
+	EthBalance        *big.Int       `json:"ethBalance"`
+	TaikoTokenBalance *big.Int       `json:"taikoTokenBalance"`
+	Allowance         *big.Int       `json:"allowance"`
+	BondSufficient    bool           `json:"bondSufficient"`
+	PendingProofs     []uint64       `json:"pendingProofs"`
+	// PendingProofsFrom is the first block ID searched for the pending proofs.
+	PendingProofsFrom uint64 `json:"pendingProofsFrom"`
+}
+
+// Status is a summary of the protocol and node state.
+type Status struct {
+	L1       *ChainHead      `json:"l1"`
+	L2       *ChainHead      `json:"l2"`
+	Protocol *ProtocolStatus `json:"protocol"`
+	Tiers    []*TierStatus   `json:"tiers"`
+	Prover   *ProverStatus   `json:"prover,omitempty"`
+}
+
+// Action is the action of the status command, which collects and prints the status.
+func Action(c *cli.Context) error {
+	cfg, err := NewConfigFromCliContext(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context, cfg.Timeout)
+	defer cancel()
+
+	rpcClient, err := rpc.NewClient(ctx, cfg.ClientConfig)
+	if err != nil {
+		return fmt.Errorf("initialize rpc clients error: %w", err)
+	}
+
+	status, err := Collect(ctx, rpcClient, cfg.AssignmentHookAddress, cfg.Prover, cfg.Limit)
+	if err != nil {
+		return err
+	}
+
+	if cfg.JSON {
+		return status.WriteJSON(c.App.Writer)
+	}
+
+	return status.WriteText(c.App.Writer)
+}
+
+// Collect collects the status of the protocol and the connected nodes, if the prover address is given,
+// its balances and pending proofs among the latest limit unverified blocks will also be collected.
+func Collect(
+	ctx context.Context,
+	cli *rpc.Client,
+	assignmentHookAddress common.Address,
+	prover *common.Address,
+	limit uint64,
+) (*Status, error) {
+	var (
+		status  = new(Status)
+		g, gCtx = errgroup.WithContext(ctx)
+	)
+
+	g.Go(func() (err error) {
+		status.L1, err = chainHead(gCtx, cli.L1)
+		return err
+	})
+	g.Go(func() (err error) {
+		status.L2, err = chainHead(gCtx, cli.L2)
+		return err
+	})
+	g.Go(func() (err error) {
+		status.Protocol, err = protocolStatus(gCtx, cli)
+		return err
+	})
+	g.Go(func() error {
+		tiers, err := cli.GetTiers(gCtx)
+		if err != nil {
+			return err
+		}
+		for _, tier := range tiers {
+			status.Tiers = append(status.Tiers, &TierStatus{
+				ID:                        tier.ID,
+				VerifierName:              string(common.TrimRightZeroes(tier.VerifierName[:])),
+				ValidityBond:              tier.ValidityBond,
+				ContestBond:               tier.ContestBond,
+				CooldownWindow:            tier.CooldownWindow.Uint64(),
+				ProvingWindow:             tier.ProvingWindow,
+				MaxBlocksToVerifyPerProof: tier.MaxBlocksToVerifyPerProof,
+			})
+		}
+		return nil
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	if prover != nil {
+		proverStatus, err := collectProverStatus(ctx, cli, assignmentHookAddress, *prover, status.Protocol, limit)
+		if err != nil {
+			return nil, err
+		}
+		status.Prover = proverStatus
+	}
+
+	return status, nil
+}
+
+// chainHead fetches the head block of the given chain.
+func chainHead(ctx context.Context, client *rpc.EthClient) (*ChainHead, error) {
+	header, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChainHead{
+		ChainID:   client.ChainID,
+		Number:    header.Number.Uint64(),
+		Hash:      header.Hash(),
+		Timestamp: header.Time,
+	}, nil
+}
+
+// protocolStatus fetches the state of the TaikoL1 contract.
+func protocolStatus(ctx context.Context, cli *rpc.Client) (*ProtocolStatus, error) {
+	configs, err := cli.TaikoL1.GetConfig(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, err
+	}
+
+	vars, err := cli.GetProtocolStateVariables(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProtocolStatus{
+		GenesisHeight:       vars.A.GenesisHeight,
+		NumBlocks:           vars.B.NumBlocks,
+		LastSyncedBlockID:   vars.A.LastSyncedBlockId,
+		LastVerifiedBlockID: vars.B.LastVerifiedBlockId,
+		PendingBlocks:       vars.B.NumBlocks - vars.B.LastVerifiedBlockId - 1,
+		AvailableSlots:      vars.B.LastVerifiedBlockId + configs.BlockMaxProposals - vars.B.NumBlocks,
+		ProvingPaused:       vars.B.ProvingPaused,
+		LivenessBond:        configs.LivenessBond,
+	}, nil
+}
+
+// collectProverStatus collects the balances and the pending proofs of the given prover.
+func collectProverStatus(
+	ctx context.Context,
+	cli *rpc.Client,
+	assignmentHookAddress common.Address,
+	prover common.Address,
+	protocol *ProtocolStatus,
+	limit uint64,
+) (*ProverStatus, error) {
+	status := &ProverStatus{Address: prover, PendingProofs: []uint64{}}
+
+	ethBalance, err := cli.L1.BalanceAt(ctx, prover, nil)
+	if err != nil {
+		return nil, err
+	}
+	status.EthBalance = ethBalance
+
+	if cli.TaikoToken != nil {
+		opts := &bind.CallOpts{Context: ctx}
+		if status.TaikoTokenBalance, err = cli.TaikoToken.BalanceOf(opts, prover); err != nil {
+			return nil, err
+		}
+		if status.Allowance, err = cli.TaikoToken.Allowance(opts, prover, assignmentHookAddress); err != nil {
+			return nil, err
+		}
+		if status.BondSufficient, err = rpc.CheckProverBalance(
+			ctx,
+			cli,
+			prover,
+			assignmentHookAddress,
+			protocol.LivenessBond,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	var count uint64
+	status.PendingProofsFrom, count = pendingProofsRange(protocol, limit)
+	if status.PendingProofs, err = pendingProofs(ctx, cli, prover, status.PendingProofsFrom, count); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// pendingProofsRange returns the first block ID and the number of the latest unverified blocks
+// to search for the pending proofs, a zero limit means searching all of them.
+func pendingProofsRange(protocol *ProtocolStatus, limit uint64) (uint64, uint64) {
+	count := protocol.PendingBlocks
+	if limit != 0 && count > limit {
+		count = limit
+	}
+
+	return protocol.NumBlocks - count, count
+}
+
+// pendingProofs returns the IDs of the given count of blocks starting from the given block ID,
+// which are assigned to the given prover and have not been proven yet.
+func pendingProofs(
+	ctx context.Context,
+	cli *rpc.Client,
+	prover common.Address,
+	from uint64,
+	count uint64,
+) ([]uint64, error) {
+	var (
+		pending = make([]bool, count)
+		g, gCtx = errgroup.WithContext(ctx)
+	)
+	g.SetLimit(maxConcurrentBlockQueries)
+
+	for i := range pending {
+		i := i
+		g.Go(func() error {
+			block, err := cli.GetL2BlockInfo(gCtx, new(big.Int).SetUint64(from+uint64(i)))
+			if err != nil {
+				return err
+			}
+			// The first transition ID is 1, so NextTransitionId <= 1 means no transition has been proven.
+			pending[i] = block.AssignedProver == prover && block.NextTransitionId <= 1
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	ids := []uint64{}
+	for i, ok := range pending {
+		if ok {
+			ids = append(ids, from+uint64(i))
+		}
+	}
+
+	log.Debug("Pending proofs collected", "prover", prover, "count", len(ids))
+
+	return ids, nil
+}